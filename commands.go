@@ -0,0 +1,249 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jpoz/hubell/internal/auth"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// command is a hubell subcommand.
+type command struct {
+	name, summary string
+}
+
+// commands are hubell's subcommands; without one it starts the TUI.
+var commands = []command{
+	{"status", "Summarize unread notifications and open PRs"},
+	{"prs", "List open PRs with CI and review status"},
+	{"notifications", "List unread notifications"},
+	{"login", "Sign in with a token or the device flow (-device, -with-token)"},
+	{"logout", "Delete the saved GitHub token and any keychain entry"},
+}
+
+// usage prints the flags and subcommands.
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: hubell [flags] [command]\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(out, "  %-15s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(out, "\nWithout a command hubell starts the TUI.\n\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+// isCommand reports whether name is a subcommand.
+func isCommand(name string) bool {
+	return slices.ContainsFunc(commands, func(c command) bool { return c.name == name })
+}
+
+// runLogin gets a token from the device flow, stdin or a prompt, checks it
+// against GitHub and saves it.
+func runLogin(ctx context.Context, tokenStore *auth.TokenStore, args []string) error {
+	fs := flag.NewFlagSet("login", flag.ContinueOnError)
+	device := fs.Bool("device", false, "Authorize in the browser with the OAuth device flow (needs HUBELL_CLIENT_ID)")
+	withToken := fs.Bool("with-token", false, "Read the token from stdin instead of prompting")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	if *device && *withToken {
+		return fmt.Errorf("-device and -with-token can't be used together")
+	}
+
+	var token string
+	var err error
+	switch {
+	case *device:
+		token, err = deviceLogin(ctx)
+	case *withToken:
+		var data []byte
+		if data, err = io.ReadAll(os.Stdin); err == nil {
+			token, err = auth.CleanToken(string(data))
+		}
+	default:
+		token, err = auth.PromptForToken()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get token: %w", err)
+	}
+	return saveValidToken(ctx, tokenStore, token)
+}
+
+// deviceLogin runs the OAuth device flow and returns the granted token.
+func deviceLogin(ctx context.Context) (string, error) {
+	clientID := auth.ClientID()
+	if clientID == "" {
+		return "", fmt.Errorf("the device flow needs an OAuth app: set HUBELL_CLIENT_ID to its client ID")
+	}
+	dc, err := auth.RequestDeviceCode(ctx, clientID)
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("\nOpen %s and enter the code: %s\n", dc.VerificationURI, dc.UserCode)
+	if err := browser.Open(dc.VerificationURI); err != nil {
+		fmt.Println("(couldn't open the browser, open the link yourself)")
+	}
+	fmt.Println("Waiting for authorization…")
+	return auth.PollDeviceToken(ctx, clientID, dc)
+}
+
+// saveValidToken checks token against GitHub, saving it only if GitHub
+// accepts it, and warns about missing scopes.
+func saveValidToken(ctx context.Context, tokenStore *auth.TokenStore, token string) error {
+	user, err := github.NewClient(token).GetAuthenticatedUser(ctx)
+	if errors.Is(err, github.ErrUnauthorized) {
+		return fmt.Errorf("GitHub rejected the token; check it hasn't expired or been revoked")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to verify token: %w", err)
+	}
+	if err := tokenStore.Save(token); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+	fmt.Printf("✓ Logged in as @%s, token saved to ~/.config/hubell/token\n", user.Login)
+	for _, r := range github.MissingScopes(user.Scopes, true) {
+		fmt.Printf("  ⚠ missing scope %s: %s won't work\n", r.Scope, r.Features)
+	}
+	return nil
+}
+
+// runLogout deletes the saved token and any copy in the OS keychain.
+func runLogout(tokenStore *auth.TokenStore) error {
+	if err := tokenStore.Delete(); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete token: %w", err)
+	}
+	if err := auth.DeleteFromKeychain(); err != nil {
+		return fmt.Errorf("failed to remove keychain entry: %w", err)
+	}
+	fmt.Println("✓ Logged out")
+	if os.Getenv("GITHUB_TOKEN") != "" {
+		fmt.Println("GITHUB_TOKEN is still set and will be used until unset.")
+	}
+	return nil
+}
+
+// pollOnce runs a single poll and returns its result.
+func pollOnce(ctx context.Context, client *github.Client, login string, interval time.Duration) (github.PollResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	poller := github.NewPoller(client, interval, login, nil)
+	poller.SetCIOptions(config.LoadCISettings().CIOptions())
+	events := poller.Subscribe()
+	poller.Start(ctx)
+	for e := range events {
+		switch e := e.(type) {
+		case github.PollCompleted:
+			return e.Result, nil
+		case github.PollFailed:
+			return github.PollResult{}, e.Err
+		}
+	}
+	return github.PollResult{}, ctx.Err()
+}
+
+// runQuery runs the status, prs or notifications command.
+func runQuery(ctx context.Context, name string, client *github.Client, login string, interval time.Duration) error {
+	result, err := pollOnce(ctx, client, login, interval)
+	if err != nil {
+		return err
+	}
+	needs := map[string][]github.PollSource{
+		"status":        {github.SourceNotifications, github.SourcePRs},
+		"prs":           {github.SourcePRs},
+		"notifications": {github.SourceNotifications},
+	}
+	for _, source := range needs[name] {
+		if err := result.Errors[source]; err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", source, err)
+		}
+	}
+	var unread []*github.Notification
+	for _, n := range result.Notifications {
+		if n.Unread {
+			unread = append(unread, n)
+		}
+	}
+	slices.SortFunc(unread, func(a, b *github.Notification) int { return b.UpdatedAt.Compare(a.UpdatedAt) })
+	prs := make([]github.PRInfo, 0, len(result.PRInfos))
+	for _, info := range result.PRInfos {
+		prs = append(prs, info)
+	}
+	slices.SortFunc(prs, func(a, b github.PRInfo) int { return b.CreatedAt.Compare(a.CreatedAt) })
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+	switch name {
+	case "status":
+		reasons := make(map[string]int)
+		for _, n := range unread {
+			reasons[n.Reason]++
+		}
+		statuses := make(map[github.PRStatus]int)
+		for key := range result.PRInfos {
+			statuses[result.PRStatuses[key]]++
+		}
+		fmt.Fprintf(w, "@%s\n", login)
+		fmt.Fprintf(w, "unread notifications:\t%d\t(%d review requests, %d mentions)\n",
+			len(unread), reasons["review_requested"], reasons["mention"]+reasons["team_mention"])
+		fmt.Fprintf(w, "open PRs:\t%d\t(%d passing, %d failing, %d pending)\n",
+			len(prs), statuses[github.PRStatusSuccess], statuses[github.PRStatusFailure], statuses[github.PRStatusPending])
+		if n := len(result.SecretScanningAlerts); n > 0 {
+			fmt.Fprintf(w, "secret scanning alerts:\t%d\n", n)
+		}
+	case "prs":
+		for _, info := range prs {
+			status := result.PRStatuses[github.PRKey(info.Owner, info.Repo, info.Number)]
+			fmt.Fprintf(w, "%s\t%s/%s#%d\t%s\t%s\n", statusSymbol(status), info.Owner, info.Repo, info.Number,
+				cmp.Or(string(info.ReviewState), "-"), info.Title)
+		}
+	case "notifications":
+		now := time.Now()
+		for _, n := range unread {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", n.Repository.FullName, n.Subject.Type, n.Reason,
+				shortDuration(now.Sub(n.UpdatedAt)), n.Subject.Title)
+		}
+	}
+	return nil
+}
+
+// statusSymbol returns a one-character CI status for terminal output.
+func statusSymbol(s github.PRStatus) string {
+	switch s {
+	case github.PRStatusSuccess:
+		return "✓"
+	case github.PRStatusFailure:
+		return "✗"
+	case github.PRStatusPending:
+		return "●"
+	default:
+		return "-"
+	}
+}
+
+// shortDuration formats an age like "5m", "3h" or "2d".
+func shortDuration(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
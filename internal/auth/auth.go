@@ -7,15 +7,32 @@ import (
 	"strings"
 )
 
+// TokenSteps are the instructions for creating a GitHub Personal Access
+// Token, shown when hubell needs a token and when one has expired.
+var TokenSteps = []string{
+	"Visit: https://github.com/settings/tokens/new",
+	"Add a note (e.g., 'hubell')",
+	"Select scope: 'notifications'",
+	"Click 'Generate token'",
+	"Copy the token and paste it below",
+}
+
+// CleanToken trims a pasted token and rejects an empty one.
+func CleanToken(token string) (string, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("token cannot be empty")
+	}
+	return token, nil
+}
+
 // PromptForToken prompts the user to create and enter a GitHub Personal Access Token
 func PromptForToken() (string, error) {
 	fmt.Println("\n=== GitHub Personal Access Token Required ===")
 	fmt.Println("\nTo create a token:")
-	fmt.Println("  1. Visit: https://github.com/settings/tokens/new")
-	fmt.Println("  2. Add a note (e.g., 'hubell')")
-	fmt.Println("  3. Select scope: 'notifications'")
-	fmt.Println("  4. Click 'Generate token'")
-	fmt.Println("  5. Copy the token and paste it below")
+	for i, step := range TokenSteps {
+		fmt.Printf("  %d. %s\n", i+1, step)
+	}
 	fmt.Println("\nAlternatively, set the GITHUB_TOKEN environment variable.")
 	fmt.Print("\nEnter your GitHub token: ")
 
@@ -25,10 +42,5 @@ func PromptForToken() (string, error) {
 		return "", fmt.Errorf("failed to read token: %w", err)
 	}
 
-	token = strings.TrimSpace(token)
-	if token == "" {
-		return "", fmt.Errorf("token cannot be empty")
-	}
-
-	return token, nil
+	return CleanToken(token)
 }
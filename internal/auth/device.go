@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	deviceCodeURL  = "https://github.com/login/device/code"
+	accessTokenURL = "https://github.com/login/oauth/access_token"
+	deviceGrant    = "urn:ietf:params:oauth:grant-type:device_code"
+)
+
+// DeviceScopes are the scopes requested by the device flow: the same ones
+// the token instructions ask for, plus repo and read:org for private PRs and
+// the org dashboard.
+var DeviceScopes = []string{"notifications", "repo", "read:org"}
+
+// ClientID returns the OAuth app client ID used for the device flow, from
+// HUBELL_CLIENT_ID. The app must have device flow enabled.
+func ClientID() string {
+	return os.Getenv("HUBELL_CLIENT_ID")
+}
+
+// DeviceCode is a pending device flow authorization.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// RequestDeviceCode starts the device flow for the OAuth app clientID.
+func RequestDeviceCode(ctx context.Context, clientID string) (*DeviceCode, error) {
+	var dc DeviceCode
+	err := postForm(ctx, deviceCodeURL, url.Values{
+		"client_id": {clientID},
+		"scope":     {strings.Join(DeviceScopes, " ")},
+	}, &dc)
+	if err != nil {
+		return nil, fmt.Errorf("request device code: %w", err)
+	}
+	if dc.DeviceCode == "" {
+		return nil, fmt.Errorf("request device code: empty response")
+	}
+	return &dc, nil
+}
+
+// PollDeviceToken waits for the user to authorize dc and returns the access
+// token, polling at the interval GitHub asks for.
+func PollDeviceToken(ctx context.Context, clientID string, dc *DeviceCode) (string, error) {
+	interval := time.Duration(max(dc.Interval, 5)) * time.Second
+	deadline := time.Now().Add(time.Duration(dc.ExpiresIn) * time.Second)
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(interval):
+		}
+		if dc.ExpiresIn > 0 && time.Now().After(deadline) {
+			return "", errors.New("device code expired, run login again")
+		}
+
+		var resp struct {
+			AccessToken string `json:"access_token"`
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+			Interval    int    `json:"interval"`
+		}
+		err := postForm(ctx, accessTokenURL, url.Values{
+			"client_id":   {clientID},
+			"device_code": {dc.DeviceCode},
+			"grant_type":  {deviceGrant},
+		}, &resp)
+		if err != nil {
+			return "", fmt.Errorf("poll for token: %w", err)
+		}
+		switch resp.Error {
+		case "":
+			return resp.AccessToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval = time.Duration(max(resp.Interval, dc.Interval+5)) * time.Second
+		case "expired_token":
+			return "", errors.New("device code expired, run login again")
+		case "access_denied":
+			return "", errors.New("authorization was denied")
+		default:
+			return "", fmt.Errorf("%s: %s", resp.Error, resp.Description)
+		}
+	}
+}
+
+// postForm posts form to u and decodes the JSON response into out.
+func postForm(ctx context.Context, u string, form url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", u, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
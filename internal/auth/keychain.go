@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+)
+
+// keychainService is the service name hubell tokens are filed under in the
+// OS keychain, e.g. by a credential helper.
+const keychainService = "hubell"
+
+// DeleteFromKeychain removes any hubell token from the OS keychain: the
+// macOS login keychain or the freedesktop Secret Service. A missing entry or
+// keychain tool is not an error.
+func DeleteFromKeychain() error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "delete-generic-password", "-s", keychainService)
+	case "linux":
+		cmd = exec.Command("secret-tool", "clear", "service", keychainService)
+	default:
+		return nil
+	}
+	if cmd.Err != nil {
+		// Tool not installed
+		return nil
+	}
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		// security exits non-zero when there is no such item
+		return nil
+	}
+	return err
+}
@@ -2,12 +2,76 @@ package browser
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 )
 
-// Open opens the specified URL in the default browser
+// Modes controlling whether URLs are opened locally or handed to the
+// fallback, e.g. because hubell runs on a remote machine.
+const (
+	// ModeAuto hands URLs to the fallback when running over SSH.
+	ModeAuto = "auto"
+	// ModeLocal always runs the platform opener.
+	ModeLocal = "local"
+	// ModePrint always hands URLs to the fallback.
+	ModePrint = "print"
+)
+
+var (
+	// command is a user-configured open command; empty uses the platform
+	// opener.
+	command string
+	// mode is one of the Mode constants.
+	mode = ModeAuto
+	// fallback receives URLs that are not opened locally.
+	fallback = func(url string) { fmt.Fprintln(os.Stderr, url) }
+)
+
+// SetCommand configures a custom command used to open URLs, e.g.
+// "firefox --new-tab %s" or "ssh laptop open %s". The URL replaces %s, or is
+// appended when the command has no %s. On Unix the command runs through sh,
+// so pipes and redirects work; the URL is always passed as a single
+// argument and never interpreted by the shell.
+func SetCommand(cmd string) {
+	command = strings.TrimSpace(cmd)
+}
+
+// SetMode sets one of the Mode constants; unknown modes mean ModeAuto.
+func SetMode(m string) {
+	switch m {
+	case ModeLocal, ModePrint:
+		mode = m
+	default:
+		mode = ModeAuto
+	}
+}
+
+// SetFallback sets the function that receives URLs which are not opened on
+// this machine, typically to show them to the user as a clickable link. It
+// must not block.
+func SetFallback(fn func(url string)) {
+	fallback = fn
+}
+
+// Remote reports whether hubell is running in an SSH session.
+func Remote() bool {
+	return os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_CLIENT") != "" || os.Getenv("SSH_TTY") != ""
+}
+
+// Open opens the specified URL in the default browser. A configured command
+// always wins; otherwise, in print mode or over SSH in auto mode, the URL is
+// passed to the fallback instead since a browser on this host is of no use.
 func Open(url string) error {
+	if command != "" {
+		return customCommand(url).Start()
+	}
+	if mode == ModePrint || (mode == ModeAuto && Remote()) {
+		fallback(url)
+		return nil
+	}
+
 	var cmd *exec.Cmd
 
 	switch runtime.GOOS {
@@ -23,3 +87,29 @@ func Open(url string) error {
 
 	return cmd.Start()
 }
+
+// customCommand builds the configured open command for url.
+func customCommand(url string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		args := strings.Fields(command)
+		replaced := false
+		for i, a := range args {
+			if strings.Contains(a, "%s") {
+				args[i] = strings.ReplaceAll(a, "%s", url)
+				replaced = true
+			}
+		}
+		if !replaced {
+			args = append(args, url)
+		}
+		return exec.Command(args[0], args[1:]...)
+	}
+
+	script := command
+	if strings.Contains(script, "%s") {
+		script = strings.ReplaceAll(script, "%s", `"$1"`)
+	} else {
+		script += ` "$1"`
+	}
+	return exec.Command("sh", "-c", script, "hubell", url)
+}
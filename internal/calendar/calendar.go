@@ -0,0 +1,187 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// maxOccurrences bounds recurrence expansion for very old or endless series.
+const maxOccurrences = 10000
+
+// httpClient bounds calendar fetches so a hung server can't stall the
+// daemon's meeting checks.
+var httpClient = &http.Client{Timeout: 15 * time.Second}
+
+// Source describes where to read calendar data from: a local ICS file, or a
+// URL serving iCalendar data (an ICS feed or a CalDAV calendar export).
+type Source struct {
+	ICSFile  string
+	URL      string
+	Username string
+	Password string
+}
+
+// Fetch reads and parses the events from the source.
+func Fetch(ctx context.Context, src Source) ([]Event, error) {
+	if src.ICSFile != "" {
+		f, err := os.Open(src.ICSFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return Parse(f)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", src.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if src.Username != "" {
+		req.SetBasicAuth(src.Username, src.Password)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calendar: status %d", resp.StatusCode)
+	}
+	return Parse(resp.Body)
+}
+
+// OccurrenceAt returns the start of the occurrence of e that is in progress
+// at t, if any.
+func (e Event) OccurrenceAt(t time.Time) (time.Time, bool) {
+	if t.Before(e.Start) {
+		return time.Time{}, false
+	}
+	if e.Rule == nil {
+		return e.Start, t.Before(e.Start.Add(e.Duration))
+	}
+
+	var found time.Time
+	e.occurrences(t, func(start time.Time) bool {
+		if !t.Before(start) && t.Before(start.Add(e.Duration)) && !e.excluded(start) {
+			found = start
+			return false
+		}
+		return true
+	})
+	return found, !found.IsZero()
+}
+
+// occurrences calls fn for each occurrence start up to limit, in order,
+// until fn returns false.
+func (e Event) occurrences(limit time.Time, fn func(time.Time) bool) {
+	r := e.Rule
+	n := 0
+	emit := func(start time.Time) bool {
+		if start.After(limit) || (!r.Until.IsZero() && start.After(r.Until)) {
+			return false
+		}
+		n++
+		if r.Count > 0 && n > r.Count {
+			return false
+		}
+		return fn(start)
+	}
+
+	switch {
+	case r.Freq == "DAILY" || len(r.ByDay) == 0:
+		step := r.Interval
+		if r.Freq == "WEEKLY" {
+			step *= 7
+		}
+		for i := 0; i < maxOccurrences; i++ {
+			if !emit(e.Start.AddDate(0, 0, i*step)) {
+				return
+			}
+		}
+	default:
+		// WEEKLY with BYDAY: walk weeks from the Monday of the first occurrence
+		weekStart := e.Start.AddDate(0, 0, -mondayOffset(e.Start.Weekday()))
+		for w := 0; w*len(r.ByDay) < maxOccurrences; w++ {
+			base := weekStart.AddDate(0, 0, 7*r.Interval*w)
+			for _, day := range r.ByDay {
+				start := base.AddDate(0, 0, mondayOffset(day))
+				if start.Before(e.Start) {
+					continue
+				}
+				if !emit(start) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e Event) excluded(start time.Time) bool {
+	for _, ex := range e.ExDates {
+		if ex.Equal(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// mondayOffset returns the number of days from Monday to wd.
+func mondayOffset(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
+
+// Current returns the event in progress at t, if any.
+func Current(events []Event, t time.Time) (Event, bool) {
+	for _, e := range events {
+		if _, ok := e.OccurrenceAt(t); ok {
+			return e, true
+		}
+	}
+	return Event{}, false
+}
+
+// Tracker reloads a calendar periodically and reports when meetings start
+// and end. It is not safe for concurrent use.
+type Tracker struct {
+	src      Source
+	refresh  time.Duration
+	events   []Event
+	loadedAt time.Time
+	meeting  Event
+	in       bool
+}
+
+// NewTracker creates a tracker that re-reads src every refresh interval.
+func NewTracker(src Source, refresh time.Duration) *Tracker {
+	return &Tracker{src: src, refresh: refresh}
+}
+
+// Check reloads the calendar if it is due and reports the meeting in
+// progress at now. ended is true on the first check after a meeting
+// finishes, in which case meeting is the one that just ended. A failed
+// reload keeps the previously loaded events.
+func (t *Tracker) Check(ctx context.Context, now time.Time) (meeting Event, inMeeting, ended bool, err error) {
+	if t.loadedAt.IsZero() || now.Sub(t.loadedAt) >= t.refresh {
+		events, fetchErr := Fetch(ctx, t.src)
+		if fetchErr == nil {
+			t.events = events
+		}
+		err = fetchErr
+		t.loadedAt = now
+	}
+
+	current, in := Current(t.events, now)
+	if t.in && (!in || current.Summary != t.meeting.Summary) {
+		ended = true
+		meeting = t.meeting
+	}
+	t.in = in
+	t.meeting = current
+	if !ended {
+		meeting = current
+	}
+	return meeting, in, ended, err
+}
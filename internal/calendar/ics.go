@@ -0,0 +1,237 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is a busy block parsed from a VEVENT. Recurring events carry their
+// recurrence rule and are expanded on demand by OccurrenceAt.
+type Event struct {
+	Summary  string
+	Start    time.Time
+	Duration time.Duration
+	Rule     *Rule
+	ExDates  []time.Time
+}
+
+// Rule is the subset of RFC 5545 RRULE supported: DAILY and WEEKLY
+// frequencies with INTERVAL, COUNT, UNTIL and (for WEEKLY) BYDAY.
+type Rule struct {
+	Freq     string
+	Interval int
+	Count    int
+	Until    time.Time
+	ByDay    []time.Weekday
+}
+
+// Parse reads VEVENTs from an iCalendar stream. All-day, cancelled and
+// transparent (free) events are skipped since they are not meetings.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events  []Event
+		cur     *Event
+		end     time.Time
+		skip    bool
+		allDay  bool
+		inEvent bool
+	)
+	for _, line := range lines {
+		name, params, value := splitProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			cur, end, skip, allDay, inEvent = &Event{}, time.Time{}, false, false, true
+			continue
+		case name == "END" && value == "VEVENT":
+			if cur != nil && !skip && !allDay && !cur.Start.IsZero() {
+				if cur.Duration == 0 && end.After(cur.Start) {
+					cur.Duration = end.Sub(cur.Start)
+				}
+				if cur.Duration > 0 {
+					events = append(events, *cur)
+				}
+			}
+			cur, inEvent = nil, false
+			continue
+		}
+		if !inEvent || cur == nil {
+			continue
+		}
+
+		switch name {
+		case "SUMMARY":
+			cur.Summary = unescapeText(value)
+		case "DTSTART":
+			t, dateOnly, ok := parseTime(value, params)
+			if ok {
+				cur.Start = t
+				allDay = dateOnly
+			}
+		case "DTEND":
+			if t, _, ok := parseTime(value, params); ok {
+				end = t
+			}
+		case "DURATION":
+			cur.Duration = parseDuration(value)
+		case "RRULE":
+			cur.Rule = parseRule(value)
+		case "EXDATE":
+			for v := range strings.SplitSeq(value, ",") {
+				if t, _, ok := parseTime(v, params); ok {
+					cur.ExDates = append(cur.ExDates, t)
+				}
+			}
+		case "STATUS":
+			if value == "CANCELLED" {
+				skip = true
+			}
+		case "TRANSP":
+			if value == "TRANSPARENT" {
+				skip = true
+			}
+		}
+	}
+	return events, nil
+}
+
+// unfold joins RFC 5545 folded lines (continuations start with a space or tab).
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitProperty splits "NAME;PARAM=x;PARAM2=y:VALUE".
+func splitProperty(line string) (string, map[string]string, string) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", nil, ""
+	}
+	parts := strings.Split(head, ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, value
+}
+
+// parseTime parses a DATE or DATE-TIME value, honoring TZID. The second
+// result reports whether the value was a date without a time.
+func parseTime(value string, params map[string]string) (time.Time, bool, bool) {
+	value = strings.TrimSpace(value)
+	if params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.ParseInLocation("20060102", value, time.Local)
+		return t, true, err == nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err == nil
+	}
+	loc := time.Local
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err == nil
+}
+
+// parseDuration parses an RFC 5545 duration such as PT30M or P1DT2H.
+func parseDuration(value string) time.Duration {
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "+"), "P")
+	var d time.Duration
+	inTime := false
+	num := ""
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			num += string(r)
+		case r == 'T':
+			inTime = true
+		default:
+			n, _ := strconv.Atoi(num)
+			num = ""
+			switch {
+			case r == 'W':
+				d += time.Duration(n) * 7 * 24 * time.Hour
+			case r == 'D':
+				d += time.Duration(n) * 24 * time.Hour
+			case r == 'H' && inTime:
+				d += time.Duration(n) * time.Hour
+			case r == 'M' && inTime:
+				d += time.Duration(n) * time.Minute
+			case r == 'S' && inTime:
+				d += time.Duration(n) * time.Second
+			}
+		}
+	}
+	return d
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// parseRule parses an RRULE value. Unsupported frequencies yield nil, which
+// treats the event as a single occurrence.
+func parseRule(value string) *Rule {
+	r := &Rule{Interval: 1}
+	for part := range strings.SplitSeq(value, ";") {
+		k, v, _ := strings.Cut(part, "=")
+		switch k {
+		case "FREQ":
+			r.Freq = v
+		case "INTERVAL":
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				r.Interval = n
+			}
+		case "COUNT":
+			r.Count, _ = strconv.Atoi(v)
+		case "UNTIL":
+			if t, _, ok := parseTime(v, nil); ok {
+				r.Until = t
+			}
+		case "BYDAY":
+			for day := range strings.SplitSeq(v, ",") {
+				// Drop ordinal prefixes such as "1MO"; they only apply to MONTHLY
+				day = strings.TrimLeft(day, "+-0123456789")
+				if wd, ok := weekdays[day]; ok {
+					r.ByDay = append(r.ByDay, wd)
+				}
+			}
+		}
+	}
+	if r.Freq != "DAILY" && r.Freq != "WEEKLY" {
+		return nil
+	}
+	slices.SortFunc(r.ByDay, func(a, b time.Weekday) int {
+		return mondayOffset(a) - mondayOffset(b)
+	})
+	return r
+}
+
+func unescapeText(s string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(s)
+}
@@ -0,0 +1,163 @@
+package calendar
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  *Rule
+	}{
+		{"daily", "FREQ=DAILY", &Rule{Freq: "DAILY", Interval: 1}},
+		{"daily interval count", "FREQ=DAILY;INTERVAL=2;COUNT=5", &Rule{Freq: "DAILY", Interval: 2, Count: 5}},
+		{"invalid interval", "FREQ=DAILY;INTERVAL=0", &Rule{Freq: "DAILY", Interval: 1}},
+		{
+			"weekly until",
+			"FREQ=WEEKLY;UNTIL=20240131T235959Z",
+			&Rule{Freq: "WEEKLY", Interval: 1, Until: time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC)},
+		},
+		{
+			"byday sorted from monday",
+			"FREQ=WEEKLY;BYDAY=SU,FR,MO",
+			&Rule{Freq: "WEEKLY", Interval: 1, ByDay: []time.Weekday{time.Monday, time.Friday, time.Sunday}},
+		},
+		{
+			"byday ordinals and unknown days dropped",
+			"FREQ=WEEKLY;BYDAY=1TU,XX,-1TH",
+			&Rule{Freq: "WEEKLY", Interval: 1, ByDay: []time.Weekday{time.Tuesday, time.Thursday}},
+		},
+		{"monthly unsupported", "FREQ=MONTHLY;BYDAY=1MO", nil},
+		{"yearly unsupported", "FREQ=YEARLY", nil},
+		{"no freq", "INTERVAL=2", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRule(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseRule(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	// Monday 1 January 2024, 09:00 UTC
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 9, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name  string
+		start time.Time
+		rule  string
+		want  []time.Time
+	}{
+		{"daily count", start, "FREQ=DAILY;COUNT=3", []time.Time{day(1), day(2), day(3)}},
+		{"daily interval", start, "FREQ=DAILY;INTERVAL=2;COUNT=3", []time.Time{day(1), day(3), day(5)}},
+		{"daily until", start, "FREQ=DAILY;UNTIL=20240103T090000Z", []time.Time{day(1), day(2), day(3)}},
+		{"weekly interval", start, "FREQ=WEEKLY;INTERVAL=2;COUNT=3", []time.Time{day(1), day(15), day(29)}},
+		{"weekly byday", start, "FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=4", []time.Time{day(1), day(3), day(5), day(8)}},
+		{
+			"weekly byday interval until",
+			day(2),
+			"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;UNTIL=20240119T000000Z",
+			[]time.Time{day(2), day(4), day(16), day(18)},
+		},
+		{
+			// Days before DTSTART in the first week aren't occurrences and
+			// don't count towards COUNT
+			"weekly byday before start",
+			day(3),
+			"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=3",
+			[]time.Time{day(3), day(8), day(10)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Event{Start: tt.start, Duration: time.Hour, Rule: parseRule(tt.rule)}
+			var got []time.Time
+			e.occurrences(tt.start.AddDate(0, 3, 0), func(s time.Time) bool {
+				got = append(got, s)
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("occurrences of %q = %v, want %v", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+
+	const ics = "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Standup\\, daily\r\n" +
+		"DTSTART;TZID=America/New_York:20240101T090000\r\n" +
+		"DTEND;TZID=America/New_York:20240101T091500\r\n" +
+		"RRULE:FREQ=DAILY;COUNT=5\r\n" +
+		"EXDATE;TZID=America/New_York:20240103T090000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Monthly review\r\n" +
+		"DTSTART:20240102T150000Z\r\n" +
+		"DURATION:PT1H\r\n" +
+		"RRULE:FREQ=MONTHLY;BYDAY=1TU\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"SUMMARY:Holiday\r\n" +
+		"DTSTART;VALUE=DATE:20240101\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse(strings.NewReader(ics))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Parse returned %d events, want 2 (all-day event skipped)", len(events))
+	}
+
+	standup, monthly := events[0], events[1]
+	if standup.Summary != "Standup, daily" {
+		t.Errorf("summary = %q", standup.Summary)
+	}
+	if want := time.Date(2024, 1, 1, 9, 0, 0, 0, ny); !standup.Start.Equal(want) || standup.Start.Location().String() != ny.String() {
+		t.Errorf("start = %v, want %v", standup.Start, want)
+	}
+	if standup.Duration != 15*time.Minute {
+		t.Errorf("duration = %v, want 15m", standup.Duration)
+	}
+
+	// An unsupported FREQ leaves the event as a single occurrence
+	if monthly.Rule != nil {
+		t.Errorf("monthly rule = %+v, want nil", monthly.Rule)
+	}
+
+	tests := []struct {
+		name string
+		e    Event
+		at   time.Time
+		want bool
+	}{
+		{"standup in progress", standup, time.Date(2024, 1, 2, 14, 5, 0, 0, time.UTC), true},
+		{"standup excluded date", standup, time.Date(2024, 1, 3, 9, 5, 0, 0, ny), false},
+		{"standup after count", standup, time.Date(2024, 1, 6, 9, 5, 0, 0, ny), false},
+		{"standup after it ends", standup, time.Date(2024, 1, 2, 9, 15, 0, 0, ny), false},
+		{"monthly first occurrence", monthly, time.Date(2024, 1, 2, 15, 30, 0, 0, time.UTC), true},
+		{"monthly not repeated", monthly, time.Date(2024, 2, 6, 15, 30, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := tt.e.OccurrenceAt(tt.at); got != tt.want {
+				t.Errorf("OccurrenceAt(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
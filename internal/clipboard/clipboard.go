@@ -0,0 +1,53 @@
+package clipboard
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnavailable is returned when no clipboard tool is installed.
+var ErrUnavailable = errors.New("no clipboard tool found")
+
+// Copy places text on the system clipboard using the platform's clipboard
+// tool: pbcopy on macOS, clip on Windows, and wl-copy, xclip or xsel on
+// Linux (clip.exe under WSL). It complements OSC 52, which only works in
+// terminals that support it.
+func Copy(text string) error {
+	for _, args := range commands() {
+		if _, err := exec.LookPath(args[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return ErrUnavailable
+}
+
+// commands returns candidate clipboard commands in order of preference.
+func commands() [][]string {
+	switch runtime.GOOS {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		return [][]string{{"clip"}}
+	}
+
+	var cmds [][]string
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		cmds = append(cmds, []string{"wl-copy"})
+	}
+	if os.Getenv("DISPLAY") != "" {
+		cmds = append(cmds,
+			[]string{"xclip", "-selection", "clipboard"},
+			[]string{"xsel", "--clipboard", "--input"},
+		)
+	}
+	if os.Getenv("WSL_DISTRO_NAME") != "" {
+		cmds = append(cmds, []string{"clip.exe"})
+	}
+	return cmds
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Account is an additional GitHub account, e.g. a personal account next to
+// the work one or a GitHub Enterprise Server login.
+type Account struct {
+	Name string `json:"name"`
+	// Token is the account's token; TokenEnv names an environment variable
+	// holding it instead, to keep the token out of the file.
+	Token    string `json:"token,omitempty"`
+	TokenEnv string `json:"token_env,omitempty"`
+	// BaseURL is the API root for GitHub Enterprise Server, e.g.
+	// "https://github.example.com/api/v3". Empty means github.com.
+	BaseURL string `json:"base_url,omitempty"`
+}
+
+// ResolveToken returns the account's token, read from TokenEnv when set.
+func (a Account) ResolveToken() string {
+	if a.TokenEnv != "" {
+		return os.Getenv(a.TokenEnv)
+	}
+	return a.Token
+}
+
+// AccountsSettings lists the accounts hubell can switch to besides the one
+// from GITHUB_TOKEN or the saved token.
+type AccountsSettings struct {
+	Accounts []Account `json:"accounts"`
+}
+
+func accountsPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "accounts.json")
+}
+
+// LoadAccountsSettings reads additional accounts from accounts.json. Returns
+// no accounts with no error if the file does not exist.
+func LoadAccountsSettings() (AccountsSettings, error) {
+	p := accountsPath()
+	if p == "" {
+		return AccountsSettings{}, nil
+	}
+	data, p, err := readSettings("accounts", p)
+	if os.IsNotExist(err) {
+		return AccountsSettings{}, nil
+	}
+	if err != nil {
+		return AccountsSettings{}, err
+	}
+	var s AccountsSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return AccountsSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	for i, a := range s.Accounts {
+		if a.Name == "" {
+			return AccountsSettings{}, fmt.Errorf("%s: account %d has no name", p, i+1)
+		}
+		if a.ResolveToken() == "" {
+			return AccountsSettings{}, fmt.Errorf("%s: account %q has no token", p, a.Name)
+		}
+	}
+	return s, nil
+}
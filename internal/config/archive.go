@@ -0,0 +1,47 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jpoz/hubell/internal/github"
+	"github.com/jpoz/hubell/internal/store"
+)
+
+// ArchivedNotification is a notification marked read or done, kept in the
+// archive across sessions.
+type ArchivedNotification struct {
+	Notification github.Notification `json:"notification"`
+	ReadAt       time.Time           `json:"read_at"`
+	Done         bool                `json:"done"`
+}
+
+func loadArchives() map[string][]ArchivedNotification {
+	archives := make(map[string][]ArchivedNotification)
+	s, err := store.Default()
+	if err != nil {
+		return archives
+	}
+	if _, err := s.Get(store.KeyArchive, &archives); err != nil {
+		return make(map[string][]ArchivedNotification)
+	}
+	return archives
+}
+
+// LoadArchive reads the archived notifications of username, newest first.
+// Returns nil on error.
+func LoadArchive(username string) []ArchivedNotification {
+	return loadArchives()[strings.ToLower(username)]
+}
+
+// SaveArchive writes the archived notifications of username, keeping other
+// accounts' archives intact.
+func SaveArchive(username string, archive []ArchivedNotification) error {
+	s, err := store.Default()
+	if err != nil {
+		return err
+	}
+	archives := loadArchives()
+	archives[strings.ToLower(username)] = archive
+	return s.Put(store.KeyArchive, archives)
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ArtifactSettings controls where CI artifacts are downloaded to.
+type ArtifactSettings struct {
+	DownloadDir string `json:"download_dir"`
+}
+
+func artifactsPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "artifacts.json")
+}
+
+// defaultDownloadDir is ~/Downloads, or the working directory if the home
+// directory is unknown.
+func defaultDownloadDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "."
+	}
+	return filepath.Join(home, "Downloads")
+}
+
+// LoadArtifactSettings reads artifact settings from artifacts.json. Returns
+// settings downloading to ~/Downloads with no error if the file does not
+// exist.
+func LoadArtifactSettings() (ArtifactSettings, error) {
+	defaults := ArtifactSettings{DownloadDir: defaultDownloadDir()}
+	p := artifactsPath()
+	if p == "" {
+		return defaults, nil
+	}
+	data, p, err := readSettings("artifacts", p)
+	if os.IsNotExist(err) {
+		return defaults, nil
+	}
+	if err != nil {
+		return defaults, err
+	}
+	var s ArtifactSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return defaults, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if s.DownloadDir == "" {
+		return defaults, nil
+	}
+	if strings.HasPrefix(s.DownloadDir, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			s.DownloadDir = filepath.Join(home, s.DownloadDir[2:])
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jpoz/hubell/internal/store"
+)
+
+// AttentionSettings controls tracking where my review time goes.
+type AttentionSettings struct {
+	// Enabled records how long the selection rests on each repo's items and
+	// which PRs are opened, for the dashboard's attention report.
+	Enabled bool `json:"enabled"`
+}
+
+func attentionPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "attention.json")
+}
+
+// LoadAttentionSettings reads attention tracking settings. Returns tracking
+// off with no error if the file does not exist.
+func LoadAttentionSettings() (AttentionSettings, error) {
+	p := attentionPath()
+	if p == "" {
+		return AttentionSettings{}, nil
+	}
+	data, p, err := readSettings("attention", p)
+	if os.IsNotExist(err) {
+		return AttentionSettings{}, nil
+	}
+	if err != nil {
+		return AttentionSettings{}, err
+	}
+	var s AttentionSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return AttentionSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	return s, nil
+}
+
+// AttentionWeek is where attention went during one ISO week.
+type AttentionWeek struct {
+	// Seconds is how long the selection rested on items of each repo
+	// ("owner/repo").
+	Seconds map[string]int `json:"seconds"`
+	// Opened counts the PRs opened in the browser, by "owner/repo#number".
+	Opened map[string]int `json:"opened"`
+}
+
+// AttentionStats holds attention per week, keyed by WeekKey.
+type AttentionStats struct {
+	Weeks map[string]AttentionWeek `json:"weeks"`
+}
+
+// Week returns the stats of the week containing t, creating them if needed.
+func (a *AttentionStats) Week(t time.Time) AttentionWeek {
+	if a.Weeks == nil {
+		a.Weeks = make(map[string]AttentionWeek)
+	}
+	k := WeekKey(t)
+	w, ok := a.Weeks[k]
+	if !ok {
+		w = AttentionWeek{Seconds: make(map[string]int), Opened: make(map[string]int)}
+		a.Weeks[k] = w
+	}
+	return w
+}
+
+// LoadAttention reads the attention stats. Returns empty stats on error.
+func LoadAttention() AttentionStats {
+	s, err := store.Default()
+	if err != nil {
+		return AttentionStats{}
+	}
+	var stats AttentionStats
+	if _, err := s.Get(store.KeyAttention, &stats); err != nil {
+		return AttentionStats{}
+	}
+	return stats
+}
+
+// SaveAttention saves the attention stats, pruning weeks older than 26
+// weeks.
+func SaveAttention(stats AttentionStats) error {
+	s, err := store.Default()
+	if err != nil {
+		return err
+	}
+	cutoffKey := WeekKey(time.Now().AddDate(0, 0, -26*7))
+	for k := range stats.Weeks {
+		if k < cutoffKey {
+			delete(stats.Weeks, k)
+		}
+	}
+	return s.Put(store.KeyAttention, stats)
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// MergeMethods are the merge methods auto-merge can use.
+var MergeMethods = []string{"merge", "squash", "rebase"}
+
+// AutoMergeSettings sets the merge method preselected when arming
+// auto-merge on a PR. Repos overrides Method per "owner/repo".
+type AutoMergeSettings struct {
+	Method string            `json:"method,omitempty"`
+	Repos  map[string]string `json:"repos,omitempty"`
+}
+
+// defaultMergeMethod matches GitHub's own default.
+const defaultMergeMethod = "merge"
+
+// MethodFor returns the merge method to preselect for a repo.
+func (s AutoMergeSettings) MethodFor(owner, repo string) string {
+	if m, ok := s.Repos[owner+"/"+repo]; ok {
+		return m
+	}
+	if s.Method != "" {
+		return s.Method
+	}
+	return defaultMergeMethod
+}
+
+func autoMergePath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "auto_merge.json")
+}
+
+// LoadAutoMergeSettings reads auto-merge settings from auto_merge.json.
+// Returns default settings (merge commits everywhere) with no error if the
+// file does not exist.
+func LoadAutoMergeSettings() (AutoMergeSettings, error) {
+	p := autoMergePath()
+	if p == "" {
+		return AutoMergeSettings{}, nil
+	}
+	data, p, err := readSettings("auto_merge", p)
+	if os.IsNotExist(err) {
+		return AutoMergeSettings{}, nil
+	}
+	if err != nil {
+		return AutoMergeSettings{}, err
+	}
+	var s AutoMergeSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return AutoMergeSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if s.Method != "" && !slices.Contains(MergeMethods, s.Method) {
+		return AutoMergeSettings{}, fmt.Errorf("%s: unknown merge method %q (want merge, squash or rebase)", p, s.Method)
+	}
+	for repo, m := range s.Repos {
+		if !slices.Contains(MergeMethods, m) {
+			return AutoMergeSettings{}, fmt.Errorf("%s: %s: unknown merge method %q (want merge, squash or rebase)", p, repo, m)
+		}
+	}
+	return s, nil
+}
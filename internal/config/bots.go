@@ -0,0 +1,64 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// BotSettings configures which accounts are bots and whether the org
+// dashboard lists their activity.
+type BotSettings struct {
+	// Patterns are bot login patterns, where "*" matches any run of
+	// characters and case is ignored (e.g. "*[bot]", "release-*"). Unset
+	// keeps github.DefaultBotPatterns; an empty list means no bots.
+	Patterns []string `json:"patterns"`
+	// Show lists bot activity in the org dashboard instead of hiding it.
+	Show bool `json:"show"`
+}
+
+func botsPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "bots.json")
+}
+
+// LoadBotSettings reads bot settings from bots.json. Returns the zero value
+// (default patterns, bots hidden) if the file is missing or invalid.
+func LoadBotSettings() BotSettings {
+	p := botsPath()
+	if p == "" {
+		return BotSettings{}
+	}
+	data, _, err := readSettings("bots", p)
+	if err != nil {
+		return BotSettings{}
+	}
+	var s BotSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return BotSettings{}
+	}
+	return s
+}
+
+// SaveBotSettings writes bot settings to bots.json.
+func SaveBotSettings(s BotSettings) error {
+	p := botsPath()
+	if p == "" {
+		return nil
+	}
+	if err := writeSettings("bots", s); err != errNoConfigFile {
+		return err
+	}
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0600)
+}
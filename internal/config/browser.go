@@ -0,0 +1,50 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// BrowserSettings controls how URLs are opened.
+type BrowserSettings struct {
+	// Command opens a URL instead of the platform default, e.g.
+	// "firefox --new-tab %s" or a script that forwards the URL to another
+	// machine over SSH. %s is replaced by the URL, which is appended if %s
+	// is absent.
+	Command string `json:"command"`
+	// Remote is "auto" (default: show URLs as links instead of opening a
+	// browser when running over SSH), "print" (always) or "local" (never).
+	Remote string `json:"remote,omitempty"`
+}
+
+func browserPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "browser.json")
+}
+
+// LoadBrowserSettings reads browser settings from browser.json. Returns empty
+// settings (the platform default opener) with no error if the file does not
+// exist.
+func LoadBrowserSettings() (BrowserSettings, error) {
+	p := browserPath()
+	if p == "" {
+		return BrowserSettings{}, nil
+	}
+	data, p, err := readSettings("browser", p)
+	if os.IsNotExist(err) {
+		return BrowserSettings{}, nil
+	}
+	if err != nil {
+		return BrowserSettings{}, err
+	}
+	var s BrowserSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return BrowserSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	return s, nil
+}
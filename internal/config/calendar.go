@@ -0,0 +1,83 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jpoz/hubell/internal/calendar"
+)
+
+// CalendarSettings configures meeting mode: while a calendar event is in
+// progress notifications are held back and delivered as a digest afterwards.
+// Set ICSFile to a local .ics file, or URL to an ICS feed / CalDAV export.
+type CalendarSettings struct {
+	ICSFile        string `json:"ics_file,omitempty"`
+	URL            string `json:"url,omitempty"`
+	Username       string `json:"username,omitempty"`
+	Password       string `json:"password,omitempty"`
+	RefreshMinutes int    `json:"refresh_minutes,omitempty"`
+}
+
+// Enabled reports whether a calendar source is configured.
+func (c CalendarSettings) Enabled() bool {
+	return c.ICSFile != "" || c.URL != ""
+}
+
+// Source converts the settings into a calendar source.
+func (c CalendarSettings) Source() calendar.Source {
+	return calendar.Source{
+		ICSFile:  c.ICSFile,
+		URL:      c.URL,
+		Username: c.Username,
+		Password: c.Password,
+	}
+}
+
+// RefreshInterval returns how often the calendar is re-read.
+func (c CalendarSettings) RefreshInterval() time.Duration {
+	return time.Duration(c.RefreshMinutes) * time.Minute
+}
+
+func calendarPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "calendar.json")
+}
+
+// LoadCalendarSettings reads meeting mode settings from calendar.json.
+// Returns empty settings with no error if the file does not exist.
+func LoadCalendarSettings() (CalendarSettings, error) {
+	p := calendarPath()
+	if p == "" {
+		return CalendarSettings{}, nil
+	}
+	data, p, err := readSettings("calendar", p)
+	if os.IsNotExist(err) {
+		return CalendarSettings{}, nil
+	}
+	if err != nil {
+		return CalendarSettings{}, err
+	}
+	var c CalendarSettings
+	if err := json.Unmarshal(data, &c); err != nil {
+		return CalendarSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if c.ICSFile != "" && c.URL != "" {
+		return CalendarSettings{}, fmt.Errorf("%s: set only one of ics_file or url", p)
+	}
+	if strings.HasPrefix(c.ICSFile, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			c.ICSFile = filepath.Join(home, c.ICSFile[2:])
+		}
+	}
+	if c.RefreshMinutes <= 0 {
+		c.RefreshMinutes = 15
+	}
+	return c, nil
+}
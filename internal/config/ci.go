@@ -0,0 +1,73 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// CISettings controls how PR CI status is computed from check runs.
+type CISettings struct {
+	// RequiredOnly computes PR status from branch protection's required
+	// status checks only, so optional checks cannot mark a PR as failing.
+	RequiredOnly bool `json:"required_only"`
+	// IgnoreChecks lists check name patterns (e.g. "codecov/*") excluded from
+	// the aggregate status and CI change notifications.
+	IgnoreChecks []string `json:"ignore_checks,omitempty"`
+}
+
+// CIOptions converts the settings into poller options.
+func (s CISettings) CIOptions() github.CIOptions {
+	return github.CIOptions{
+		RequiredOnly: s.RequiredOnly,
+		IgnoreChecks: s.IgnoreChecks,
+	}
+}
+
+func ciPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "ci.json")
+}
+
+// LoadCISettings reads CI settings from ci.json. Returns the zero value
+// (all checks count) if the file is missing or invalid.
+func LoadCISettings() CISettings {
+	p := ciPath()
+	if p == "" {
+		return CISettings{}
+	}
+	data, p, err := readSettings("ci", p)
+	if err != nil {
+		return CISettings{}
+	}
+	var s CISettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return CISettings{}
+	}
+	return s
+}
+
+// SaveCISettings writes CI settings to ci.json.
+func SaveCISettings(s CISettings) error {
+	p := ciPath()
+	if p == "" {
+		return nil
+	}
+	if err := writeSettings("ci", s); err != errNoConfigFile {
+		return err
+	}
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0600)
+}
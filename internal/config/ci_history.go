@@ -0,0 +1,66 @@
+package config
+
+import (
+	"time"
+
+	"github.com/jpoz/hubell/internal/store"
+)
+
+// ciHistoryDays is how many days of CI outcomes are kept.
+const ciHistoryDays = 8 * 7
+
+// CIDay tallies the check runs on my open PRs first seen completed on one
+// day.
+type CIDay struct {
+	Total   int `json:"total"`
+	Success int `json:"success"`
+	Failure int `json:"failure"`
+}
+
+// CIHistory holds per-day CI outcome tallies keyed by local date
+// ("2026-02-14"), and the check run IDs already counted with the day they
+// were counted on, so a run still listed on later polls isn't counted
+// again.
+type CIHistory struct {
+	Days map[string]CIDay `json:"days"`
+	Seen map[int]string   `json:"seen"`
+}
+
+// LoadCIHistory reads the CI outcome history. Returns an empty history on
+// error.
+func LoadCIHistory() CIHistory {
+	h := CIHistory{}
+	if s, err := store.Default(); err == nil {
+		_, _ = s.Get(store.KeyCIHistory, &h)
+	}
+	if h.Days == nil {
+		h.Days = make(map[string]CIDay)
+	}
+	if h.Seen == nil {
+		h.Seen = make(map[int]string)
+	}
+	return h
+}
+
+// SaveCIHistory saves the CI outcome history, pruning days and counted runs
+// older than eight weeks.
+func SaveCIHistory(h CIHistory) error {
+	s, err := store.Default()
+	if err != nil {
+		return err
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -ciHistoryDays).Format(time.DateOnly)
+	for day := range h.Days {
+		if day < cutoff {
+			delete(h.Days, day)
+		}
+	}
+	for id, day := range h.Seen {
+		if day < cutoff {
+			delete(h.Seen, id)
+		}
+	}
+
+	return s.Put(store.KeyCIHistory, h)
+}
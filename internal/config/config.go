@@ -2,44 +2,50 @@ package config
 
 import (
 	"os"
-	"path/filepath"
-	"strings"
+
+	"github.com/jpoz/hubell/internal/store"
 )
 
-func themePath() string {
-	configDir := os.Getenv("XDG_CONFIG_HOME")
-	if configDir == "" {
-		home, err := os.UserHomeDir()
-		if err != nil {
+// LoadTheme reads the saved theme name. Returns empty string if not found.
+// config.toml owns the theme; the store key is only read before config.toml
+// exists, so Load can move it there.
+func LoadTheme() string {
+	var name string
+	if readValue("theme", &name) {
+		return name
+	}
+	if p := configPath(); p != "" {
+		if _, err := os.Stat(p); err == nil {
 			return ""
 		}
-		configDir = filepath.Join(home, ".config")
 	}
-	return filepath.Join(configDir, "hubell", "theme")
-}
-
-// LoadTheme reads the saved theme name from disk. Returns empty string if not found.
-func LoadTheme() string {
-	p := themePath()
-	if p == "" {
+	s, err := store.Default()
+	if err != nil {
 		return ""
 	}
-	data, err := os.ReadFile(p)
-	if err != nil {
+	if _, err := s.Get(store.KeyTheme, &name); err != nil {
 		return ""
 	}
-	return strings.TrimSpace(string(data))
+	return name
 }
 
-// SaveTheme writes the theme name to disk.
+// SaveTheme saves the theme name to config.toml, or to the store while
+// there is no config.toml yet.
 func SaveTheme(name string) error {
-	p := themePath()
-	if p == "" {
-		return nil
+	if err := writeSettings("theme", name); err != errNoConfigFile {
+		return err
 	}
-	dir := filepath.Dir(p)
-	if err := os.MkdirAll(dir, 0700); err != nil {
+	s, err := store.Default()
+	if err != nil {
 		return err
 	}
-	return os.WriteFile(p, []byte(name+"\n"), 0600)
+	return s.Put(store.KeyTheme, name)
+}
+
+// dropStoredTheme removes the store's copy of the theme once config.toml
+// holds it.
+func dropStoredTheme() {
+	if s, err := store.Default(); err == nil {
+		_ = s.Delete(store.KeyTheme)
+	}
 }
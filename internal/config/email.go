@@ -0,0 +1,71 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// EmailSettings configures email fallback notifications sent by the daemon
+// when no desktop session is available. Events lists which events are
+// considered high priority: "ci_failure", "review_requested", "mention",
+// "assign", "secret_scanning" and "failing_main". The password may be supplied via HUBELL_SMTP_PASSWORD instead of
+// being stored in the file.
+type EmailSettings struct {
+	Enabled  bool     `json:"enabled"`
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	Events   []string `json:"events,omitempty"`
+}
+
+// DefaultEmailEvents are used when email.json does not list any events.
+var DefaultEmailEvents = []string{"ci_failure", "secret_scanning"}
+
+func emailPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "email.json")
+}
+
+// LoadEmailSettings reads email fallback settings from email.json.
+// Returns disabled settings with no error if the file does not exist.
+func LoadEmailSettings() (EmailSettings, error) {
+	p := emailPath()
+	if p == "" {
+		return EmailSettings{}, nil
+	}
+	data, p, err := readSettings("email", p)
+	if os.IsNotExist(err) {
+		return EmailSettings{}, nil
+	}
+	if err != nil {
+		return EmailSettings{}, err
+	}
+	var s EmailSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return EmailSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if !s.Enabled {
+		return s, nil
+	}
+	if s.Host == "" || s.From == "" || len(s.To) == 0 {
+		return EmailSettings{}, fmt.Errorf("%s: host, from and to are required", p)
+	}
+	if s.Port == 0 {
+		s.Port = 587
+	}
+	if pw := os.Getenv("HUBELL_SMTP_PASSWORD"); pw != "" {
+		s.Password = pw
+	}
+	if len(s.Events) == 0 {
+		s.Events = DefaultEmailEvents
+	}
+	return s, nil
+}
@@ -0,0 +1,325 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jpoz/hubell/internal/store"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Config holds the top-level settings of config.toml, hubell's unified
+// config file. Every other setting lives in a table named after the JSON
+// file it replaces (e.g. [layout] for layout.json) and is read by that
+// setting's Load function, which falls back to the JSON file when
+// config.toml doesn't set it.
+type Config struct {
+	// Interval is how often GitHub is polled.
+	Interval time.Duration
+	Org      string
+	Team     string
+	// Orgs are the org dashboards to switch between, each an "org" or
+	// "org/team" scope.
+	Orgs  []string
+	Theme string
+	// Filter is the notification filter the TUI starts with: "my_prs",
+	// "all", "mentions" or "assigned".
+	Filter string
+	// Keys rebinds main view actions, e.g. {"mark_read": ["r", "m"]}.
+	Keys map[string][]string
+	// Icons is the icon set: "unicode", "nerd" or "ascii".
+	Icons string
+}
+
+// DefaultConfig polls every 30 seconds.
+var DefaultConfig = Config{Interval: 30 * time.Second}
+
+// Filters are the notification filters config.toml can start with.
+var Filters = []string{"my_prs", "all", "mentions", "assigned"}
+
+// IconSets are the icon sets config.toml can pick.
+var IconSets = []string{"unicode", "nerd", "ascii"}
+
+// legacySections are the JSON files config.toml replaces, by table name.
+var legacySections = []string{
+	"accounts", "artifacts", "attention", "auto_merge", "bots", "browser", "calendar", "ci", "email", "layout",
+	"main_board", "outbound", "panels", "power", "read", "repo_paths", "rotation", "sort",
+	"tickets", "timezones", "title_lint", "update_branch", "working_hours",
+}
+
+// fileMu serializes rewrites of config.toml.
+var fileMu sync.Mutex
+
+// configDir returns hubell's config directory, the one the store lives in.
+func configDir() string {
+	return store.Dir()
+}
+
+// pathOverride replaces the default config.toml location (--config).
+var pathOverride string
+
+// SetPath makes Load and every setting use the config file at p instead of
+// config.toml in hubell's config directory.
+func SetPath(p string) {
+	pathOverride = p
+}
+
+func configPath() string {
+	if pathOverride != "" {
+		return pathOverride
+	}
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "config.toml")
+}
+
+// Load reads config.toml, first creating it from the older per-setting
+// files when it doesn't exist yet. The older files are left in place.
+func Load() (Config, error) {
+	p := configPath()
+	if p == "" {
+		return DefaultConfig, nil
+	}
+	if _, err := os.Stat(p); os.IsNotExist(err) {
+		if err := migrateLegacyFiles(p); err != nil {
+			return DefaultConfig, fmt.Errorf("create %s: %w", p, err)
+		}
+	}
+	var raw struct {
+		Interval string              `json:"interval"`
+		Org      string              `json:"org"`
+		Team     string              `json:"team"`
+		Orgs     []string            `json:"orgs"`
+		Theme    string              `json:"theme"`
+		Filter   string              `json:"filter"`
+		Keys     map[string][]string `json:"keys"`
+		Icons    string              `json:"icons"`
+	}
+	doc, err := readDocument(p)
+	if err != nil {
+		return DefaultConfig, err
+	}
+	if err := decodeValue(doc, &raw); err != nil {
+		return DefaultConfig, fmt.Errorf("parse %s: %w", p, err)
+	}
+
+	c := DefaultConfig
+	c.Org, c.Team = strings.TrimSpace(raw.Org), strings.TrimSpace(raw.Team)
+	c.Theme, c.Filter, c.Keys, c.Icons = raw.Theme, raw.Filter, raw.Keys, raw.Icons
+	if raw.Interval != "" {
+		d, err := time.ParseDuration(raw.Interval)
+		if err != nil || d < 5*time.Second {
+			return DefaultConfig, fmt.Errorf("%s: interval must be a duration of at least 5s, got %q", p, raw.Interval)
+		}
+		c.Interval = d
+	}
+	if c.Filter != "" && !slices.Contains(Filters, c.Filter) {
+		return DefaultConfig, fmt.Errorf("%s: unknown filter %q", p, c.Filter)
+	}
+	for _, scope := range raw.Orgs {
+		scope = strings.TrimSpace(scope)
+		org, team, _ := strings.Cut(scope, "/")
+		if org == "" || strings.Contains(team, "/") {
+			return DefaultConfig, fmt.Errorf("%s: orgs: want \"org\" or \"org/team\", got %q", p, scope)
+		}
+		if !slices.Contains(c.Orgs, scope) {
+			c.Orgs = append(c.Orgs, scope)
+		}
+	}
+	if c.Icons != "" && !slices.Contains(IconSets, c.Icons) {
+		return DefaultConfig, fmt.Errorf("%s: unknown icons %q, want one of %s", p, c.Icons, strings.Join(IconSets, ", "))
+	}
+	return c, nil
+}
+
+// migrateLegacyFiles writes config.toml at p from the per-setting files and
+// the theme kept in the store, which config.toml owns from then on.
+func migrateLegacyFiles(p string) error {
+	doc := map[string]any{"interval": DefaultConfig.Interval.String()}
+	for _, name := range legacySections {
+		data, err := os.ReadFile(filepath.Join(configDir(), name+".json"))
+		if err != nil || !json.Valid(data) {
+			continue
+		}
+		var v any
+		if err := unmarshalJSON(data, &v); err != nil {
+			continue
+		}
+		if v = tomlValue(v); v != nil {
+			doc[name] = v
+		}
+	}
+	for key, value := range map[string]string{"org": loadLegacyOrg(), "team": loadLegacyTeam(), "theme": LoadTheme()} {
+		if value != "" {
+			doc[key] = value
+		}
+	}
+	if repos := LoadReleasesOnly(); len(repos) > 0 {
+		doc["releases_only"] = slices.Sorted(maps.Keys(repos))
+	}
+	if err := writeDocument(p, doc); err != nil {
+		return err
+	}
+	dropStoredTheme()
+	return nil
+}
+
+// readDocument parses config.toml. A missing file is an empty document.
+func readDocument(p string) (map[string]any, error) {
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	doc := make(map[string]any)
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", p, err)
+	}
+	return doc, nil
+}
+
+// writeDocument writes config.toml. Comments in the previous file are not
+// kept.
+func writeDocument(p string, doc map[string]any) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	data, err := toml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	header := "# hubell settings. Tables replace the older per-setting JSON files.\n\n"
+	return os.WriteFile(p, append([]byte(header), data...), 0600)
+}
+
+// readSettings returns the settings stored under key in config.toml as
+// JSON, so they keep decoding through their json tags, or the contents of
+// legacyPath when config.toml doesn't set key. The returned path names where
+// the data came from, for error messages.
+func readSettings(key, legacyPath string) ([]byte, string, error) {
+	p := configPath()
+	if p == "" {
+		data, err := os.ReadFile(legacyPath)
+		return data, legacyPath, err
+	}
+	doc, err := readDocument(p)
+	if err != nil {
+		return nil, p, err
+	}
+	if v, ok := doc[key]; ok {
+		data, err := json.Marshal(v)
+		return data, fmt.Sprintf("%s [%s]", p, key), err
+	}
+	data, err := os.ReadFile(legacyPath)
+	return data, legacyPath, err
+}
+
+// readValue decodes the setting stored under key in config.toml into v.
+// Returns false when config.toml doesn't set it validly.
+func readValue(key string, v any) bool {
+	p := configPath()
+	if p == "" {
+		return false
+	}
+	doc, err := readDocument(p)
+	if err != nil {
+		return false
+	}
+	value, ok := doc[key]
+	return ok && decodeValue(value, v) == nil
+}
+
+// errNoConfigFile means config.toml doesn't exist, so settings are saved to
+// their own file.
+var errNoConfigFile = errors.New("no config.toml")
+
+// writeSettings stores v under key in config.toml. Returns errNoConfigFile
+// when there is no config.toml.
+func writeSettings(key string, v any) error {
+	p := configPath()
+	if p == "" {
+		return errNoConfigFile
+	}
+	fileMu.Lock()
+	defer fileMu.Unlock()
+	doc, err := readDocument(p)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return errNoConfigFile
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var value any
+	if err := unmarshalJSON(data, &value); err != nil {
+		return err
+	}
+	if value = tomlValue(value); value == nil {
+		delete(doc, key)
+	} else {
+		doc[key] = value
+	}
+	return writeDocument(p, doc)
+}
+
+// decodeValue decodes a TOML value into v through its json tags.
+func decodeValue(value any, v any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// unmarshalJSON decodes JSON keeping numbers exact, for tomlValue.
+func unmarshalJSON(data []byte, v any) error {
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// tomlValue converts a decoded JSON value to one TOML can encode: integers
+// stay integers and nulls, which TOML lacks, are dropped.
+func tomlValue(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for k, field := range v {
+			if field = tomlValue(field); field == nil {
+				delete(v, k)
+			} else {
+				v[k] = field
+			}
+		}
+		return v
+	case []any:
+		out := v[:0]
+		for _, item := range v {
+			if item = tomlValue(item); item != nil {
+				out = append(out, item)
+			}
+		}
+		return out
+	}
+	return v
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jpoz/hubell/internal/store"
+)
+
+// useTempDir points the config directory and config.toml at a fresh
+// temporary directory for the rest of the test. It returns config.toml's
+// path, which doesn't exist yet.
+func useTempDir(t *testing.T) string {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	if err := os.MkdirAll(store.Dir(), 0700); err != nil {
+		t.Fatal(err)
+	}
+	p := filepath.Join(store.Dir(), "config.toml")
+	SetPath(p)
+	t.Cleanup(func() { SetPath("") })
+	return p
+}
+
+func writeFile(t *testing.T, name, data string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(configDir(), name), []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateLegacyFiles(t *testing.T) {
+	p := useTempDir(t)
+	writeFile(t, "layout.json", `{"panes":[{"name":"prs","width":100}],"stack_below":80}`)
+	writeFile(t, "bots.json", "not json")
+	writeFile(t, "org", "acme\n")
+	writeFile(t, "team", " platform \n")
+	writeFile(t, "releases_only", "o/r\no/a\n")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Org != "acme" || cfg.Team != "platform" {
+		t.Errorf("org, team = %q, %q; want acme, platform", cfg.Org, cfg.Team)
+	}
+	if cfg.Interval != DefaultConfig.Interval {
+		t.Errorf("interval = %v, want %v", cfg.Interval, DefaultConfig.Interval)
+	}
+
+	doc, err := readDocument(p)
+	if err != nil || doc == nil {
+		t.Fatalf("config.toml not written: %v", err)
+	}
+	if _, ok := doc["bots"]; ok {
+		t.Error("invalid bots.json migrated")
+	}
+	var repos []string
+	if !readValue("releases_only", &repos) || !reflect.DeepEqual(repos, []string{"o/a", "o/r"}) {
+		t.Errorf("releases_only = %v, want [o/a o/r]", repos)
+	}
+
+	// The legacy files are left in place but config.toml now owns the settings
+	if _, err := os.Stat(filepath.Join(configDir(), "layout.json")); err != nil {
+		t.Errorf("layout.json removed: %v", err)
+	}
+	if err := os.Remove(filepath.Join(configDir(), "layout.json")); err != nil {
+		t.Fatal(err)
+	}
+	layout, err := LoadLayout()
+	if err != nil {
+		t.Fatalf("LoadLayout: %v", err)
+	}
+	want := LayoutSettings{Panes: []LayoutPane{{Name: PanePRs, Width: 100}}, StackBelow: 80}
+	if !reflect.DeepEqual(layout, want) {
+		t.Errorf("layout = %+v, want %+v", layout, want)
+	}
+}
+
+func TestLoadMigratesOnce(t *testing.T) {
+	p := useTempDir(t)
+	if err := os.WriteFile(p, []byte("org = \"from-toml\"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, "org", "from-file\n")
+	writeFile(t, "team", "platform\n")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Org != "from-toml" || cfg.Team != "" {
+		t.Errorf("org, team = %q, %q; want from-toml and no team", cfg.Org, cfg.Team)
+	}
+}
+
+func TestReadSettings(t *testing.T) {
+	p := useTempDir(t)
+	legacy := filepath.Join(configDir(), "layout.json")
+
+	// Neither file
+	if _, _, err := readSettings("layout", legacy); !os.IsNotExist(err) {
+		t.Fatalf("readSettings with no files: err = %v, want not exist", err)
+	}
+
+	// Only the legacy file, with and without a config.toml
+	writeFile(t, "layout.json", `{"stack_below":1}`)
+	data, from, err := readSettings("layout", legacy)
+	if err != nil || string(data) != `{"stack_below":1}` || from != legacy {
+		t.Fatalf("readSettings without config.toml = %s, %q, %v; want layout.json", data, from, err)
+	}
+	if err := os.WriteFile(p, []byte("interval = \"1m\"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	data, from, err = readSettings("layout", legacy)
+	if err != nil || string(data) != `{"stack_below":1}` || from != legacy {
+		t.Fatalf("readSettings with config.toml lacking the key = %s, %q, %v; want layout.json", data, from, err)
+	}
+
+	// Both: config.toml wins
+	if err := os.WriteFile(p, []byte("[layout]\nstack_below = 2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	data, from, err = readSettings("layout", legacy)
+	if err != nil || string(data) != `{"stack_below":2}` || !strings.HasPrefix(from, p) {
+		t.Fatalf("readSettings with both = %s, %q, %v; want config.toml", data, from, err)
+	}
+}
+
+func TestWriteSettings(t *testing.T) {
+	p := useTempDir(t)
+
+	if err := writeSettings("orgs", []string{"acme"}); err != errNoConfigFile {
+		t.Fatalf("writeSettings without config.toml = %v, want errNoConfigFile", err)
+	}
+	if _, err := os.Stat(p); !os.IsNotExist(err) {
+		t.Fatalf("writeSettings created config.toml: %v", err)
+	}
+
+	if err := os.WriteFile(p, []byte("org = \"acme\"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeSettings("orgs", []string{"acme", "acme/platform"}); err != nil {
+		t.Fatalf("writeSettings: %v", err)
+	}
+	var orgs []string
+	if !readValue("orgs", &orgs) || !reflect.DeepEqual(orgs, []string{"acme", "acme/platform"}) {
+		t.Errorf("orgs = %v, want [acme acme/platform]", orgs)
+	}
+	var org string
+	if !readValue("org", &org) || org != "acme" {
+		t.Errorf("org = %q after writing orgs, want acme kept", org)
+	}
+
+	// A null value removes the key
+	if err := writeSettings("orgs", []string(nil)); err != nil {
+		t.Fatalf("writeSettings(nil): %v", err)
+	}
+	if readValue("orgs", &orgs) {
+		t.Errorf("orgs still set after writing nil: %v", orgs)
+	}
+}
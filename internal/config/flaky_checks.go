@@ -0,0 +1,77 @@
+package config
+
+import (
+	"time"
+
+	"github.com/jpoz/hubell/internal/store"
+)
+
+// flakyCheckMaxAge is how long checks and their runs' outcomes are
+// remembered after they were last seen.
+const flakyCheckMaxAge = 30 * 24 * time.Hour
+
+// FlakyCheck tallies a check's runs; it's flaky once it has both passed
+// and failed on the same commit.
+type FlakyCheck struct {
+	Repo string `json:"repo"` // owner/repo
+	Name string `json:"name"`
+	// Flips counts reruns whose conclusion differed from the previous run
+	// of the check on the same commit; Runs counts all runs seen.
+	Flips      int       `json:"flips"`
+	Runs       int       `json:"runs"`
+	LastFlipAt time.Time `json:"last_flip_at,omitzero"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// CheckOutcome is the latest seen run of a check on a commit.
+type CheckOutcome struct {
+	RunID      int       `json:"run_id"`
+	Conclusion string    `json:"conclusion"` // "success" or "failure"
+	SeenAt     time.Time `json:"seen_at"`
+}
+
+// FlakyChecks tracks check conclusions across polls. Checks is keyed by
+// "owner/repo:name" and Latest by "owner/repo@sha:name".
+type FlakyChecks struct {
+	Checks map[string]*FlakyCheck  `json:"checks"`
+	Latest map[string]CheckOutcome `json:"latest"`
+}
+
+// LoadFlakyChecks reads the flaky check tracker. Returns an empty tracker
+// on error.
+func LoadFlakyChecks() FlakyChecks {
+	f := FlakyChecks{}
+	if s, err := store.Default(); err == nil {
+		_, _ = s.Get(store.KeyFlakyChecks, &f)
+	}
+	if f.Checks == nil {
+		f.Checks = make(map[string]*FlakyCheck)
+	}
+	if f.Latest == nil {
+		f.Latest = make(map[string]CheckOutcome)
+	}
+	return f
+}
+
+// SaveFlakyChecks saves the flaky check tracker, dropping checks and
+// outcomes not seen in the last 30 days.
+func SaveFlakyChecks(f FlakyChecks) error {
+	s, err := store.Default()
+	if err != nil {
+		return err
+	}
+
+	cutoff := time.Now().Add(-flakyCheckMaxAge)
+	for k, c := range f.Checks {
+		if c.LastSeenAt.Before(cutoff) {
+			delete(f.Checks, k)
+		}
+	}
+	for k, o := range f.Latest {
+		if o.SeenAt.Before(cutoff) {
+			delete(f.Latest, k)
+		}
+	}
+
+	return s.Put(store.KeyFlakyChecks, f)
+}
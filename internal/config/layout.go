@@ -0,0 +1,128 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Pane names used in layout.json.
+const (
+	PaneTimeline      = "timeline"
+	PaneNotifications = "notifications"
+	PanePRs           = "prs"
+)
+
+// LayoutPane is one pane of the main view and its relative width.
+type LayoutPane struct {
+	Name   string `json:"name"`
+	Width  int    `json:"width"`
+	Hidden bool   `json:"hidden,omitempty"`
+}
+
+// LayoutSettings controls which panes the main view shows, in which order
+// and how wide they are.
+type LayoutSettings struct {
+	// Panes lists the shown panes from left to right (top to bottom when
+	// stacked). Widths are relative to each other.
+	Panes []LayoutPane `json:"panes"`
+	// StackBelow stacks the panes vertically, sized by the same relative
+	// widths, when the terminal is narrower than this many columns. Zero
+	// never stacks.
+	StackBelow int `json:"stack_below,omitempty"`
+	// DescriptionLines is how many lines each notification's description
+	// (e.g. a comment excerpt) soft-wraps over, 1 to 3. Zero means 1.
+	DescriptionLines int `json:"description_lines,omitempty"`
+}
+
+// DefaultLayout is the classic 30/35/35 timeline, notifications, PRs split.
+var DefaultLayout = LayoutSettings{
+	Panes: []LayoutPane{
+		{Name: PaneTimeline, Width: 30},
+		{Name: PaneNotifications, Width: 35},
+		{Name: PanePRs, Width: 35},
+	},
+}
+
+func layoutPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "layout.json")
+}
+
+// LoadLayout reads the pane layout from layout.json. Returns DefaultLayout
+// with no error if the file does not exist.
+func LoadLayout() (LayoutSettings, error) {
+	p := layoutPath()
+	if p == "" {
+		return cloneLayout(DefaultLayout), nil
+	}
+	data, p, err := readSettings("layout", p)
+	if os.IsNotExist(err) {
+		return cloneLayout(DefaultLayout), nil
+	}
+	if err != nil {
+		return cloneLayout(DefaultLayout), err
+	}
+	var l LayoutSettings
+	if err := json.Unmarshal(data, &l); err != nil {
+		return cloneLayout(DefaultLayout), fmt.Errorf("parse %s: %w", p, err)
+	}
+	if len(l.Panes) == 0 {
+		return cloneLayout(DefaultLayout), fmt.Errorf("%s: no panes configured", p)
+	}
+	seen := make(map[string]bool)
+	shown := 0
+	for i, pane := range l.Panes {
+		if !pane.Hidden {
+			shown++
+		}
+		switch pane.Name {
+		case PaneTimeline, PaneNotifications, PanePRs:
+		default:
+			return cloneLayout(DefaultLayout), fmt.Errorf("%s: unknown pane %q", p, pane.Name)
+		}
+		if seen[pane.Name] {
+			return cloneLayout(DefaultLayout), fmt.Errorf("%s: pane %q listed twice", p, pane.Name)
+		}
+		seen[pane.Name] = true
+		if pane.Width <= 0 {
+			l.Panes[i].Width = 100 / len(l.Panes)
+		}
+	}
+	if shown == 0 {
+		return cloneLayout(DefaultLayout), fmt.Errorf("%s: every pane is hidden", p)
+	}
+	if l.DescriptionLines < 0 || l.DescriptionLines > 3 {
+		return cloneLayout(DefaultLayout), fmt.Errorf("%s: description_lines must be between 1 and 3", p)
+	}
+	return l, nil
+}
+
+// SaveLayout writes the pane layout to layout.json.
+func SaveLayout(l LayoutSettings) error {
+	p := layoutPath()
+	if p == "" {
+		return nil
+	}
+	if err := writeSettings("layout", l); err != errNoConfigFile {
+		return err
+	}
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0600)
+}
+
+func cloneLayout(l LayoutSettings) LayoutSettings {
+	l.Panes = append([]LayoutPane(nil), l.Panes...)
+	return l
+}
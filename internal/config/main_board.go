@@ -0,0 +1,79 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// MainBoardSettings configures the failing-main board, which watches the CI
+// status of default branches. It is off by default since checking every
+// repo of an org costs a few API calls per repo.
+type MainBoardSettings struct {
+	Enabled bool `json:"enabled"`
+	// Repos ("owner/repo") to watch instead of every repo in the org.
+	Repos []string `json:"repos,omitempty"`
+	// RefreshMinutes is how often the board is refreshed.
+	RefreshMinutes int `json:"refresh_minutes,omitempty"`
+}
+
+// defaultMainBoardRefresh refreshes the board every five minutes.
+const defaultMainBoardRefresh = 5
+
+// Options returns the poller options for watching org's repos, or the
+// configured allowlist. Disabled settings yield options that watch nothing.
+func (s MainBoardSettings) Options(org string, ci github.CIOptions) github.MainBoardOptions {
+	if !s.Enabled {
+		return github.MainBoardOptions{}
+	}
+	return github.MainBoardOptions{
+		Org:      org,
+		Repos:    s.Repos,
+		Interval: time.Duration(s.RefreshMinutes) * time.Minute,
+		CI:       ci,
+	}
+}
+
+func mainBoardPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "main_board.json")
+}
+
+// LoadMainBoardSettings reads the failing-main board settings from
+// main_board.json. Returns disabled settings with no error if the file does
+// not exist.
+func LoadMainBoardSettings() (MainBoardSettings, error) {
+	defaults := MainBoardSettings{RefreshMinutes: defaultMainBoardRefresh}
+	p := mainBoardPath()
+	if p == "" {
+		return defaults, nil
+	}
+	data, p, err := readSettings("main_board", p)
+	if os.IsNotExist(err) {
+		return defaults, nil
+	}
+	if err != nil {
+		return defaults, err
+	}
+	s := defaults
+	if err := json.Unmarshal(data, &s); err != nil {
+		return defaults, fmt.Errorf("parse %s: %w", p, err)
+	}
+	for _, r := range s.Repos {
+		if owner, repo, ok := strings.Cut(r, "/"); !ok || owner == "" || repo == "" {
+			return defaults, fmt.Errorf("%s: repo %q is not owner/repo", p, r)
+		}
+	}
+	if s.RefreshMinutes <= 0 {
+		s.RefreshMinutes = defaultMainBoardRefresh
+	}
+	return s, nil
+}
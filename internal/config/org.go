@@ -1,25 +1,23 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
 func orgPath() string {
-	configDir := os.Getenv("XDG_CONFIG_HOME")
-	if configDir == "" {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return ""
-		}
-		configDir = filepath.Join(home, ".config")
+	dir := configDir()
+	if dir == "" {
+		return ""
 	}
-	return filepath.Join(configDir, "hubell", "org")
+	return filepath.Join(dir, "org")
 }
 
-// LoadOrg reads the saved org name from disk. Returns empty string if not found.
-func LoadOrg() string {
+// loadLegacyOrg reads the org name saved before config.toml, for Load to
+// move there. Returns empty string if not found.
+func loadLegacyOrg() string {
 	p := orgPath()
 	if p == "" {
 		return ""
@@ -37,9 +35,67 @@ func SaveOrg(name string) error {
 	if p == "" {
 		return nil
 	}
+	if err := writeSettings("org", name); err != errNoConfigFile {
+		return err
+	}
 	dir := filepath.Dir(p)
 	if err := os.MkdirAll(dir, 0700); err != nil {
 		return err
 	}
 	return os.WriteFile(p, []byte(name+"\n"), 0600)
 }
+
+func teamPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "team")
+}
+
+// loadLegacyTeam reads the team slug saved before config.toml, for Load to
+// move there. Returns empty string if not found.
+func loadLegacyTeam() string {
+	p := teamPath()
+	if p == "" {
+		return ""
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// SaveTeam writes the team slug to disk. An empty slug removes the saved team.
+func SaveTeam(slug string) error {
+	p := teamPath()
+	if p == "" {
+		return nil
+	}
+	if err := writeSettings("team", slug); err != errNoConfigFile {
+		return err
+	}
+	if slug == "" {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(p, []byte(slug+"\n"), 0600)
+}
+
+// SaveOrgs writes the org dashboards to switch between, as "org" or
+// "org/team" scopes. They are only kept in config.toml, so saving fails
+// when there is none.
+func SaveOrgs(scopes []string) error {
+	err := writeSettings("orgs", scopes)
+	if err == errNoConfigFile {
+		return errors.New("no config.toml; add an orgs entry to config.toml to keep dashboards")
+	}
+	return err
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"time"
+
+	"github.com/jpoz/hubell/internal/github"
+	"github.com/jpoz/hubell/internal/store"
+)
+
+// OrgCacheEntry holds the last fetched org activity for one dashboard scope.
+type OrgCacheEntry struct {
+	UpdatedAt time.Time                  `json:"updated_at"`
+	Members   []github.OrgMemberActivity `json:"members"`
+	Summary   github.OrgActivitySummary  `json:"summary"`
+}
+
+func loadOrgCacheFile() map[string]OrgCacheEntry {
+	entries := make(map[string]OrgCacheEntry)
+	s, err := store.Default()
+	if err != nil {
+		return entries
+	}
+	if _, err := s.Get(store.KeyOrgCache, &entries); err != nil {
+		return make(map[string]OrgCacheEntry)
+	}
+	return entries
+}
+
+// LoadOrgCache reads cached org activity for the given scope (e.g. "org" or
+// "org/team"). The second return value is false if nothing is cached.
+func LoadOrgCache(scope string) (OrgCacheEntry, bool) {
+	entry, ok := loadOrgCacheFile()[scope]
+	return entry, ok
+}
+
+// SaveOrgCache writes org activity for the given scope, keeping other scopes intact.
+func SaveOrgCache(scope string, entry OrgCacheEntry) error {
+	s, err := store.Default()
+	if err != nil {
+		return err
+	}
+	entries := loadOrgCacheFile()
+	entries[scope] = entry
+	return s.Put(store.KeyOrgCache, entries)
+}
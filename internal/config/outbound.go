@@ -0,0 +1,93 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// OutboundBackend configures one chat backend. Type is "discord",
+// "telegram" or "matrix"; only the fields for that type are used.
+type OutboundBackend struct {
+	Type string `json:"type"`
+
+	// discord
+	WebhookURL string `json:"webhook_url,omitempty"`
+
+	// telegram
+	BotToken string `json:"bot_token,omitempty"`
+	ChatID   string `json:"chat_id,omitempty"`
+
+	// matrix
+	Homeserver  string `json:"homeserver,omitempty"`
+	AccessToken string `json:"access_token,omitempty"`
+	RoomID      string `json:"room_id,omitempty"`
+}
+
+// OutboundRule routes the listed events (same names as EmailSettings.Events)
+// to the named backends.
+type OutboundRule struct {
+	Events   []string `json:"events"`
+	Backends []string `json:"backends"`
+}
+
+// OutboundSettings holds the chat backends and routing rules used by the daemon.
+type OutboundSettings struct {
+	Backends map[string]OutboundBackend `json:"backends"`
+	Rules    []OutboundRule             `json:"rules"`
+}
+
+func outboundPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "outbound.json")
+}
+
+// LoadOutboundSettings reads chat backends and rules from outbound.json.
+// Returns empty settings with no error if the file does not exist.
+func LoadOutboundSettings() (OutboundSettings, error) {
+	p := outboundPath()
+	if p == "" {
+		return OutboundSettings{}, nil
+	}
+	data, p, err := readSettings("outbound", p)
+	if os.IsNotExist(err) {
+		return OutboundSettings{}, nil
+	}
+	if err != nil {
+		return OutboundSettings{}, err
+	}
+	var s OutboundSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return OutboundSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	for name, b := range s.Backends {
+		switch b.Type {
+		case "discord":
+			if b.WebhookURL == "" {
+				return OutboundSettings{}, fmt.Errorf("backend %q: webhook_url is required", name)
+			}
+		case "telegram":
+			if b.BotToken == "" || b.ChatID == "" {
+				return OutboundSettings{}, fmt.Errorf("backend %q: bot_token and chat_id are required", name)
+			}
+		case "matrix":
+			if b.Homeserver == "" || b.AccessToken == "" || b.RoomID == "" {
+				return OutboundSettings{}, fmt.Errorf("backend %q: homeserver, access_token and room_id are required", name)
+			}
+		default:
+			return OutboundSettings{}, fmt.Errorf("backend %q: unknown type %q", name, b.Type)
+		}
+	}
+	for i, rule := range s.Rules {
+		for _, name := range rule.Backends {
+			if _, ok := s.Backends[name]; !ok {
+				return OutboundSettings{}, fmt.Errorf("rule %d: unknown backend %q", i+1, name)
+			}
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Panel defines a custom query panel. Exactly one of Query (a GitHub issue
+// and PR search, as typed on github.com) or GraphQL must be set. For GraphQL
+// panels, Items is the dot path to the array of rows in the response data,
+// e.g. "viewer.pullRequests.nodes".
+type Panel struct {
+	Name    string        `json:"name"`
+	Query   string        `json:"query,omitempty"`
+	GraphQL string        `json:"graphql,omitempty"`
+	Items   string        `json:"items,omitempty"`
+	Columns []PanelColumn `json:"columns,omitempty"`
+}
+
+// PanelColumn is one column of a custom panel. Field is a dot path into each
+// row (e.g. "user.login"); arrays along the path are joined. Width is
+// optional and shares the remaining space when zero.
+type PanelColumn struct {
+	Header string `json:"header"`
+	Field  string `json:"field"`
+	Width  int    `json:"width,omitempty"`
+}
+
+// DefaultSearchColumns are used for search panels that define no columns.
+var DefaultSearchColumns = []PanelColumn{
+	{Header: "#", Field: "number", Width: 6},
+	{Header: "Title", Field: "title"},
+	{Header: "Author", Field: "user.login", Width: 16},
+	{Header: "Updated", Field: "updated_at", Width: 10},
+}
+
+func panelsPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "panels.json")
+}
+
+// LoadPanels reads the custom panel definitions from panels.json.
+// Returns nil with no error if the file does not exist.
+func LoadPanels() ([]Panel, error) {
+	p := panelsPath()
+	if p == "" {
+		return nil, nil
+	}
+	data, p, err := readSettings("panels", p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var panels []Panel
+	if err := json.Unmarshal(data, &panels); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", p, err)
+	}
+	for i, panel := range panels {
+		if (panel.Query == "") == (panel.GraphQL == "") {
+			return nil, fmt.Errorf("panel %q: set exactly one of query or graphql", panel.Name)
+		}
+		if panel.GraphQL != "" && panel.Items == "" {
+			return nil, fmt.Errorf("panel %q: graphql panels need an items path", panel.Name)
+		}
+		if len(panel.Columns) == 0 {
+			if panel.GraphQL != "" {
+				return nil, fmt.Errorf("panel %q: graphql panels need columns", panel.Name)
+			}
+			panels[i].Columns = DefaultSearchColumns
+		}
+	}
+	return panels, nil
+}
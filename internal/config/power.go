@@ -0,0 +1,63 @@
+package config
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+)
+
+// PowerSettings controls when hubell switches to its low-power profile.
+type PowerSettings struct {
+	// Enabled turns power awareness on or off entirely.
+	Enabled bool `json:"enabled"`
+	// BatteryThreshold is the charge percentage at or below which running on
+	// battery triggers low-power mode. 100 means any time on battery.
+	BatteryThreshold int `json:"battery_threshold"`
+	// Metered enables low-power mode on metered network connections.
+	Metered bool `json:"metered"`
+	// LowPowerPollSeconds is the poll interval used while in low-power mode.
+	LowPowerPollSeconds int `json:"low_power_poll_seconds"`
+}
+
+// DefaultPowerSettings switches to low power whenever on battery or on a
+// metered connection, polling every two minutes.
+var DefaultPowerSettings = PowerSettings{
+	Enabled:             true,
+	BatteryThreshold:    100,
+	Metered:             true,
+	LowPowerPollSeconds: 120,
+}
+
+// LowPowerPollInterval returns the poll interval to use in low-power mode.
+func (p PowerSettings) LowPowerPollInterval() time.Duration {
+	return time.Duration(p.LowPowerPollSeconds) * time.Second
+}
+
+func powerPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "power.json")
+}
+
+// LoadPowerSettings reads power settings from disk. Missing fields keep their
+// defaults; an unreadable or invalid file yields DefaultPowerSettings.
+func LoadPowerSettings() PowerSettings {
+	p := powerPath()
+	if p == "" {
+		return DefaultPowerSettings
+	}
+	data, p, err := readSettings("power", p)
+	if err != nil {
+		return DefaultPowerSettings
+	}
+	s := DefaultPowerSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return DefaultPowerSettings
+	}
+	if s.BatteryThreshold < 0 || s.BatteryThreshold > 100 || s.LowPowerPollSeconds <= 0 {
+		return DefaultPowerSettings
+	}
+	return s
+}
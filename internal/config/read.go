@@ -0,0 +1,77 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ReadSettings controls how read notifications are shown.
+type ReadSettings struct {
+	// UnreadOnly hides read notifications from the list entirely.
+	UnreadOnly bool `json:"unread_only"`
+	// HideAfterSeconds, when positive, keeps notifications in the list,
+	// faded, for that long after they are read before hiding them. Zero
+	// hides notifications marked read in hubell at once and leaves ones read
+	// elsewhere listed.
+	HideAfterSeconds int `json:"hide_after_seconds,omitempty"`
+}
+
+// HideAfter returns the grace period before read notifications are hidden.
+func (s ReadSettings) HideAfter() time.Duration {
+	return time.Duration(s.HideAfterSeconds) * time.Second
+}
+
+func readPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "read.json")
+}
+
+// LoadReadSettings reads the read-notification settings from read.json.
+// Returns zero settings with no error if the file does not exist.
+func LoadReadSettings() (ReadSettings, error) {
+	p := readPath()
+	if p == "" {
+		return ReadSettings{}, nil
+	}
+	data, p, err := readSettings("read", p)
+	if os.IsNotExist(err) {
+		return ReadSettings{}, nil
+	}
+	if err != nil {
+		return ReadSettings{}, err
+	}
+	var s ReadSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return ReadSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if s.HideAfterSeconds < 0 {
+		return ReadSettings{}, fmt.Errorf("%s: hide_after_seconds must not be negative", p)
+	}
+	return s, nil
+}
+
+// SaveReadSettings writes the read-notification settings to read.json.
+func SaveReadSettings(s ReadSettings) error {
+	p := readPath()
+	if p == "" {
+		return nil
+	}
+	if err := writeSettings("read", s); err != errNoConfigFile {
+		return err
+	}
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0600)
+}
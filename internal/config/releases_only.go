@@ -0,0 +1,67 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func releasesOnlyPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "releases_only")
+}
+
+// LoadReleasesOnly reads the set of repos (owner/repo) for which only release
+// notifications should be shown. Returns an empty set if not found.
+func LoadReleasesOnly() map[string]bool {
+	repos := make(map[string]bool)
+	var names []string
+	if readValue("releases_only", &names) {
+		for _, name := range names {
+			repos[name] = true
+		}
+		return repos
+	}
+	p := releasesOnlyPath()
+	if p == "" {
+		return repos
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return repos
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			repos[line] = true
+		}
+	}
+	return repos
+}
+
+// SaveReleasesOnly writes the releases-only repo set to disk, one repo per line.
+func SaveReleasesOnly(repos map[string]bool) error {
+	p := releasesOnlyPath()
+	if p == "" {
+		return nil
+	}
+	names := []string{}
+	for name, on := range repos {
+		if on {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if err := writeSettings("releases_only", names); err != errNoConfigFile {
+		return err
+	}
+
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(p, []byte(strings.Join(names, "\n")+"\n"), 0600)
+}
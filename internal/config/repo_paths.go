@@ -0,0 +1,81 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RepoPathsSettings maps repos to their local clones, for actions that run
+// in them.
+type RepoPathsSettings struct {
+	// Repos maps "owner/repo" to the clone's directory. "owner/*" maps
+	// every repo of an owner to a directory holding clones named after
+	// the repos. A leading ~ is the home directory.
+	Repos map[string]string `json:"repos"`
+	// Checkout is the shell command that checks out a PR in its clone.
+	// {number}, {branch}, {owner} and {repo} are replaced by the PR's.
+	// Defaults to "gh pr checkout {number}" when gh is installed, else
+	// fetches the PR's head into a pr-{number} branch with git.
+	Checkout string `json:"checkout,omitempty"`
+	// Editor is the shell command that opens a clone in an editor, e.g.
+	// "code {path}" or "nvim". {path} is replaced by the clone's directory,
+	// which is appended if {path} is absent, along with the placeholders
+	// Checkout takes. Defaults to $VISUAL, then $EDITOR, then vi.
+	Editor string `json:"editor,omitempty"`
+}
+
+// PathFor returns the local clone of a repo, or "" when it isn't mapped.
+func (s RepoPathsSettings) PathFor(owner, repo string) string {
+	if p, ok := s.Repos[owner+"/"+repo]; ok {
+		return expandHome(p)
+	}
+	if dir, ok := s.Repos[owner+"/*"]; ok {
+		return filepath.Join(expandHome(dir), repo)
+	}
+	return ""
+}
+
+// expandHome replaces a leading ~ with the home directory.
+func expandHome(p string) string {
+	rest, ok := strings.CutPrefix(p, "~")
+	if !ok || (rest != "" && !strings.HasPrefix(rest, "/")) {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return p
+	}
+	return home + rest
+}
+
+func repoPathsPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "repo_paths.json")
+}
+
+// LoadRepoPathsSettings reads repo paths from repo_paths.json. Returns no
+// mappings with no error if the file does not exist.
+func LoadRepoPathsSettings() (RepoPathsSettings, error) {
+	p := repoPathsPath()
+	if p == "" {
+		return RepoPathsSettings{}, nil
+	}
+	data, p, err := readSettings("repo_paths", p)
+	if os.IsNotExist(err) {
+		return RepoPathsSettings{}, nil
+	}
+	if err != nil {
+		return RepoPathsSettings{}, err
+	}
+	var s RepoPathsSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return RepoPathsSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Alert kinds that can be gated on the on-call rotation. They match the
+// daemon's event names.
+const (
+	AlertFailingMain    = "failing_main"
+	AlertSecretScanning = "secret_scanning"
+)
+
+// DefaultRotationAlerts are gated when rotation.json doesn't list alerts.
+var DefaultRotationAlerts = []string{AlertFailingMain, AlertSecretScanning}
+
+// RotationSettings is a weekly on-call rotation. Alerts of the listed kinds
+// only raise desktop and push notifications for whoever is on call this
+// week; everyone else still sees them in the TUI.
+type RotationSettings struct {
+	// Logins take turns, one week each, in order.
+	Logins []string `json:"logins"`
+	// Start is the first day (YYYY-MM-DD) of Logins[0]'s first week.
+	Start  string   `json:"start"`
+	Alerts []string `json:"alerts,omitempty"`
+
+	start time.Time
+}
+
+// OnCall returns the login on call at now, or "" when no rotation is
+// configured.
+func (s RotationSettings) OnCall(now time.Time) string {
+	if len(s.Logins) == 0 || s.start.IsZero() {
+		return ""
+	}
+	week := int(now.Sub(s.start).Hours()) / (7 * 24)
+	if now.Before(s.start) {
+		week--
+	}
+	n := len(s.Logins)
+	return s.Logins[((week%n)+n)%n]
+}
+
+// Notifies reports whether an alert of kind should notify login at now:
+// always, unless the kind is gated by the rotation and login is off call.
+func (s RotationSettings) Notifies(kind, login string, now time.Time) bool {
+	onCall := s.OnCall(now)
+	if onCall == "" || !slices.Contains(s.Alerts, kind) {
+		return true
+	}
+	return strings.EqualFold(onCall, login)
+}
+
+func rotationPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "rotation.json")
+}
+
+// LoadRotationSettings reads the on-call rotation from rotation.json. Returns
+// an empty rotation (nothing gated) with no error if the file does not exist.
+func LoadRotationSettings() (RotationSettings, error) {
+	p := rotationPath()
+	if p == "" {
+		return RotationSettings{}, nil
+	}
+	data, p, err := readSettings("rotation", p)
+	if os.IsNotExist(err) {
+		return RotationSettings{}, nil
+	}
+	if err != nil {
+		return RotationSettings{}, err
+	}
+	var s RotationSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return RotationSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if len(s.Logins) == 0 {
+		return RotationSettings{}, nil
+	}
+	s.start, err = time.ParseInLocation("2006-01-02", s.Start, time.Local)
+	if err != nil {
+		return RotationSettings{}, fmt.Errorf("%s: start: %w", p, err)
+	}
+	if len(s.Alerts) == 0 {
+		s.Alerts = DefaultRotationAlerts
+	}
+	return s, nil
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// Sort orders used in sort.json.
+const (
+	SortUpdated = "updated"
+	SortCreated = "created"
+	SortRepo    = "repo"
+	SortReason  = "reason"
+	SortCI      = "ci"
+	SortReview  = "review"
+)
+
+// NotificationSorts and PRSorts list the orders each pane cycles through;
+// the first is the default.
+var (
+	NotificationSorts = []string{SortUpdated, SortRepo, SortReason, SortCI}
+	PRSorts           = []string{SortCreated, SortUpdated, SortCI, SortReview}
+)
+
+// SortSettings holds the sort order of the notification and PR panes.
+type SortSettings struct {
+	Notifications string `json:"notifications"`
+	PRs           string `json:"prs"`
+}
+
+// DefaultSortSettings sorts notifications by last update and PRs by
+// creation, newest first.
+var DefaultSortSettings = SortSettings{
+	Notifications: SortUpdated,
+	PRs:           SortCreated,
+}
+
+// NextSort returns the order after current in orders, wrapping around.
+func NextSort(orders []string, current string) string {
+	i := slices.Index(orders, current)
+	return orders[(i+1)%len(orders)]
+}
+
+func sortPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "sort.json")
+}
+
+// LoadSortSettings reads the pane sort orders from sort.json. Returns
+// DefaultSortSettings with no error if the file does not exist.
+func LoadSortSettings() (SortSettings, error) {
+	p := sortPath()
+	if p == "" {
+		return DefaultSortSettings, nil
+	}
+	data, p, err := readSettings("sort", p)
+	if os.IsNotExist(err) {
+		return DefaultSortSettings, nil
+	}
+	if err != nil {
+		return DefaultSortSettings, err
+	}
+	s := DefaultSortSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return DefaultSortSettings, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if !slices.Contains(NotificationSorts, s.Notifications) {
+		return DefaultSortSettings, fmt.Errorf("%s: unknown notification sort %q", p, s.Notifications)
+	}
+	if !slices.Contains(PRSorts, s.PRs) {
+		return DefaultSortSettings, fmt.Errorf("%s: unknown PR sort %q", p, s.PRs)
+	}
+	return s, nil
+}
+
+// SaveSortSettings writes the pane sort orders to sort.json.
+func SaveSortSettings(s SortSettings) error {
+	p := sortPath()
+	if p == "" {
+		return nil
+	}
+	if err := writeSettings("sort", s); err != errNoConfigFile {
+		return err
+	}
+	dir := filepath.Dir(p)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0600)
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ThemeFile is a user-defined theme read from the themes directory. Colors
+// are ANSI numbers ("62") or hex ("#7aa2f7"), as lipgloss takes them.
+type ThemeFile struct {
+	// Key identifies the theme in config.toml and --theme; it is the file
+	// name without its extension, lowercased.
+	Key  string `json:"-"`
+	Name string `json:"name"`
+
+	Error           string `json:"error"`
+	HelpText        string `json:"help_text"`
+	FocusedBorder   string `json:"focused_border"`
+	UnfocusedBorder string `json:"unfocused_border"`
+
+	BannerDark   [3]int `json:"banner_dark"`
+	BannerBright [3]int `json:"banner_bright"`
+
+	StatusSuccess string `json:"status_success"`
+	StatusFailure string `json:"status_failure"`
+	StatusPending string `json:"status_pending"`
+
+	Title              string `json:"title"`
+	TitleBar           string `json:"title_bar"`
+	SelectedForeground string `json:"selected_foreground"`
+	SelectedDesc       string `json:"selected_desc"`
+	NormalForeground   string `json:"normal_foreground"`
+	NormalDesc         string `json:"normal_desc"`
+
+	TimelineCreated   string `json:"timeline_created"`
+	TimelineApproved  string `json:"timeline_approved"`
+	TimelineMerged    string `json:"timeline_merged"`
+	TimelineChanges   string `json:"timeline_changes"`
+	TimelineRequested string `json:"timeline_requested"`
+	TimelineClosed    string `json:"timeline_closed"`
+	TimelineReopened  string `json:"timeline_reopened"`
+
+	Accent string `json:"accent"`
+	Subtle string `json:"subtle"`
+}
+
+// missing returns the color slots the file leaves unset.
+func (t ThemeFile) missing() []string {
+	slots := []struct {
+		name, value string
+	}{
+		{"error", t.Error}, {"help_text", t.HelpText},
+		{"focused_border", t.FocusedBorder}, {"unfocused_border", t.UnfocusedBorder},
+		{"status_success", t.StatusSuccess}, {"status_failure", t.StatusFailure},
+		{"status_pending", t.StatusPending}, {"title", t.Title}, {"title_bar", t.TitleBar},
+		{"selected_foreground", t.SelectedForeground}, {"selected_desc", t.SelectedDesc},
+		{"normal_foreground", t.NormalForeground}, {"normal_desc", t.NormalDesc},
+		{"timeline_created", t.TimelineCreated}, {"timeline_approved", t.TimelineApproved},
+		{"timeline_merged", t.TimelineMerged}, {"timeline_changes", t.TimelineChanges},
+		{"timeline_requested", t.TimelineRequested}, {"timeline_closed", t.TimelineClosed},
+		{"timeline_reopened", t.TimelineReopened}, {"accent", t.Accent}, {"subtle", t.Subtle},
+	}
+	var missing []string
+	for _, s := range slots {
+		if strings.TrimSpace(s.value) == "" {
+			missing = append(missing, s.name)
+		}
+	}
+	return missing
+}
+
+// ThemesDir returns the custom themes location: ~/.config/hubell/themes.
+func ThemesDir() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "themes")
+}
+
+// themeFilePaths lists the .toml and .json files in the themes directory,
+// sorted by name.
+func themeFilePaths() ([]string, error) {
+	dir := ThemesDir()
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, e := range entries {
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if !e.IsDir() && (ext == ".toml" || ext == ".json") {
+			paths = append(paths, filepath.Join(dir, e.Name()))
+		}
+	}
+	return paths, nil
+}
+
+// LoadThemeFiles reads every theme in the themes directory, sorted by key.
+// Files that don't parse or leave a color slot unset are skipped and
+// reported in the returned error; the rest still load. Returns no themes
+// with no error if the directory does not exist.
+func LoadThemeFiles() ([]ThemeFile, error) {
+	paths, err := themeFilePaths()
+	if err != nil {
+		return nil, err
+	}
+	var themes []ThemeFile
+	var errs []error
+	for _, p := range paths {
+		t, err := readThemeFile(p)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		themes = append(themes, t)
+	}
+	slices.SortFunc(themes, func(a, b ThemeFile) int { return strings.Compare(a.Key, b.Key) })
+	return themes, errors.Join(errs...)
+}
+
+// readThemeFile parses one TOML or JSON theme file.
+func readThemeFile(p string) (ThemeFile, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return ThemeFile{}, err
+	}
+	var t ThemeFile
+	if strings.EqualFold(filepath.Ext(p), ".json") {
+		err = json.Unmarshal(data, &t)
+	} else {
+		// Decoded through the json tags so both formats share field names
+		doc := make(map[string]any)
+		if err = toml.Unmarshal(data, &doc); err == nil {
+			err = decodeValue(doc, &t)
+		}
+	}
+	if err != nil {
+		return ThemeFile{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if missing := t.missing(); len(missing) > 0 {
+		return ThemeFile{}, fmt.Errorf("%s: missing colors: %s", p, strings.Join(missing, ", "))
+	}
+	t.Key = strings.ToLower(strings.TrimSuffix(filepath.Base(p), filepath.Ext(p)))
+	if t.Name == "" {
+		t.Name = t.Key
+	}
+	return t, nil
+}
+
+// ThemeFilesStamp summarizes the themes directory's files and modification
+// times, so a change to any theme file changes the stamp.
+func ThemeFilesStamp() string {
+	paths, err := themeFilePaths()
+	if err != nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&b, "%s:%d:%d;", filepath.Base(p), info.ModTime().UnixNano(), info.Size())
+	}
+	return b.String()
+}
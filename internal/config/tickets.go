@@ -0,0 +1,97 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jpoz/hubell/internal/tickets"
+)
+
+// TicketTracker configures one external ticket system, e.g.
+//
+//	{"name": "Jira", "type": "jira", "pattern": "[A-Z][A-Z0-9]+-\\d+",
+//	 "url": "https://acme.atlassian.net/browse/{key}",
+//	 "api_url": "https://acme.atlassian.net", "email": "me@acme.com", "token": "..."}
+//
+// Type, api_url, email and token are only needed to show ticket status.
+type TicketTracker struct {
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`
+	Pattern string `json:"pattern"`
+	URL     string `json:"url"`
+	APIURL  string `json:"api_url,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Token   string `json:"token,omitempty"`
+}
+
+// TicketSettings lists the ticket systems whose keys are linked from PRs.
+type TicketSettings struct {
+	Trackers []TicketTracker `json:"trackers"`
+}
+
+// TicketTrackers converts the settings into ticket trackers. Patterns must have
+// been validated by LoadTicketSettings.
+func (s TicketSettings) TicketTrackers() []tickets.Tracker {
+	trackers := make([]tickets.Tracker, 0, len(s.Trackers))
+	for _, t := range s.Trackers {
+		trackers = append(trackers, tickets.Tracker{
+			Name:         t.Name,
+			Kind:         t.Type,
+			Pattern:      regexp.MustCompile(t.Pattern),
+			LinkTemplate: t.URL,
+			APIURL:       t.APIURL,
+			Email:        t.Email,
+			Token:        t.Token,
+		})
+	}
+	return trackers
+}
+
+func ticketsPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "tickets.json")
+}
+
+// LoadTicketSettings reads ticket tracker settings from tickets.json.
+// Returns empty settings with no error if the file does not exist.
+func LoadTicketSettings() (TicketSettings, error) {
+	p := ticketsPath()
+	if p == "" {
+		return TicketSettings{}, nil
+	}
+	data, p, err := readSettings("tickets", p)
+	if os.IsNotExist(err) {
+		return TicketSettings{}, nil
+	}
+	if err != nil {
+		return TicketSettings{}, err
+	}
+	var s TicketSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return TicketSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	for i, t := range s.Trackers {
+		if t.Name == "" {
+			return TicketSettings{}, fmt.Errorf("%s: tracker %d has no name", p, i+1)
+		}
+		if _, err := regexp.Compile(t.Pattern); err != nil || t.Pattern == "" {
+			return TicketSettings{}, fmt.Errorf("%s: tracker %q has an invalid pattern", p, t.Name)
+		}
+		if !strings.Contains(t.URL, "{key}") {
+			return TicketSettings{}, fmt.Errorf("%s: tracker %q url must contain {key}", p, t.Name)
+		}
+		switch t.Type {
+		case "", tickets.KindJira, tickets.KindLinear:
+		default:
+			return TicketSettings{}, fmt.Errorf("%s: tracker %q has unknown type %q", p, t.Name, t.Type)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TimelineRecord is a timeline event as kept in the timeline history.
+type TimelineRecord struct {
+	// Account is the login whose timeline the event was seen on.
+	Account string `json:"account"`
+	// Kind is the event type: "created", "approved", "merged",
+	// "changes_requested", "review_requested", "closed" or "reopened".
+	Kind              string    `json:"kind"`
+	At                time.Time `json:"at"`
+	Owner             string    `json:"owner"`
+	Repo              string    `json:"repo"`
+	Number            int       `json:"number"`
+	Title             string    `json:"title"`
+	URL               string    `json:"url"`
+	Actor             string    `json:"actor,omitempty"`
+	FirstContribution bool      `json:"first_contribution,omitempty"`
+}
+
+// timelineHistoryMaxAge is how far back history is read; older lines stay
+// in the file but are skipped.
+const timelineHistoryMaxAge = 90 * 24 * time.Hour
+
+// TimelineHistoryPath returns the timeline history location:
+// ~/.local/share/hubell/timeline.jsonl, or under $XDG_DATA_HOME when set.
+func TimelineHistoryPath() string {
+	dataDir := os.Getenv("XDG_DATA_HOME")
+	if dataDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dataDir = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataDir, "hubell", "timeline.jsonl")
+}
+
+// LoadTimelineHistory reads the timeline events seen in the last 90 days,
+// oldest first. Returns no records with no error if the file does not
+// exist; unparseable lines, e.g. one cut short by a crash, are skipped.
+func LoadTimelineHistory() ([]TimelineRecord, error) {
+	p := TimelineHistoryPath()
+	if p == "" {
+		return nil, nil
+	}
+	f, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cutoff := time.Now().Add(-timelineHistoryMaxAge)
+	var records []TimelineRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for scanner.Scan() {
+		var r TimelineRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			continue
+		}
+		if r.At.After(cutoff) {
+			records = append(records, r)
+		}
+	}
+	return records, scanner.Err()
+}
+
+// AppendTimelineHistory appends records to the timeline history, one JSON
+// object per line.
+func AppendTimelineHistory(records []TimelineRecord) error {
+	p := TimelineHistoryPath()
+	if p == "" || len(records) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// TimezoneSettings maps team members to their timezones, for annotating the
+// org timeline and bucketing activity by timezone.
+type TimezoneSettings struct {
+	// Members maps GitHub logins to IANA timezone names, e.g.
+	// {"octocat": "America/Los_Angeles"}.
+	Members map[string]string `json:"members"`
+}
+
+// Locations returns each member's timezone keyed by lowercased login.
+// Settings from LoadTimezoneSettings have only valid timezones.
+func (s TimezoneSettings) Locations() map[string]*time.Location {
+	locs := make(map[string]*time.Location, len(s.Members))
+	for login, name := range s.Members {
+		if loc, err := time.LoadLocation(name); err == nil {
+			locs[strings.ToLower(login)] = loc
+		}
+	}
+	return locs
+}
+
+func timezonesPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "timezones.json")
+}
+
+// LoadTimezoneSettings reads member timezones from timezones.json. Returns
+// no timezones with no error if the file does not exist.
+func LoadTimezoneSettings() (TimezoneSettings, error) {
+	p := timezonesPath()
+	if p == "" {
+		return TimezoneSettings{}, nil
+	}
+	data, p, err := readSettings("timezones", p)
+	if os.IsNotExist(err) {
+		return TimezoneSettings{}, nil
+	}
+	if err != nil {
+		return TimezoneSettings{}, err
+	}
+	var s TimezoneSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return TimezoneSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	for login, name := range s.Members {
+		if _, err := time.LoadLocation(name); err != nil {
+			return TimezoneSettings{}, fmt.Errorf("%s: %s: %w", p, login, err)
+		}
+	}
+	return s, nil
+}
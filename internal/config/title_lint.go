@@ -0,0 +1,69 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// ConventionalCommitPattern matches Conventional Commits titles such as
+// "feat(api): add pagination" or "fix!: drop legacy flag".
+const ConventionalCommitPattern = `^(build|chore|ci|docs|feat|fix|perf|refactor|revert|style|test)(\([^)]+\))?!?: \S`
+
+// TitleLintSettings configures the PR title check. Either set Preset to
+// "conventional" or provide a regular expression in Pattern, e.g.
+// "^[A-Z]+-\\d+ " to require a ticket prefix.
+type TitleLintSettings struct {
+	Preset  string `json:"preset,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// Regexp returns the compiled title pattern, or nil when linting is disabled.
+// The pattern must have been validated by LoadTitleLintSettings.
+func (s TitleLintSettings) Regexp() *regexp.Regexp {
+	switch {
+	case s.Pattern != "":
+		return regexp.MustCompile(s.Pattern)
+	case s.Preset == "conventional":
+		return regexp.MustCompile(ConventionalCommitPattern)
+	}
+	return nil
+}
+
+func titleLintPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "title_lint.json")
+}
+
+// LoadTitleLintSettings reads PR title lint settings from title_lint.json.
+// Returns empty settings (linting disabled) with no error if the file does
+// not exist.
+func LoadTitleLintSettings() (TitleLintSettings, error) {
+	p := titleLintPath()
+	if p == "" {
+		return TitleLintSettings{}, nil
+	}
+	data, p, err := readSettings("title_lint", p)
+	if os.IsNotExist(err) {
+		return TitleLintSettings{}, nil
+	}
+	if err != nil {
+		return TitleLintSettings{}, err
+	}
+	var s TitleLintSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return TitleLintSettings{}, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if s.Preset != "" && s.Preset != "conventional" {
+		return TitleLintSettings{}, fmt.Errorf("%s: unknown preset %q", p, s.Preset)
+	}
+	if _, err := regexp.Compile(s.Pattern); err != nil {
+		return TitleLintSettings{}, fmt.Errorf("%s: invalid pattern: %w", p, err)
+	}
+	return s, nil
+}
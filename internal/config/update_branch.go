@@ -0,0 +1,66 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// UpdateBranchSettings controls how hubell brings a PR up to date with its
+// base branch. By default it calls GitHub's update-branch API; set Comment
+// to post a bot command such as "/rebase" or "@dependabot rebase" instead.
+// Repos overrides Comment per "owner/repo"; an empty override uses the API.
+type UpdateBranchSettings struct {
+	Comment string            `json:"comment,omitempty"`
+	Repos   map[string]string `json:"repos,omitempty"`
+	// OutdatedAfter is how many commits a PR can fall behind its base before
+	// it is flagged as outdated.
+	OutdatedAfter int `json:"outdated_after,omitempty"`
+}
+
+// defaultOutdatedAfter flags PRs 10 or more commits behind their base.
+const defaultOutdatedAfter = 10
+
+// CommentFor returns the bot command to post for a repo, or "" to use the
+// update-branch API.
+func (s UpdateBranchSettings) CommentFor(owner, repo string) string {
+	if c, ok := s.Repos[owner+"/"+repo]; ok {
+		return c
+	}
+	return s.Comment
+}
+
+func updateBranchPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "update_branch.json")
+}
+
+// LoadUpdateBranchSettings reads update-branch settings from
+// update_branch.json. Returns default settings (use the API everywhere) with
+// no error if the file does not exist.
+func LoadUpdateBranchSettings() (UpdateBranchSettings, error) {
+	defaults := UpdateBranchSettings{OutdatedAfter: defaultOutdatedAfter}
+	p := updateBranchPath()
+	if p == "" {
+		return defaults, nil
+	}
+	data, p, err := readSettings("update_branch", p)
+	if os.IsNotExist(err) {
+		return defaults, nil
+	}
+	if err != nil {
+		return defaults, err
+	}
+	var s UpdateBranchSettings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return defaults, fmt.Errorf("parse %s: %w", p, err)
+	}
+	if s.OutdatedAfter <= 0 {
+		s.OutdatedAfter = defaultOutdatedAfter
+	}
+	return s, nil
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"slices"
+
+	"github.com/jpoz/hubell/internal/store"
+)
+
+// LoadWatchlist reads the PRs by others being watched, as "owner/repo#123"
+// keys. Returns an empty list on error.
+func LoadWatchlist() []string {
+	s, err := store.Default()
+	if err != nil {
+		return nil
+	}
+	var keys []string
+	if _, err := s.Get(store.KeyWatchlist, &keys); err != nil {
+		return nil
+	}
+	return keys
+}
+
+// SaveWatchlist saves the watched PR keys, sorted.
+func SaveWatchlist(keys []string) error {
+	s, err := store.Default()
+	if err != nil {
+		return err
+	}
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	return s.Put(store.KeyWatchlist, keys)
+}
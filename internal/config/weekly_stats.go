@@ -1,11 +1,10 @@
 package config
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
+
+	"github.com/jpoz/hubell/internal/store"
 )
 
 // WeekKey returns an ISO week key like "2026-W07" for the given time.
@@ -19,30 +18,14 @@ type WeeklyStats struct {
 	Weeks map[string]int `json:"weeks"`
 }
 
-func weeklyStatsPath() string {
-	configDir := os.Getenv("XDG_CONFIG_HOME")
-	if configDir == "" {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return ""
-		}
-		configDir = filepath.Join(home, ".config")
-	}
-	return filepath.Join(configDir, "hubell", "weekly_stats.json")
-}
-
-// LoadWeeklyStats reads cached weekly stats from disk. Returns empty stats on error.
+// LoadWeeklyStats reads cached weekly stats. Returns empty stats on error.
 func LoadWeeklyStats() WeeklyStats {
-	p := weeklyStatsPath()
-	if p == "" {
-		return WeeklyStats{Weeks: make(map[string]int)}
-	}
-	data, err := os.ReadFile(p)
+	s, err := store.Default()
 	if err != nil {
 		return WeeklyStats{Weeks: make(map[string]int)}
 	}
 	var stats WeeklyStats
-	if err := json.Unmarshal(data, &stats); err != nil {
+	if _, err := s.Get(store.KeyWeeklyStats, &stats); err != nil {
 		return WeeklyStats{Weeks: make(map[string]int)}
 	}
 	if stats.Weeks == nil {
@@ -51,11 +34,11 @@ func LoadWeeklyStats() WeeklyStats {
 	return stats
 }
 
-// SaveWeeklyStats writes weekly stats to disk, pruning entries older than 26 weeks.
+// SaveWeeklyStats saves weekly stats, pruning entries older than 26 weeks.
 func SaveWeeklyStats(stats WeeklyStats) error {
-	p := weeklyStatsPath()
-	if p == "" {
-		return nil
+	s, err := store.Default()
+	if err != nil {
+		return err
 	}
 
 	// Prune entries older than 26 weeks
@@ -67,13 +50,5 @@ func SaveWeeklyStats(stats WeeklyStats) error {
 		}
 	}
 
-	dir := filepath.Dir(p)
-	if err := os.MkdirAll(dir, 0700); err != nil {
-		return err
-	}
-	data, err := json.MarshalIndent(stats, "", "  ")
-	if err != nil {
-		return err
-	}
-	return os.WriteFile(p, data, 0600)
+	return s.Put(store.KeyWeeklyStats, stats)
 }
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+)
+
+// WorkingHours describes the local working window used to flag after-hours
+// activity. Hours are in local time; EndHour is exclusive.
+type WorkingHours struct {
+	StartHour   int  `json:"start_hour"`
+	EndHour     int  `json:"end_hour"`
+	WeekendsOff bool `json:"weekends_off"`
+}
+
+// DefaultWorkingHours is 09:00-18:00, Monday to Friday.
+var DefaultWorkingHours = WorkingHours{StartHour: 9, EndHour: 18, WeekendsOff: true}
+
+// IsAfterHours reports whether t falls outside the working window.
+func (w WorkingHours) IsAfterHours(t time.Time) bool {
+	local := t.Local()
+	if w.WeekendsOff && (local.Weekday() == time.Saturday || local.Weekday() == time.Sunday) {
+		return true
+	}
+	hour := local.Hour()
+	return hour < w.StartHour || hour >= w.EndHour
+}
+
+func workingHoursPath() string {
+	dir := configDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "working_hours.json")
+}
+
+// LoadWorkingHours reads the configured working hours from disk, falling back
+// to DefaultWorkingHours if the file is missing or invalid.
+func LoadWorkingHours() WorkingHours {
+	p := workingHoursPath()
+	if p == "" {
+		return DefaultWorkingHours
+	}
+	data, p, err := readSettings("working_hours", p)
+	if err != nil {
+		return DefaultWorkingHours
+	}
+	var w WorkingHours
+	if err := json.Unmarshal(data, &w); err != nil {
+		return DefaultWorkingHours
+	}
+	if w.StartHour < 0 || w.EndHour > 24 || w.StartHour >= w.EndHour {
+		return DefaultWorkingHours
+	}
+	return w
+}
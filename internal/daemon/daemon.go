@@ -0,0 +1,397 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jpoz/hubell/internal/calendar"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+	"github.com/jpoz/hubell/internal/notify"
+)
+
+// Event names accepted in config.EmailSettings.Events and
+// config.OutboundRule.Events.
+const (
+	EventCIFailure       = "ci_failure"
+	EventReviewRequested = "review_requested"
+	EventMention         = "mention"
+	EventAssign          = "assign"
+	EventSecretScanning  = config.AlertSecretScanning
+	EventFailingMain     = config.AlertFailingMain
+)
+
+// Options configures a Daemon.
+type Options struct {
+	// Email, when enabled, is used for high-priority events while no desktop
+	// session is active.
+	Email config.EmailSettings
+	// Outbound routes events to chat backends (Discord, Telegram, Matrix).
+	Outbound config.OutboundSettings
+	// Calendar, when configured, holds desktop notifications back during
+	// meetings and delivers them as a digest when the meeting ends.
+	Calendar config.CalendarSettings
+	// Rotation gates some alerts on Login being on call this week.
+	Rotation config.RotationSettings
+	Login    string
+}
+
+// Daemon keeps hubell resident without the TUI: it consumes poller events and
+// raises desktop notifications for new notifications and CI changes.
+type Daemon struct {
+	events   <-chan github.Event
+	email    config.EmailSettings
+	outbound config.OutboundSettings
+	backends map[string]notify.Backend
+	calendar *calendar.Tracker
+	rotation config.RotationSettings
+	login    string
+	digest   notify.Digest
+	meeting  bool
+
+	// pending collects the changes published during the current poll
+	// until its PollCompleted arrives.
+	pending pollChanges
+
+	mu         sync.Mutex
+	unread     int
+	failing    int
+	lastUnread int
+	dnd        bool
+	onUpdate   func(unread, failing int)
+}
+
+// pollChanges are the change events of one poll cycle.
+type pollChanges struct {
+	notifications []*github.Notification
+	prChanges     []github.PRStatusChange
+	secretAlerts  []github.SecretScanningAlert
+	failingMains  []github.BranchHealth
+}
+
+// New creates a daemon reading from the given poller subscription.
+func New(events <-chan github.Event, opts Options) *Daemon {
+	backends := make(map[string]notify.Backend, len(opts.Outbound.Backends))
+	for name, b := range opts.Outbound.Backends {
+		backends[name] = newBackend(b)
+	}
+	d := &Daemon{
+		events:   events,
+		email:    opts.Email,
+		outbound: opts.Outbound,
+		backends: backends,
+		rotation: opts.Rotation,
+		login:    opts.Login,
+	}
+	if opts.Calendar.Enabled() {
+		d.calendar = calendar.NewTracker(opts.Calendar.Source(), opts.Calendar.RefreshInterval())
+	}
+	return d
+}
+
+// OnUpdate registers a callback invoked with fresh counts after every poll.
+func (d *Daemon) OnUpdate(fn func(unread, failing int)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.onUpdate = fn
+}
+
+// SetDND enables or disables do-not-disturb. While enabled no desktop
+// notifications are sent; email and chat alerts still are.
+func (d *Daemon) SetDND(on bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dnd = on
+}
+
+// DND reports whether do-not-disturb is enabled.
+func (d *Daemon) DND() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.dnd
+}
+
+// Counts returns the unread notification and failing PR counts from the last poll.
+func (d *Daemon) Counts() (unread, failing int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.unread, d.failing
+}
+
+// Run consumes poller events until the context is cancelled or the poller stops.
+func (d *Daemon) Run(ctx context.Context) error {
+	var meetingCh <-chan time.Time
+	if d.calendar != nil {
+		d.checkMeeting(ctx)
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		meetingCh = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-meetingCh:
+			d.checkMeeting(ctx)
+		case e, ok := <-d.events:
+			if !ok {
+				return nil
+			}
+			d.receive(e)
+		}
+	}
+}
+
+// receive collects a poll's change events and handles them together once
+// the poll completes.
+func (d *Daemon) receive(e github.Event) {
+	switch e := e.(type) {
+	case github.NotificationAdded:
+		d.pending.notifications = append(d.pending.notifications, e.Notification)
+	case github.PRStatusChanged:
+		d.pending.prChanges = append(d.pending.prChanges, e.PRStatusChange)
+	case github.SecretAlertRaised:
+		d.pending.secretAlerts = append(d.pending.secretAlerts, e.SecretScanningAlert)
+	case github.MainBranchBroken:
+		d.pending.failingMains = append(d.pending.failingMains, e.BranchHealth)
+	case github.PollCompleted:
+		d.handle(e.Result, d.pending)
+		d.pending = pollChanges{}
+	case github.PollFailed:
+		d.pending = pollChanges{}
+	}
+}
+
+// handle updates counts from a poll result and sends notifications for the
+// poll's changes.
+func (d *Daemon) handle(result github.PollResult, changes pollChanges) {
+	// A source that failed keeps its previous count
+	d.mu.Lock()
+	unread, failing := d.unread, d.failing
+	d.mu.Unlock()
+	if result.Errors[github.SourceNotifications] == nil {
+		unread = 0
+		for _, n := range result.Notifications {
+			if n.Unread {
+				unread++
+			}
+		}
+	}
+	if result.Errors[github.SourcePRs] == nil {
+		failing = 0
+		for _, status := range result.PRStatuses {
+			if status == github.PRStatusFailure {
+				failing++
+			}
+		}
+	}
+
+	d.mu.Lock()
+	d.unread = unread
+	d.failing = failing
+	newCount := unread - d.lastUnread
+	d.lastUnread = unread
+	quiet := d.dnd
+	onUpdate := d.onUpdate
+	d.mu.Unlock()
+
+	if !quiet {
+		if newCount > 0 {
+			d.desktopNotify(
+				"GitHub Notifications",
+				fmt.Sprintf("You have %d new notification(s)", newCount),
+			)
+		}
+		for _, change := range changes.prChanges {
+			d.desktopNotify(
+				fmt.Sprintf("CI %s: %s/%s", change.NewStatus, change.Owner, change.Repo),
+				fmt.Sprintf("PR #%d: %s (%s → %s)", change.Number, change.Title, change.OldStatus, change.NewStatus),
+			)
+		}
+		now := time.Now()
+		for _, alert := range changes.secretAlerts {
+			if d.rotation.Notifies(EventSecretScanning, d.login, now) {
+				d.desktopNotify(alert.Title(), alert.Summary())
+			}
+		}
+		for _, h := range changes.failingMains {
+			if !d.rotation.Notifies(EventFailingMain, d.login, now) {
+				continue
+			}
+			d.desktopNotify(
+				fmt.Sprintf("%s is red: %s", h.Branch, h.FullName()),
+				fmt.Sprintf("%.7s by @%s: %s", h.BreakingSHA, h.BreakingAuthor, h.BreakingMessage),
+			)
+		}
+	}
+
+	d.dispatch(collectEvents(changes))
+
+	if onUpdate != nil {
+		onUpdate(unread, failing)
+	}
+}
+
+// checkMeeting updates meeting mode from the calendar and delivers the
+// digest when a meeting ends.
+func (d *Daemon) checkMeeting(ctx context.Context) {
+	meeting, in, ended, err := d.calendar.Check(ctx, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "calendar: %v\n", err)
+	}
+	if ended {
+		d.digest.Flush(fmt.Sprintf("While you were in %q", meeting.Summary))
+	}
+	d.mu.Lock()
+	d.meeting = in
+	d.mu.Unlock()
+}
+
+// desktopNotify sends a desktop notification, or queues it for the digest
+// while a meeting is in progress.
+func (d *Daemon) desktopNotify(title, body string) {
+	d.mu.Lock()
+	inMeeting := d.meeting
+	d.mu.Unlock()
+	if inMeeting {
+		d.digest.Add(title, body)
+		return
+	}
+	notify.SendDesktopNotification(title, body)
+}
+
+// event is a notable change found in a poll. Kind is one of the
+// Event* constants or, for notifications, the raw notification reason.
+type event struct {
+	Kind string
+	Text string // summary line followed by an indented URL line
+}
+
+// collectEvents returns the CI failures, new secret scanning alerts, newly
+// red default branches and new unread notifications of a poll.
+func collectEvents(changes pollChanges) []event {
+	var events []event
+
+	for _, change := range changes.prChanges {
+		if change.NewStatus == github.PRStatusFailure {
+			events = append(events, event{
+				Kind: EventCIFailure,
+				Text: fmt.Sprintf("CI failed on %s/%s#%d: %s\n  %s",
+					change.Owner, change.Repo, change.Number, change.Title, change.URL),
+			})
+		}
+	}
+
+	for _, alert := range changes.secretAlerts {
+		events = append(events, event{
+			Kind: EventSecretScanning,
+			Text: fmt.Sprintf("%s: %s\n  %s", alert.Title(), alert.Summary(), alert.HTMLURL),
+		})
+	}
+
+	for _, h := range changes.failingMains {
+		events = append(events, event{
+			Kind: EventFailingMain,
+			Text: fmt.Sprintf("%s broke on %s by @%s: %s\n  %s", h.Branch, h.FullName(), h.BreakingAuthor, h.BreakingMessage, h.URL()),
+		})
+	}
+
+	for _, n := range changes.notifications {
+		events = append(events, event{
+			Kind: n.Reason,
+			Text: fmt.Sprintf("%s on %s: %s\n  %s",
+				strings.ReplaceAll(n.Reason, "_", " "), n.Repository.FullName, n.Subject.Title,
+				github.NotificationWebURL(n)),
+		})
+	}
+	return events
+}
+
+// matching returns the text of the events whose kind is in kinds.
+func matching(events []event, kinds []string) []string {
+	var texts []string
+	for _, e := range events {
+		if slices.Contains(kinds, e.Kind) {
+			texts = append(texts, e.Text)
+		}
+	}
+	return texts
+}
+
+// dispatch routes events to the chat backends named by each outbound rule,
+// and to email when no desktop session is active. Alerts gated by the
+// on-call rotation are dropped while the user is off call.
+func (d *Daemon) dispatch(events []event) {
+	now := time.Now()
+	events = slices.DeleteFunc(events, func(e event) bool {
+		return !d.rotation.Notifies(e.Kind, d.login, now)
+	})
+	if len(events) == 0 {
+		return
+	}
+
+	for _, rule := range d.outbound.Rules {
+		texts := matching(events, rule.Events)
+		if len(texts) == 0 {
+			continue
+		}
+		title, body := summarize(texts)
+		for _, name := range rule.Backends {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			if err := d.backends[name].Send(ctx, title, body); err != nil {
+				fmt.Fprintf(os.Stderr, "%s notification: %v\n", name, err)
+			}
+			cancel()
+		}
+	}
+
+	if d.email.Enabled {
+		if texts := matching(events, d.email.Events); len(texts) > 0 && !notify.DesktopSessionActive() {
+			d.sendEmail(texts)
+		}
+	}
+}
+
+// summarize builds a title and body for a batch of event texts.
+func summarize(texts []string) (string, string) {
+	title := fmt.Sprintf("hubell: %d high-priority event(s)", len(texts))
+	if len(texts) == 1 {
+		title = "hubell: " + strings.SplitN(texts[0], "\n", 2)[0]
+	}
+	return title, strings.Join(texts, "\n\n")
+}
+
+// sendEmail delivers the given events in a single message.
+func (d *Daemon) sendEmail(texts []string) {
+	subject, body := summarize(texts)
+	cfg := notify.SMTPConfig{
+		Host:     d.email.Host,
+		Port:     d.email.Port,
+		Username: d.email.Username,
+		Password: d.email.Password,
+		From:     d.email.From,
+		To:       d.email.To,
+	}
+	if err := notify.SendEmail(cfg, subject, body); err != nil {
+		fmt.Fprintf(os.Stderr, "email notification: %v\n", err)
+	}
+}
+
+// newBackend builds the notify backend for a configured chat backend.
+func newBackend(b config.OutboundBackend) notify.Backend {
+	switch b.Type {
+	case "discord":
+		return notify.Discord{WebhookURL: b.WebhookURL}
+	case "telegram":
+		return notify.Telegram{BotToken: b.BotToken, ChatID: b.ChatID}
+	case "matrix":
+		return notify.Matrix{Homeserver: strings.TrimRight(b.Homeserver, "/"), AccessToken: b.AccessToken, RoomID: b.RoomID}
+	}
+	return nil
+}
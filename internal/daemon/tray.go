@@ -0,0 +1,125 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"fyne.io/systray"
+)
+
+// RunTray shows a system tray icon for the daemon and blocks until the user
+// quits from the tray menu or the context is cancelled. It must be called
+// from the main goroutine.
+func RunTray(ctx context.Context, cancel context.CancelFunc, d *Daemon) {
+	systray.Run(func() {
+		systray.SetIcon(trayIcon(false))
+		systray.SetTooltip("hubell")
+
+		mOpen := systray.AddMenuItem("Open hubell", "Open the hubell TUI in a terminal")
+		mDND := systray.AddMenuItemCheckbox("Do not disturb", "Pause desktop notifications", d.DND())
+		systray.AddSeparator()
+		mQuit := systray.AddMenuItem("Quit", "Stop the hubell daemon")
+
+		d.OnUpdate(func(unread, failing int) {
+			systray.SetIcon(trayIcon(failing > 0))
+			systray.SetTitle(trayTitle(unread, failing))
+			systray.SetTooltip(fmt.Sprintf("hubell: %d unread, %d failing", unread, failing))
+		})
+
+		go func() {
+			_ = d.Run(ctx)
+			systray.Quit()
+		}()
+
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-mOpen.ClickedCh:
+					_ = openTUI()
+				case <-mDND.ClickedCh:
+					if mDND.Checked() {
+						mDND.Uncheck()
+						d.SetDND(false)
+					} else {
+						mDND.Check()
+						d.SetDND(true)
+					}
+				case <-mQuit.ClickedCh:
+					cancel()
+					systray.Quit()
+					return
+				}
+			}
+		}()
+	}, cancel)
+}
+
+// trayTitle returns the short text shown next to the icon on platforms that
+// support it, e.g. "3 · 1✗".
+func trayTitle(unread, failing int) string {
+	if failing > 0 {
+		return fmt.Sprintf("%d · %d✗", unread, failing)
+	}
+	if unread > 0 {
+		return fmt.Sprintf("%d", unread)
+	}
+	return ""
+}
+
+// openTUI launches the interactive TUI in a new terminal window.
+func openTUI() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("osascript", "-e", fmt.Sprintf(`tell application "Terminal" to do script %q`, exe))
+	case "linux":
+		term := os.Getenv("TERMINAL")
+		if term == "" {
+			term = "x-terminal-emulator"
+		}
+		cmd = exec.Command(term, "-e", exe)
+	default:
+		return fmt.Errorf("unsupported platform: %s", runtime.GOOS)
+	}
+
+	return cmd.Start()
+}
+
+// trayIcon renders a small filled circle, red when any PR is failing.
+func trayIcon(alert bool) []byte {
+	const size = 32
+	fill := color.RGBA{R: 0x58, G: 0xa6, B: 0xff, A: 0xff}
+	if alert {
+		fill = color.RGBA{R: 0xf8, G: 0x51, B: 0x49, A: 0xff}
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	center := float64(size-1) / 2
+	radius := float64(size)/2 - 2
+	for y := range size {
+		for x := range size {
+			dx, dy := float64(x)-center, float64(y)-center
+			if dx*dx+dy*dy <= radius*radius {
+				img.Set(x, y, fill)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img)
+	return buf.Bytes()
+}
@@ -0,0 +1,66 @@
+package debuglog
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+)
+
+// maxSize is the size past which the log is started afresh when enabled.
+const maxSize = 5 << 20
+
+// Path returns the debug log location: ~/.cache/hubell/debug.log, or under
+// $XDG_CACHE_HOME when set.
+func Path() string {
+	cacheDir := os.Getenv("XDG_CACHE_HOME")
+	if cacheDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		cacheDir = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cacheDir, "hubell", "debug.log")
+}
+
+// Enable routes slog's default logger, at debug level, to the debug log.
+// Without it debug records are dropped. Close the returned file on exit.
+func Enable() (io.Closer, error) {
+	p := Path()
+	if p == "" {
+		return nil, os.ErrNotExist
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return nil, err
+	}
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if fi, err := os.Stat(p); err == nil && fi.Size() > maxSize {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(p, flags, 0600)
+	if err != nil {
+		return nil, err
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(f, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	slog.Debug("debug log started", "pid", os.Getpid())
+	return f, nil
+}
+
+// Tail returns up to n of the log's last lines, oldest first.
+func Tail(n int) ([]string, error) {
+	data, err := os.ReadFile(Path())
+	if err != nil {
+		return nil, err
+	}
+	lines := bytes.Split(bytes.TrimRight(data, "\n"), []byte("\n"))
+	lines = lines[max(len(lines)-n, 0):]
+	tail := make([]string, 0, len(lines))
+	for _, l := range lines {
+		if len(l) > 0 {
+			tail = append(tail, string(l))
+		}
+	}
+	return tail, nil
+}
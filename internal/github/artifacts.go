@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// WorkflowRun is a GitHub Actions workflow run.
+type WorkflowRun struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	HTMLURL    string `json:"html_url"`
+}
+
+// Artifact is a file uploaded by a workflow run.
+type Artifact struct {
+	ID                 int64     `json:"id"`
+	Name               string    `json:"name"`
+	SizeInBytes        int64     `json:"size_in_bytes"`
+	Expired            bool      `json:"expired"`
+	ArchiveDownloadURL string    `json:"archive_download_url"`
+	CreatedAt          time.Time `json:"created_at"`
+
+	// RunName is the workflow run that produced the artifact; not part of
+	// the API response.
+	RunName string `json:"-"`
+}
+
+// ListWorkflowRunsForSHA fetches the Actions workflow runs for a commit.
+func (c *ChecksService) ListWorkflowRunsForSHA(ctx context.Context, owner, repo, sha string) ([]WorkflowRun, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/actions/runs?head_sha=%s&per_page=100", c.baseURL, owner, repo, url.QueryEscape(sha))
+
+	var result struct {
+		WorkflowRuns []WorkflowRun `json:"workflow_runs"`
+	}
+	if err := c.getJSON(ctx, u, &result); err != nil {
+		return nil, fmt.Errorf("list workflow runs: %w", err)
+	}
+	return result.WorkflowRuns, nil
+}
+
+// ListRunArtifacts fetches the artifacts uploaded by a workflow run.
+func (c *ChecksService) ListRunArtifacts(ctx context.Context, owner, repo string, runID int64) ([]Artifact, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/actions/runs/%d/artifacts?per_page=100", c.baseURL, owner, repo, runID)
+
+	var result struct {
+		Artifacts []Artifact `json:"artifacts"`
+	}
+	if err := c.getJSON(ctx, u, &result); err != nil {
+		return nil, fmt.Errorf("list artifacts: %w", err)
+	}
+	return result.Artifacts, nil
+}
+
+// ListPRArtifacts returns the unexpired artifacts of the completed workflow
+// runs for a PR's head commit.
+func (c *ChecksService) ListPRArtifacts(ctx context.Context, owner, repo, headSHA string) ([]Artifact, error) {
+	runs, err := c.ListWorkflowRunsForSHA(ctx, owner, repo, headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []Artifact
+	for _, run := range runs {
+		if run.Status != "completed" {
+			continue
+		}
+		artifacts, err := c.ListRunArtifacts(ctx, owner, repo, run.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range artifacts {
+			if a.Expired {
+				continue
+			}
+			a.RunName = run.Name
+			all = append(all, a)
+		}
+	}
+	return all, nil
+}
+
+// DownloadArtifact saves an artifact's zip archive into dir and returns the
+// path written. Downloads are bounded by ctx rather than the API timeout.
+func (c *ChecksService) DownloadArtifact(ctx context.Context, a Artifact, dir string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", a.ArchiveDownloadURL, nil)
+	if err != nil {
+		return "", err
+	}
+	c.setHeaders(req)
+
+	// The API redirects to blob storage; net/http drops the Authorization
+	// header when following a redirect to another host.
+	resp, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("download artifact: status %d", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, filepath.Base(a.Name)+".zip")
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		os.Remove(path)
+		return "", fmt.Errorf("download artifact: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,31 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// EnablePullRequestAutoMerge arms auto-merge on a PR, so GitHub merges it
+// with method ("merge", "squash" or "rebase") once its requirements pass.
+// nodeID is the PR's GraphQL node ID.
+func (c *PullRequestsService) EnablePullRequestAutoMerge(ctx context.Context, nodeID, method string) error {
+	const mutation = `mutation($id: ID!, $method: PullRequestMergeMethod!) {
+		enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method}) { clientMutationId }
+	}`
+	if err := c.graphql(ctx, mutation, map[string]any{"id": nodeID, "method": strings.ToUpper(method)}, nil); err != nil {
+		return fmt.Errorf("enable auto-merge: %w", err)
+	}
+	return nil
+}
+
+// DisablePullRequestAutoMerge disarms auto-merge on a PR.
+func (c *PullRequestsService) DisablePullRequestAutoMerge(ctx context.Context, nodeID string) error {
+	const mutation = `mutation($id: ID!) {
+		disablePullRequestAutoMerge(input: {pullRequestId: $id}) { clientMutationId }
+	}`
+	if err := c.graphql(ctx, mutation, map[string]any{"id": nodeID}, nil); err != nil {
+		return fmt.Errorf("disable auto-merge: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package github
+
+import "strings"
+
+// DefaultBotPatterns are the bot account patterns used when none are
+// configured.
+var DefaultBotPatterns = []string{"*[bot]", "*-bot", "dependabot", "renovate", "greenkeeper", "codecov", "coveralls"}
+
+// WithBotPatterns sets the login patterns of bot accounts, whose activity
+// the org dashboard flags and issue response times skip. A "*" matches any
+// run of characters and case is ignored. Nil keeps DefaultBotPatterns; an
+// empty list treats no one as a bot.
+func WithBotPatterns(patterns []string) Option {
+	return func(c *core) {
+		c.botPatterns = patterns
+	}
+}
+
+// isBot reports whether login matches a bot pattern.
+func (c *core) isBot(login string) bool {
+	patterns := c.botPatterns
+	if patterns == nil {
+		patterns = DefaultBotPatterns
+	}
+	for _, pattern := range patterns {
+		if matchLogin(pattern, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchLogin reports whether login matches pattern, in which "*" matches
+// any run of characters, ignoring case.
+func matchLogin(pattern, login string) bool {
+	parts := strings.Split(strings.ToLower(pattern), "*")
+	login = strings.ToLower(login)
+	if len(parts) == 1 {
+		return parts[0] == login
+	}
+	if !strings.HasPrefix(login, parts[0]) {
+		return false
+	}
+	login = login[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(login, part)
+		if i < 0 {
+			return false
+		}
+		login = login[i+len(part):]
+	}
+	return strings.HasSuffix(login, parts[len(parts)-1])
+}
@@ -0,0 +1,107 @@
+package github
+
+import (
+	"sync"
+	"time"
+)
+
+// prDetailTTL is how long per-PR details that are expensive to fetch every
+// poll (required checks, commits behind base, code scanning alerts) are
+// reused.
+const prDetailTTL = 10 * time.Minute
+
+// adminReposTTL is how long the list of repos the user administers is reused.
+const adminReposTTL = 30 * time.Minute
+
+// secretScanningTTL is how long a repo's secret scanning alerts are reused.
+// Short, since these are the alerts that must not wait.
+const secretScanningTTL = 2 * time.Minute
+
+// ttlCache memoizes values by key across polls for a fixed duration.
+type ttlCache[V any] struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]ttlEntry[V]
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	fetchedAt time.Time
+}
+
+func newTTLCache[V any](ttl time.Duration) *ttlCache[V] {
+	return &ttlCache[V]{ttl: ttl, entries: make(map[string]ttlEntry[V])}
+}
+
+// get returns the cached value for key, calling fetch when the entry is
+// missing or stale. Concurrent misses for the same key may both fetch.
+func (c *ttlCache[V]) get(key string, fetch func() V) V {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && time.Since(entry.fetchedAt) < c.ttl {
+		return entry.value
+	}
+
+	value := fetch()
+
+	c.mu.Lock()
+	c.entries[key] = ttlEntry[V]{value: value, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return value
+}
+
+// versionCache memoizes values by key for as long as the version they were
+// fetched at, e.g. an issue's updated_at, stays the same.
+type versionCache[V any] struct {
+	mu      sync.Mutex
+	entries map[string]versionEntry[V]
+}
+
+type versionEntry[V any] struct {
+	value   V
+	version time.Time
+}
+
+func newVersionCache[V any]() *versionCache[V] {
+	return &versionCache[V]{entries: make(map[string]versionEntry[V])}
+}
+
+// lookup returns the value cached for key at version, if there is one.
+func (c *versionCache[V]) lookup(key string, version time.Time) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !entry.version.Equal(version) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// store caches value for key at version.
+func (c *versionCache[V]) store(key string, version time.Time, value V) {
+	c.mu.Lock()
+	c.entries[key] = versionEntry[V]{value: value, version: version}
+	c.mu.Unlock()
+}
+
+// prCaches holds the per-PR and per-repo detail caches the poller keeps
+// across polls.
+type prCaches struct {
+	requiredChecks *ttlCache[[]string]
+	behindBy       *ttlCache[int]
+	codeScanning   *ttlCache[[]CodeScanningAlert]
+	adminRepos     *ttlCache[[]string]
+	secretScanning *ttlCache[[]SecretScanningAlert]
+}
+
+func newPRCaches() *prCaches {
+	return &prCaches{
+		requiredChecks: newTTLCache[[]string](prDetailTTL),
+		behindBy:       newTTLCache[int](prDetailTTL),
+		codeScanning:   newTTLCache[[]CodeScanningAlert](prDetailTTL),
+		adminRepos:     newTTLCache[[]string](adminReposTTL),
+		secretScanning: newTTLCache[[]SecretScanningAlert](secretScanningTTL),
+	}
+}
@@ -0,0 +1,104 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetCheckRuns fetches all check runs for a given commit SHA, paginating
+// through all pages to ensure none are missed.
+func (c *ChecksService) GetCheckRuns(ctx context.Context, owner, repo, sha string) (*CheckRunsResponse, error) {
+	var allCheckRuns []CheckRun
+	totalCount := 0
+
+	for page := 1; ; page++ {
+		pageURL := fmt.Sprintf("%s/repos/%s/%s/commits/%s/check-runs?per_page=100&page=%d", c.baseURL, owner, repo, sha, page)
+
+		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		c.setHeaders(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		}
+
+		var result CheckRunsResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to decode check runs: %w", err)
+		}
+		resp.Body.Close()
+
+		totalCount = result.TotalCount
+		allCheckRuns = append(allCheckRuns, result.CheckRuns...)
+
+		if len(allCheckRuns) >= totalCount || len(result.CheckRuns) < 100 {
+			break
+		}
+	}
+
+	return &CheckRunsResponse{
+		TotalCount: totalCount,
+		CheckRuns:  allCheckRuns,
+	}, nil
+}
+
+// GetCommitStatus fetches the combined commit status for a given SHA.
+// This covers legacy status checks (e.g. older CI systems) that don't use
+// the newer Check Runs API.
+func (c *ChecksService) GetCommitStatus(ctx context.Context, owner, repo, sha string) (*CombinedStatus, error) {
+	var allStatuses []CommitStatus
+	totalCount := 0
+
+	for page := 1; ; page++ {
+		pageURL := fmt.Sprintf("%s/repos/%s/%s/commits/%s/status?per_page=100&page=%d", c.baseURL, owner, repo, sha, page)
+
+		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		c.setHeaders(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		}
+
+		var result CombinedStatus
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to decode commit status: %w", err)
+		}
+		resp.Body.Close()
+
+		totalCount = result.TotalCount
+		allStatuses = append(allStatuses, result.Statuses...)
+
+		if len(allStatuses) >= totalCount || len(result.Statuses) < 100 {
+			break
+		}
+	}
+
+	return &CombinedStatus{
+		State:      "",
+		TotalCount: totalCount,
+		Statuses:   allStatuses,
+	}, nil
+}
@@ -3,409 +3,185 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"time"
-	"unicode"
 )
 
 const (
-	baseURL       = "https://api.github.com"
-	apiVersion    = "application/vnd.github+json"
-	apiVersionHdr = "2022-11-28"
+	defaultBaseURL = "https://api.github.com"
+	apiVersion     = "application/vnd.github+json"
+	apiVersionHdr  = "2022-11-28"
 )
 
-// Client is a GitHub API client
+// ErrUnauthorized is returned when GitHub rejects the token, typically
+// because it expired or was revoked.
+var ErrUnauthorized = errors.New("unauthorized: token may be invalid or expired")
+
+// Client is a GitHub API client. Endpoints are grouped into services that
+// share one authenticated transport.
 type Client struct {
-	token        string
-	httpClient   *http.Client
+	*core
+
+	Notifications *NotificationsService
+	PullRequests  *PullRequestsService
+	Checks        *ChecksService
+	Repos         *ReposService
+	Orgs          *OrgsService
+	Search        *SearchService
+}
+
+// core is the transport shared by every service of a Client.
+type core struct {
+	// token is swapped by SetToken while requests may be in flight
+	token      atomic.Pointer[string]
+	baseURL    string
+	httpClient *http.Client
+	// timeout bounds each attempt of a request; retry holds the retry
+	// policy of each CallClass
+	timeout time.Duration
+	retry   map[CallClass]RetryPolicy
+	// rateLimit is the latest REST rate limit seen, nil before any
+	rateLimit atomic.Pointer[RateLimit]
+	// botPatterns match bot logins; nil means DefaultBotPatterns
+	botPatterns []string
+	// issueResponses and issueClosers keep the per-issue lookups of the org
+	// issue stats until the issue is updated
+	issueResponses *versionCache[issueResponse]
+	issueClosers   *versionCache[string]
+
+	// client lets a service call endpoints that live on another service.
+	client *Client
+}
+
+// NotificationsService covers notifications, comment threads and repository
+// subscriptions.
+type NotificationsService struct {
+	*core
 	lastModified string
 }
 
-// NewClient creates a new GitHub API client
-func NewClient(token string) *Client {
-	return &Client{
-		token: token,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-	}
-}
+// PullRequestsService covers pull requests, their reviews and comments.
+type PullRequestsService struct{ *core }
 
-// setHeaders sets the common GitHub API headers on a request
-func (c *Client) setHeaders(req *http.Request) {
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Accept", apiVersion)
-	req.Header.Set("X-GitHub-Api-Version", apiVersionHdr)
-}
+// ChecksService covers check runs, commit statuses and Actions artifacts.
+type ChecksService struct{ *core }
 
-// ListNotifications fetches all notifications for the authenticated user
-// Uses Last-Modified header for efficient polling (returns nil if 304 Not Modified)
-func (c *Client) ListNotifications(ctx context.Context) ([]*Notification, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/notifications", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.setHeaders(req)
-
-	// Add If-Modified-Since header for efficient polling
-	if c.lastModified != "" {
-		req.Header.Set("If-Modified-Since", c.lastModified)
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+// ReposService covers repository-level endpoints: comparisons, forks and
+// security alerts.
+type ReposService struct{ *core }
 
-	// Handle 304 Not Modified - no new notifications
-	if resp.StatusCode == http.StatusNotModified {
-		return nil, nil
-	}
+// OrgsService covers organization members, activity and reporting.
+type OrgsService struct{ *core }
 
-	// Handle other error status codes
-	if resp.StatusCode == http.StatusUnauthorized {
-		return nil, fmt.Errorf("unauthorized: token may be invalid or expired")
-	}
-	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
-		return nil, fmt.Errorf("rate limited or forbidden (status %d)", resp.StatusCode)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
+// SearchService covers the search and GraphQL queries about the
+// authenticated user's work.
+type SearchService struct{ *core }
 
-	// Store Last-Modified header for next request
-	if lm := resp.Header.Get("Last-Modified"); lm != "" {
-		c.lastModified = lm
-	}
+// Option configures a Client.
+type Option func(*core)
 
-	// Decode notifications
-	var notifications []*Notification
-	if err := json.NewDecoder(resp.Body).Decode(&notifications); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+// WithHTTPClient sets the HTTP client used for API requests. It replaces
+// the default transport, so requests through it are neither retried,
+// traced nor counted against RateLimit.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *core) {
+		c.httpClient = hc
 	}
-
-	return notifications, nil
 }
 
-// MarkAsRead marks a notification thread as read
-func (c *Client) MarkAsRead(ctx context.Context, threadID string) error {
-	url := fmt.Sprintf("%s/notifications/threads/%s", baseURL, threadID)
-	req, err := http.NewRequestWithContext(ctx, "PATCH", url, nil)
-	if err != nil {
-		return err
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
+// WithBaseURL points the client at another API root, such as a GitHub
+// Enterprise Server's https://HOST/api/v3.
+func WithBaseURL(u string) Option {
+	return func(c *core) {
+		c.baseURL = strings.TrimRight(u, "/")
 	}
-	defer resp.Body.Close()
-
-	// Expect 205 Reset Content
-	if resp.StatusCode != http.StatusResetContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	return nil
 }
 
-// GetAuthenticatedUser returns the currently authenticated user
-func (c *Client) GetAuthenticatedUser(ctx context.Context) (*User, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/user", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+// WithTimeout sets the timeout of each attempt of an API request. Defaults
+// to 30 seconds.
+func WithTimeout(d time.Duration) Option {
+	return func(c *core) {
+		c.timeout = d
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	var user User
-	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
-		return nil, fmt.Errorf("failed to decode user: %w", err)
-	}
-
-	return &user, nil
 }
 
-// SearchUserOpenPRs fetches all open pull requests created by the authenticated user.
-// It merges results from /user/issues (which includes private repos when the token has
-// repo scope) and the search API (which includes PRs on repos where the user is not a
-// member, e.g. open source contributions via forks). Both sources are queried concurrently.
-func (c *Client) SearchUserOpenPRs(ctx context.Context, username string) (*SearchResult, error) {
-	type sourceResult struct {
-		items []SearchItem
-		err   error
-	}
-
-	userCh := make(chan sourceResult, 1)
-	searchCh := make(chan sourceResult, 1)
-
-	// /user/issues covers private repos where the user is a collaborator/member
-	go func() {
-		result, err := c.listUserOpenPRs(ctx)
-		if err != nil {
-			userCh <- sourceResult{err: err}
-		} else {
-			userCh <- sourceResult{items: result.Items}
-		}
-	}()
-
-	// Search API covers external repos (forks, open source contributions)
-	go func() {
-		result, err := c.searchUserOpenPRs(ctx, username)
-		if err != nil {
-			searchCh <- sourceResult{err: err}
-		} else {
-			searchCh <- sourceResult{items: result.Items}
-		}
-	}()
-
-	ur := <-userCh
-	sr := <-searchCh
-
-	seen := make(map[string]struct{})
-	var allItems []SearchItem
+// NewClient creates a new GitHub API client
+func NewClient(token string, opts ...Option) *Client {
+	c := &core{
+		baseURL: defaultBaseURL,
+		timeout: 30 * time.Second,
+		retry:   maps.Clone(DefaultRetryPolicies),
 
-	if ur.err == nil {
-		for _, item := range ur.items {
-			key := item.HTMLURL
-			if _, ok := seen[key]; !ok {
-				seen[key] = struct{}{}
-				allItems = append(allItems, item)
-			}
-		}
+		issueResponses: newVersionCache[issueResponse](),
+		issueClosers:   newVersionCache[string](),
 	}
-
-	if sr.err == nil {
-		for _, item := range sr.items {
-			key := item.HTMLURL
-			if _, ok := seen[key]; !ok {
-				seen[key] = struct{}{}
-				allItems = append(allItems, item)
-			}
+	c.token.Store(&token)
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.httpClient == nil {
+		// Retries wrap tracing so every attempt is logged
+		c.httpClient = &http.Client{
+			Transport: &retryTransport{base: traceTransport{base: http.DefaultTransport}, core: c},
 		}
 	}
-
-	if len(allItems) == 0 {
-		return nil, fmt.Errorf("failed to fetch open PRs from any source")
+	client := &Client{
+		core:          c,
+		Notifications: &NotificationsService{core: c},
+		PullRequests:  &PullRequestsService{core: c},
+		Checks:        &ChecksService{core: c},
+		Repos:         &ReposService{core: c},
+		Orgs:          &OrgsService{core: c},
+		Search:        &SearchService{core: c},
 	}
-
-	return &SearchResult{Items: allItems}, nil
+	c.client = client
+	return client
 }
 
-// listUserOpenPRs uses GET /user/issues to list PRs including private repos.
-// Requires repo scope on the token.
-func (c *Client) listUserOpenPRs(ctx context.Context) (*SearchResult, error) {
-	var allItems []SearchItem
-
-	for page := 1; ; page++ {
-		pageURL := fmt.Sprintf("%s/user/issues?filter=created&state=open&per_page=100&page=%d", baseURL, page)
-
-		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		c.setHeaders(req)
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			resp.Body.Close()
-			return nil, fmt.Errorf("/user/issues: status %d", resp.StatusCode)
-		}
-
-		var items []SearchItem
-		if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
-			resp.Body.Close()
-			return nil, fmt.Errorf("failed to decode user issues: %w", err)
-		}
-		resp.Body.Close()
-
-		for _, item := range items {
-			// Only include pull requests (items with a pull_request ref)
-			if item.PullRequestRef.URL != "" {
-				allItems = append(allItems, item)
-			}
-		}
-
-		if len(items) < 100 {
-			break
-		}
-	}
-
-	return &SearchResult{Items: allItems}, nil
+// SetToken replaces the token used by every later request, e.g. after the
+// previous one expired. Safe to call while the client is in use.
+func (c *Client) SetToken(token string) {
+	c.token.Store(&token)
 }
 
-// searchUserOpenPRs uses the search API as a fallback. Works for public repos
-// without repo scope but does not reliably include private repos.
-func (c *Client) searchUserOpenPRs(ctx context.Context, username string) (*SearchResult, error) {
-	q := fmt.Sprintf("author:%s+type:pr+state:open", username)
-	u := fmt.Sprintf("%s/search/issues?q=%s&per_page=100", baseURL, q)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("search: status %d", resp.StatusCode)
-	}
-
-	var result SearchResult
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode search results: %w", err)
-	}
-
-	return &result, nil
+// setHeaders sets the common GitHub API headers on a request
+func (c *core) setHeaders(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+*c.token.Load())
+	req.Header.Set("Accept", apiVersion)
+	req.Header.Set("X-GitHub-Api-Version", apiVersionHdr)
 }
 
-// SearchMergedPRsThisWeek fetches PRs merged by the user since the start of the current week (Monday).
-func (c *Client) SearchMergedPRsThisWeek(ctx context.Context, username string) ([]MergedPRInfo, error) {
-	now := time.Now()
-	weekday := now.Weekday()
-	if weekday == time.Sunday {
-		weekday = 7
-	}
-	monday := now.AddDate(0, 0, -int(weekday-time.Monday))
-	mondayStr := monday.Format("2006-01-02")
-
-	q := fmt.Sprintf("author:%s+type:pr+is:merged+merged:>=%s", username, mondayStr)
-	u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=30", baseURL, q)
-
+// getJSON performs an authenticated GET and decodes a 200 response into out.
+func (c *core) getJSON(ctx context.Context, u string, out any) error {
 	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
 	c.setHeaders(req)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("search merged PRs: status %d", resp.StatusCode)
-	}
-
-	var result SearchResult
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode merged PR search: %w", err)
-	}
-
-	var merged []MergedPRInfo
-	for _, item := range result.Items {
-		owner, repo := parseRepoURL(item.RepositoryURL)
-		if owner == "" || repo == "" {
-			continue
-		}
-		mergedAt := time.Time{}
-		if item.ClosedAt != nil {
-			mergedAt = *item.ClosedAt
-		}
-		merged = append(merged, MergedPRInfo{
-			Owner:     owner,
-			Repo:      repo,
-			Number:    item.Number,
-			Title:     item.Title,
-			URL:       item.HTMLURL,
-			Author:    item.User.Login,
-			CreatedAt: item.CreatedAt,
-			MergedAt:  mergedAt,
-		})
-	}
-
-	return merged, nil
-}
-
-// SearchMergedPRsSince fetches PRs merged by the user since the given date.
-// Uses per_page=100 to cover typical 12-week history in a single request.
-func (c *Client) SearchMergedPRsSince(ctx context.Context, username string, since time.Time) ([]MergedPRInfo, error) {
-	sinceStr := since.Format("2006-01-02")
-
-	q := fmt.Sprintf("author:%s+type:pr+is:merged+merged:>=%s", username, sinceStr)
-	u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=100", baseURL, q)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	if resp.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
 	}
-	defer resp.Body.Close()
-
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("search merged PRs since %s: status %d", sinceStr, resp.StatusCode)
+		return fmt.Errorf("status %d", resp.StatusCode)
 	}
-
-	var result SearchResult
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode merged PR search: %w", err)
-	}
-
-	var merged []MergedPRInfo
-	for _, item := range result.Items {
-		owner, repo := parseRepoURL(item.RepositoryURL)
-		if owner == "" || repo == "" {
-			continue
-		}
-		mergedAt := time.Time{}
-		if item.ClosedAt != nil {
-			mergedAt = *item.ClosedAt
-		}
-		merged = append(merged, MergedPRInfo{
-			Owner:     owner,
-			Repo:      repo,
-			Number:    item.Number,
-			Title:     item.Title,
-			URL:       item.HTMLURL,
-			Author:    item.User.Login,
-			CreatedAt: item.CreatedAt,
-			MergedAt:  mergedAt,
-		})
-	}
-
-	return merged, nil
+	return json.NewDecoder(resp.Body).Decode(out)
 }
 
-// GetPullRequest fetches a specific pull request
-func (c *Client) GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d", baseURL, owner, repo, number)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+// GetAuthenticatedUser returns the currently authenticated user
+func (c *Client) GetAuthenticatedUser(ctx context.Context) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/user", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -418,240 +194,18 @@ func (c *Client) GetPullRequest(ctx context.Context, owner, repo string, number
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	var pr PullRequest
-	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
-		return nil, fmt.Errorf("failed to decode pull request: %w", err)
-	}
-
-	return &pr, nil
-}
-
-// GetPullRequestReviews fetches reviews for a pull request
-func (c *Client) GetPullRequestReviews(ctx context.Context, owner, repo string, number int) ([]Review, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/reviews", baseURL, owner, repo, number)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, ErrUnauthorized
 	}
-	defer resp.Body.Close()
-
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
-	var reviews []Review
-	if err := json.NewDecoder(resp.Body).Decode(&reviews); err != nil {
-		return nil, fmt.Errorf("failed to decode reviews: %w", err)
-	}
-
-	return reviews, nil
-}
-
-// GetIssueComments fetches comments on an issue or pull request.
-func (c *Client) GetIssueComments(ctx context.Context, owner, repo string, number int, since time.Time) ([]IssueComment, error) {
-	sinceStr := since.Format(time.RFC3339)
-	u := fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments?since=%s&per_page=100", baseURL, owner, repo, number, sinceStr)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("get issue comments: status %d", resp.StatusCode)
-	}
-
-	var comments []IssueComment
-	if err := json.NewDecoder(resp.Body).Decode(&comments); err != nil {
-		return nil, fmt.Errorf("decode issue comments: %w", err)
-	}
-
-	return comments, nil
-}
-
-// GetCheckRuns fetches all check runs for a given commit SHA, paginating
-// through all pages to ensure none are missed.
-func (c *Client) GetCheckRuns(ctx context.Context, owner, repo, sha string) (*CheckRunsResponse, error) {
-	var allCheckRuns []CheckRun
-	totalCount := 0
-
-	for page := 1; ; page++ {
-		pageURL := fmt.Sprintf("%s/repos/%s/%s/commits/%s/check-runs?per_page=100&page=%d", baseURL, owner, repo, sha, page)
-
-		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		c.setHeaders(req)
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			resp.Body.Close()
-			return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-		}
-
-		var result CheckRunsResponse
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			resp.Body.Close()
-			return nil, fmt.Errorf("failed to decode check runs: %w", err)
-		}
-		resp.Body.Close()
-
-		totalCount = result.TotalCount
-		allCheckRuns = append(allCheckRuns, result.CheckRuns...)
-
-		if len(allCheckRuns) >= totalCount || len(result.CheckRuns) < 100 {
-			break
-		}
-	}
-
-	return &CheckRunsResponse{
-		TotalCount: totalCount,
-		CheckRuns:  allCheckRuns,
-	}, nil
-}
-
-// GetCommitStatus fetches the combined commit status for a given SHA.
-// This covers legacy status checks (e.g. older CI systems) that don't use
-// the newer Check Runs API.
-func (c *Client) GetCommitStatus(ctx context.Context, owner, repo, sha string) (*CombinedStatus, error) {
-	var allStatuses []CommitStatus
-	totalCount := 0
-
-	for page := 1; ; page++ {
-		pageURL := fmt.Sprintf("%s/repos/%s/%s/commits/%s/status?per_page=100&page=%d", baseURL, owner, repo, sha, page)
-
-		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		c.setHeaders(req)
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			resp.Body.Close()
-			return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-		}
-
-		var result CombinedStatus
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			resp.Body.Close()
-			return nil, fmt.Errorf("failed to decode commit status: %w", err)
-		}
-		resp.Body.Close()
-
-		totalCount = result.TotalCount
-		allStatuses = append(allStatuses, result.Statuses...)
-
-		if len(allStatuses) >= totalCount || len(result.Statuses) < 100 {
-			break
-		}
-	}
-
-	return &CombinedStatus{
-		State:      "",
-		TotalCount: totalCount,
-		Statuses:   allStatuses,
-	}, nil
-}
-
-// FetchCommentDetail fetches the comment or review at the given API URL and
-// returns a CommentDetail with author, body preview, type and review state.
-func (c *Client) FetchCommentDetail(ctx context.Context, commentURL string) (*CommentDetail, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", commentURL, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	c.setHeaders(req)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("fetch comment detail: status %d", resp.StatusCode)
-	}
-
-	var raw struct {
-		User  User   `json:"user"`
-		Body  string `json:"body"`
-		State string `json:"state"` // reviews only: APPROVED, CHANGES_REQUESTED, COMMENTED, etc.
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
-		return nil, fmt.Errorf("decode comment detail: %w", err)
-	}
-
-	return &CommentDetail{
-		Author:      raw.User.Login,
-		Body:        truncateBody(raw.Body, 80),
-		Type:        classifyCommentURL(commentURL),
-		ReviewState: strings.ToUpper(raw.State),
-	}, nil
-}
-
-// classifyCommentURL determines the comment type from the API URL pattern.
-func classifyCommentURL(url string) string {
-	switch {
-	case strings.Contains(url, "/pulls/comments/"):
-		return "review_comment"
-	case strings.Contains(url, "/reviews/"):
-		return "review"
-	default:
-		return "comment"
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode user: %w", err)
 	}
-}
+	user.Scopes = parseScopes(resp.Header.Values("X-OAuth-Scopes"))
 
-// truncateBody collapses whitespace and truncates to maxLen characters.
-func truncateBody(s string, maxLen int) string {
-	// Collapse newlines and runs of whitespace into single spaces
-	var b strings.Builder
-	prevSpace := false
-	for _, r := range s {
-		if unicode.IsSpace(r) {
-			if !prevSpace {
-				b.WriteByte(' ')
-				prevSpace = true
-			}
-			continue
-		}
-		b.WriteRune(r)
-		prevSpace = false
-	}
-	result := strings.TrimSpace(b.String())
-	if len(result) > maxLen {
-		return result[:maxLen] + "..."
-	}
-	return result
+	return &user, nil
 }
@@ -0,0 +1,118 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CodeScanningAlert is a code scanning (e.g. CodeQL) alert.
+type CodeScanningAlert struct {
+	Number   int
+	RuleID   string
+	Message  string
+	Severity string // critical, high, medium, low, error, warning, note
+	Tool     string
+	Path     string
+	Line     int
+	HTMLURL  string
+}
+
+// SeverityRank orders severities from most (0) to least severe.
+func (a CodeScanningAlert) SeverityRank() int {
+	switch a.Severity {
+	case "critical":
+		return 0
+	case "high", "error":
+		return 1
+	case "medium", "warning":
+		return 2
+	case "low":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// codeScanningAlertResponse is the subset of the code scanning alert API
+// response used here.
+type codeScanningAlertResponse struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Rule    struct {
+		ID                    string `json:"id"`
+		Severity              string `json:"severity"`
+		SecuritySeverityLevel string `json:"security_severity_level"`
+		Description           string `json:"description"`
+	} `json:"rule"`
+	Tool struct {
+		Name string `json:"name"`
+	} `json:"tool"`
+	MostRecentInstance struct {
+		Message struct {
+			Text string `json:"text"`
+		} `json:"message"`
+		Location struct {
+			Path      string `json:"path"`
+			StartLine int    `json:"start_line"`
+		} `json:"location"`
+	} `json:"most_recent_instance"`
+}
+
+// ListCodeScanningAlerts fetches the open code scanning alerts for a git ref.
+func (c *ReposService) ListCodeScanningAlerts(ctx context.Context, owner, repo, ref string) ([]CodeScanningAlert, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/code-scanning/alerts?state=open&per_page=100&ref=%s", c.baseURL, owner, repo, url.QueryEscape(ref))
+
+	var raw []codeScanningAlertResponse
+	if err := c.getJSON(ctx, u, &raw); err != nil {
+		return nil, fmt.Errorf("list code scanning alerts: %w", err)
+	}
+
+	alerts := make([]CodeScanningAlert, 0, len(raw))
+	for _, r := range raw {
+		severity := r.Rule.SecuritySeverityLevel
+		if severity == "" {
+			severity = r.Rule.Severity
+		}
+		message := r.MostRecentInstance.Message.Text
+		if message == "" {
+			message = r.Rule.Description
+		}
+		alerts = append(alerts, CodeScanningAlert{
+			Number:   r.Number,
+			RuleID:   r.Rule.ID,
+			Message:  message,
+			Severity: severity,
+			Tool:     r.Tool.Name,
+			Path:     r.MostRecentInstance.Location.Path,
+			Line:     r.MostRecentInstance.Location.StartLine,
+			HTMLURL:  r.HTMLURL,
+		})
+	}
+	return alerts, nil
+}
+
+// ListIntroducedCodeScanningAlerts returns the open alerts on a PR that are
+// not also open on its base branch. Repos without code scanning yield none.
+func (c *ReposService) ListIntroducedCodeScanningAlerts(ctx context.Context, owner, repo string, number int, baseBranch string) ([]CodeScanningAlert, error) {
+	prAlerts, err := c.ListCodeScanningAlerts(ctx, owner, repo, fmt.Sprintf("refs/pull/%d/merge", number))
+	if err != nil || len(prAlerts) == 0 {
+		return nil, err
+	}
+	baseAlerts, err := c.ListCodeScanningAlerts(ctx, owner, repo, "refs/heads/"+baseBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	onBase := make(map[int]bool, len(baseAlerts))
+	for _, a := range baseAlerts {
+		onBase[a.Number] = true
+	}
+	var introduced []CodeScanningAlert
+	for _, a := range prAlerts {
+		if !onBase[a.Number] {
+			introduced = append(introduced, a)
+		}
+	}
+	return introduced, nil
+}
@@ -0,0 +1,44 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Comparison is the result of comparing two commits.
+type Comparison struct {
+	Status   string            `json:"status"` // ahead, behind, diverged, identical
+	AheadBy  int               `json:"ahead_by"`
+	BehindBy int               `json:"behind_by"`
+	Files    []PullRequestFile `json:"files"`
+}
+
+// CompareCommits compares base...head, where either side may be a branch
+// name or commit SHA. Files lists the changes on head since the merge base.
+func (c *ReposService) CompareCommits(ctx context.Context, owner, repo, base, head string) (*Comparison, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/compare/%s...%s", c.baseURL, owner, repo, url.PathEscape(base), url.PathEscape(head))
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("compare %s...%s: status %d", base, head, resp.StatusCode)
+	}
+
+	var cmp Comparison
+	if err := json.NewDecoder(resp.Body).Decode(&cmp); err != nil {
+		return nil, fmt.Errorf("decode compare: %w", err)
+	}
+	return &cmp, nil
+}
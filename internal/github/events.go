@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+	"sync"
+)
+
+// Event is something the poller observed. Each poll publishes the changes it
+// found (NotificationAdded, PRStatusChanged, …) followed by a PollCompleted
+// carrying the full snapshot and any per-source errors, or a single
+// PollFailed. OrgUpdated is published outside poll cycles.
+type Event interface {
+	event()
+}
+
+// PollCompleted ends a poll cycle with the current state, which may be
+// partial (see PollResult.Errors).
+type PollCompleted struct {
+	Result PollResult
+}
+
+// PollFailed is published when GitHub rejected the token, so a poll cycle
+// can fetch nothing until it is replaced.
+type PollFailed struct {
+	Err error
+}
+
+// NotificationAdded is an unread notification that is new or has new
+// activity since the previous poll. Not published on the first poll.
+type NotificationAdded struct {
+	Notification *Notification
+}
+
+// PRStatusChanged is a change in an open PR's CI status. Not published on
+// the first poll.
+type PRStatusChanged struct {
+	PRStatusChange
+}
+
+// PRMerged is one of the user's PRs merged since the previous poll. Not
+// published on the first poll.
+type PRMerged struct {
+	MergedPRInfo
+}
+
+// SecretAlertRaised is a secret scanning alert that is new or was bypassed
+// since the previous poll. Not published on the first poll.
+type SecretAlertRaised struct {
+	SecretScanningAlert
+}
+
+// MainBranchBroken is a watched default branch that turned red since the
+// previous failing-main board refresh.
+type MainBranchBroken struct {
+	BranchHealth
+}
+
+// OrgUpdated is a finished org dashboard refresh. The org dashboard loads
+// on demand rather than each poll, so whoever fetched it publishes it with
+// Poller.PublishOrgUpdate.
+type OrgUpdated struct {
+	Org     string
+	Team    string // empty for the whole org
+	Members []OrgMemberActivity
+	Summary OrgActivitySummary
+}
+
+func (PollCompleted) event()     {}
+func (PollFailed) event()        {}
+func (NotificationAdded) event() {}
+func (PRStatusChanged) event()   {}
+func (PRMerged) event()          {}
+func (SecretAlertRaised) event() {}
+func (MainBranchBroken) event()  {}
+func (OrgUpdated) event()        {}
+
+// busBuffer is how many events a subscriber can fall behind before
+// publishing waits for it.
+const busBuffer = 64
+
+// Bus fans events out to any number of subscribers, each reading its own
+// channel at its own pace.
+type Bus struct {
+	// mu is held for reading while publishing, so Close can't close a
+	// channel mid-send
+	mu     sync.RWMutex
+	subs   []chan Event
+	closed bool
+	// done is closed first thing in Close, releasing publishers waiting on
+	// a full subscriber so Close can take mu
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewBus creates an event bus with no subscribers.
+func NewBus() *Bus {
+	return &Bus{done: make(chan struct{})}
+}
+
+// Subscribe returns a channel receiving every event published from now on.
+// The channel is closed when the bus is closed.
+func (b *Bus) Subscribe() <-chan Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch := make(chan Event, busBuffer)
+	if b.closed {
+		close(ch)
+		return ch
+	}
+	b.subs = append(b.subs, ch)
+	return ch
+}
+
+// Publish delivers e to every subscriber, waiting for a subscriber whose
+// buffer is full until ctx is done or the bus is closed. Publishing on a
+// closed bus does nothing.
+func (b *Bus) Publish(ctx context.Context, e Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+	for _, ch := range b.subs {
+		select {
+		case ch <- e:
+		case <-ctx.Done():
+			return
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// Close closes every subscriber's channel. Events published afterwards are
+// dropped.
+func (b *Bus) Close() {
+	b.closeOnce.Do(func() { close(b.done) })
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for _, ch := range b.subs {
+		close(ch)
+	}
+	b.subs = nil
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// graphql executes a GraphQL query or mutation and decodes the "data" field
+// of the response into out (which may be nil).
+func (c *core) graphql(ctx context.Context, query string, variables map[string]any, out any) error {
+	payload, err := json.Marshal(map[string]any{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Mutations change state, so they get the write retry policy
+	if strings.HasPrefix(strings.TrimSpace(query), "mutation") {
+		ctx = withCallClass(ctx, CallWrite)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("graphql: status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode graphql response: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		msgs := make([]string, len(result.Errors))
+		for i, e := range result.Errors {
+			msgs[i] = e.Message
+		}
+		return fmt.Errorf("graphql: %s", strings.Join(msgs, "; "))
+	}
+	if out == nil || len(result.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(result.Data, out); err != nil {
+		return fmt.Errorf("decode graphql data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Issue represents a GitHub issue
+type Issue struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	User      User       `json:"user"`
+	ClosedBy  *User      `json:"closed_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+}
+
+// GetIssue fetches a single issue
+func (c *PullRequestsService) GetIssue(ctx context.Context, owner, repo string, number int) (*Issue, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d", c.baseURL, owner, repo, number)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get issue: status %d", resp.StatusCode)
+	}
+
+	var issue Issue
+	if err := json.NewDecoder(resp.Body).Decode(&issue); err != nil {
+		return nil, fmt.Errorf("decode issue: %w", err)
+	}
+	return &issue, nil
+}
+
+// orgIssueLookupLimit caps the per-issue requests one org issue stats run
+// makes for first responses and for closers each. Issues past the cap, most
+// recent first, count in the totals but are left out of the response and
+// closer figures unless an earlier run cached them.
+const orgIssueLookupLimit = 100
+
+// orgIssueAuthorsPerQuery is how many author qualifiers one backlog search
+// ORs together, keeping the query under GitHub's length limit.
+const orgIssueAuthorsPerQuery = 10
+
+// issueResponse is the cached first response to an issue.
+type issueResponse struct {
+	answered bool
+	after    time.Duration
+}
+
+// SearchOrgIssueStats gathers issue triage metrics for the org since the given
+// date: who opened and closed issues, how quickly new issues got a first
+// response from someone other than the author, and the open backlog.
+// A non-nil members limits the stats to issues those members opened, as for
+// a team; nil counts the whole org. Per-issue lookups are best-effort, capped
+// by orgIssueLookupLimit and cached until the issue is updated.
+func (c *OrgsService) SearchOrgIssueStats(ctx context.Context, org string, members []OrgMember, since time.Time, progress func(current, total int)) (OrgIssueStats, error) {
+	sinceStr := since.Format("2006-01-02")
+	stats := OrgIssueStats{
+		OpenedBy: make(map[string]int),
+		ClosedBy: make(map[string]int),
+	}
+
+	memberSet := make(map[string]bool, len(members))
+	for _, m := range members {
+		memberSet[strings.ToLower(m.Login)] = true
+	}
+	inScope := func(item SearchItem) bool {
+		return members == nil || memberSet[strings.ToLower(item.User.Login)]
+	}
+
+	opened, err := c.searchAllPages(ctx, fmt.Sprintf("org:%s+type:issue+created:>=%s", org, sinceStr))
+	if err != nil {
+		return stats, fmt.Errorf("search opened issues: %w", err)
+	}
+	closed, err := c.searchAllPages(ctx, fmt.Sprintf("org:%s+type:issue+closed:>=%s", org, sinceStr))
+	if err != nil {
+		return stats, fmt.Errorf("search closed issues: %w", err)
+	}
+	opened = slices.DeleteFunc(opened, func(item SearchItem) bool { return !inScope(item) })
+	closed = slices.DeleteFunc(closed, func(item SearchItem) bool { return !inScope(item) })
+	stats.Opened = len(opened)
+	stats.Closed = len(closed)
+	if backlog, err := c.searchIssueBacklog(ctx, org, members); err == nil {
+		stats.Backlog = backlog
+	}
+
+	for _, item := range opened {
+		stats.OpenedBy[strings.ToLower(item.User.Login)]++
+	}
+
+	// Look up the most recent issues first, so the cap drops the oldest
+	slices.SortFunc(opened, func(a, b SearchItem) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	slices.SortFunc(closed, func(a, b SearchItem) int { return b.UpdatedAt.Compare(a.UpdatedAt) })
+
+	var (
+		mu        sync.Mutex
+		responses []time.Duration
+		jobs      []func()
+	)
+	recordResponse := func(r issueResponse) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.answered {
+			responses = append(responses, r.after)
+		} else {
+			stats.Unanswered++
+		}
+	}
+
+	// First response: the earliest comment by someone other than the author
+	lookups := 0
+	for _, item := range opened {
+		if item.Comments == 0 {
+			stats.Unanswered++
+			continue
+		}
+		owner, repo := parseRepoURL(item.RepositoryURL)
+		key := PRKey(owner, repo, item.Number)
+		if r, ok := c.issueResponses.lookup(key, item.UpdatedAt); ok {
+			recordResponse(r)
+			continue
+		}
+		if lookups >= orgIssueLookupLimit {
+			continue
+		}
+		lookups++
+		jobs = append(jobs, func() {
+			comments, err := c.client.PullRequests.GetIssueComments(ctx, owner, repo, item.Number, item.CreatedAt)
+			if err != nil {
+				return
+			}
+			var r issueResponse
+			for _, comment := range comments {
+				if strings.EqualFold(comment.User.Login, item.User.Login) || c.isBot(comment.User.Login) {
+					continue
+				}
+				r = issueResponse{answered: true, after: comment.CreatedAt.Sub(item.CreatedAt)}
+				break
+			}
+			c.issueResponses.store(key, item.UpdatedAt, r)
+			recordResponse(r)
+		})
+	}
+
+	// Closers are only available from the issue itself
+	recordCloser := func(login string) {
+		if login == "" || (members != nil && !memberSet[strings.ToLower(login)]) {
+			return
+		}
+		mu.Lock()
+		stats.ClosedBy[strings.ToLower(login)]++
+		mu.Unlock()
+	}
+	lookups = 0
+	for _, item := range closed {
+		owner, repo := parseRepoURL(item.RepositoryURL)
+		key := PRKey(owner, repo, item.Number)
+		if login, ok := c.issueClosers.lookup(key, item.UpdatedAt); ok {
+			recordCloser(login)
+			continue
+		}
+		if lookups >= orgIssueLookupLimit {
+			continue
+		}
+		lookups++
+		jobs = append(jobs, func() {
+			issue, err := c.client.PullRequests.GetIssue(ctx, owner, repo, item.Number)
+			if err != nil {
+				return
+			}
+			var login string
+			if issue.ClosedBy != nil {
+				login = issue.ClosedBy.Login
+			}
+			c.issueClosers.store(key, item.UpdatedAt, login)
+			recordCloser(login)
+		})
+	}
+
+	var (
+		wg        sync.WaitGroup
+		doneCount int32
+	)
+	sem := make(chan struct{}, 10)
+	for _, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if progress == nil {
+					return
+				}
+				current := int(atomic.AddInt32(&doneCount, 1))
+				if shouldReportOrgProgress(current, len(jobs)) {
+					progress(current, len(jobs))
+				}
+			}()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			job()
+		}()
+	}
+	wg.Wait()
+
+	if len(responses) > 0 {
+		slices.Sort(responses)
+		stats.MedianFirstResponse = responses[len(responses)/2]
+	}
+	return stats, nil
+}
+
+// searchIssueBacklog counts the org's open issues, only those opened by
+// members when members is non-nil. Members are searched in batches of
+// author qualifiers, which GitHub ORs together.
+func (c *core) searchIssueBacklog(ctx context.Context, org string, members []OrgMember) (int, error) {
+	if members == nil {
+		return c.searchTotalCount(ctx, fmt.Sprintf("org:%s+type:issue+state:open", org))
+	}
+	backlog := 0
+	for batch := range slices.Chunk(members, orgIssueAuthorsPerQuery) {
+		q := fmt.Sprintf("org:%s+type:issue+state:open", org)
+		for _, m := range batch {
+			q += "+author:" + m.Login
+		}
+		n, err := c.searchTotalCount(ctx, q)
+		if err != nil {
+			return 0, err
+		}
+		backlog += n
+	}
+	return backlog, nil
+}
+
+// SearchAssignedIssues returns the open issues assigned to the authenticated user.
+func (c *SearchService) SearchAssignedIssues(ctx context.Context) ([]SearchItem, error) {
+	return c.searchAllPages(ctx, "assignee:@me+type:issue+state:open")
+}
@@ -0,0 +1,228 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mainBoardLookback is how many commits are walked back from a red branch
+// head to find the commit that broke it.
+const mainBoardLookback = 15
+
+// BranchHealth is the CI state of a repository's default branch.
+type BranchHealth struct {
+	Owner  string
+	Repo   string
+	Branch string
+	Status PRStatus
+	// HeadSHA is the branch tip the status was computed for.
+	HeadSHA string
+
+	// For red branches: the oldest red commit since the last green one,
+	// when it landed and who wrote it. Walking back stops after
+	// mainBoardLookback commits, so RedSince is a lower bound.
+	BreakingSHA     string
+	BreakingAuthor  string
+	BreakingMessage string
+	RedSince        time.Time
+}
+
+// FullName returns "owner/repo".
+func (b BranchHealth) FullName() string {
+	return b.Owner + "/" + b.Repo
+}
+
+// URL links to the breaking commit, or the branch's commit list.
+func (b BranchHealth) URL() string {
+	if b.BreakingSHA != "" {
+		return fmt.Sprintf("https://github.com/%s/%s/commit/%s", b.Owner, b.Repo, b.BreakingSHA)
+	}
+	return fmt.Sprintf("https://github.com/%s/%s/commits/%s", b.Owner, b.Repo, b.Branch)
+}
+
+// MainBoardOptions selects the branches the failing-main board watches.
+type MainBoardOptions struct {
+	// Org watches the default branch of every unarchived repo in the org.
+	Org string
+	// Repos ("owner/repo") replaces the org's repo list when non-empty.
+	Repos []string
+	// Interval is how often the board is refreshed.
+	Interval time.Duration
+	CI       CIOptions
+}
+
+// Enabled reports whether there is anything to watch.
+func (o MainBoardOptions) Enabled() bool {
+	return (o.Org != "" || len(o.Repos) > 0) && o.Interval > 0
+}
+
+// repoSummary is the subset of the repository API response used to find
+// default branches.
+type repoSummary struct {
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	DefaultBranch string `json:"default_branch"`
+	Archived      bool   `json:"archived"`
+}
+
+// branchCommit is the subset of the commits API response used here.
+type branchCommit struct {
+	SHA    string `json:"sha"`
+	Commit struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name string    `json:"name"`
+			Date time.Time `json:"date"`
+		} `json:"author"`
+	} `json:"commit"`
+	Author *User `json:"author"`
+}
+
+// listOrgRepos returns the unarchived repositories of an org.
+func (c *ReposService) listOrgRepos(ctx context.Context, org string) ([]repoSummary, error) {
+	var repos []repoSummary
+	for page := 1; ; page++ {
+		u := fmt.Sprintf("%s/orgs/%s/repos?type=all&per_page=100&page=%d", c.baseURL, org, page)
+		var batch []repoSummary
+		if err := c.getJSON(ctx, u, &batch); err != nil {
+			return nil, fmt.Errorf("list org repos: %w", err)
+		}
+		for _, r := range batch {
+			if !r.Archived {
+				repos = append(repos, r)
+			}
+		}
+		if len(batch) < 100 {
+			return repos, nil
+		}
+	}
+}
+
+// getRepo fetches a single repository.
+func (c *ReposService) getRepo(ctx context.Context, fullName string) (repoSummary, error) {
+	var r repoSummary
+	if err := c.getJSON(ctx, fmt.Sprintf("%s/repos/%s", c.baseURL, fullName), &r); err != nil {
+		return r, fmt.Errorf("get repo: %w", err)
+	}
+	return r, nil
+}
+
+// listBranchCommits returns the most recent commits on a branch, newest
+// first.
+func (c *ChecksService) listBranchCommits(ctx context.Context, owner, repo, branch string, n int) ([]branchCommit, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/commits?sha=%s&per_page=%d", c.baseURL, owner, repo, branch, n)
+	var commits []branchCommit
+	if err := c.getJSON(ctx, u, &commits); err != nil {
+		return nil, fmt.Errorf("list commits: %w", err)
+	}
+	return commits, nil
+}
+
+// commitCIStatus aggregates the check runs and legacy statuses of a commit.
+func (c *ChecksService) commitCIStatus(ctx context.Context, owner, repo, sha string, ci CIOptions) (PRStatus, error) {
+	checkRuns, err := c.GetCheckRuns(ctx, owner, repo, sha)
+	if err != nil {
+		return PRStatusNone, err
+	}
+	if commitStatus, err := c.GetCommitStatus(ctx, owner, repo, sha); err == nil {
+		for _, s := range commitStatus.Statuses {
+			checkRuns.CheckRuns = append(checkRuns.CheckRuns, statusToCheckRun(s))
+		}
+	}
+	runs := filterIgnoredChecks(checkRuns.CheckRuns, ci.IgnoreChecks)
+	return computeAggregateStatus(&CheckRunsResponse{TotalCount: len(runs), CheckRuns: runs}), nil
+}
+
+// FetchBranchHealth computes a branch's CI state and, when it is red, walks
+// back through its history to find the commit that broke it.
+func (c *ChecksService) FetchBranchHealth(ctx context.Context, owner, repo, branch string, ci CIOptions) (BranchHealth, error) {
+	health := BranchHealth{Owner: owner, Repo: repo, Branch: branch}
+	commits, err := c.listBranchCommits(ctx, owner, repo, branch, mainBoardLookback)
+	if err != nil || len(commits) == 0 {
+		return health, err
+	}
+	health.HeadSHA = commits[0].SHA
+
+	health.Status, err = c.commitCIStatus(ctx, owner, repo, commits[0].SHA, ci)
+	if err != nil || health.Status != PRStatusFailure {
+		return health, err
+	}
+
+	// Walk back until a green commit; pending and unchecked commits neither
+	// break nor fix the branch
+	breaking := commits[0]
+	for _, commit := range commits[1:] {
+		status, err := c.commitCIStatus(ctx, owner, repo, commit.SHA, ci)
+		if err != nil || status == PRStatusSuccess {
+			break
+		}
+		if status == PRStatusFailure {
+			breaking = commit
+		}
+	}
+	health.BreakingSHA = breaking.SHA
+	health.BreakingMessage, _, _ = strings.Cut(breaking.Commit.Message, "\n")
+	health.BreakingAuthor = breaking.Commit.Author.Name
+	if breaking.Author != nil && breaking.Author.Login != "" {
+		health.BreakingAuthor = breaking.Author.Login
+	}
+	health.RedSince = breaking.Commit.Author.Date
+	return health, nil
+}
+
+// FetchFailingMains returns the watched default branches that are currently
+// red, longest red first. Repos that can't be read are skipped.
+func (c *ChecksService) FetchFailingMains(ctx context.Context, opts MainBoardOptions) ([]BranchHealth, error) {
+	var repos []repoSummary
+	if len(opts.Repos) > 0 {
+		for _, fullName := range opts.Repos {
+			r, err := c.client.Repos.getRepo(ctx, fullName)
+			if err != nil {
+				continue
+			}
+			repos = append(repos, r)
+		}
+	} else {
+		var err error
+		if repos, err = c.client.Repos.listOrgRepos(ctx, opts.Org); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		failing []BranchHealth
+		wg      sync.WaitGroup
+	)
+	sem := make(chan struct{}, 5)
+	for _, r := range repos {
+		owner, name, ok := strings.Cut(r.FullName, "/")
+		if !ok || r.DefaultBranch == "" {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			health, err := c.FetchBranchHealth(ctx, owner, name, r.DefaultBranch, opts.CI)
+			if err != nil || health.Status != PRStatusFailure {
+				return
+			}
+			mu.Lock()
+			failing = append(failing, health)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(failing, func(i, j int) bool {
+		return failing[i].RedSince.Before(failing[j].RedSince)
+	})
+	return failing, nil
+}
@@ -0,0 +1,201 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mention is the comment that pinged the user, with the sentence around the
+// @-mention.
+type Mention struct {
+	Author    string
+	Excerpt   string // the sentence containing the mention, whitespace collapsed
+	URL       string // web URL of the comment
+	CreatedAt time.Time
+}
+
+// mentionExcerptLen caps a mention excerpt, in runes, on each side of the
+// mention.
+const mentionExcerptLen = 120
+
+// mentionReasons are the notification reasons enriched with a Mention.
+var mentionReasons = []string{"mention", "team_mention"}
+
+// IsMention reports whether a notification is about the user or one of
+// their teams being @-mentioned.
+func IsMention(n *Notification) bool {
+	return slices.Contains(mentionReasons, n.Reason)
+}
+
+// mentionComment is the part of an issue, pull request or comment a
+// mention is looked for in.
+type mentionComment struct {
+	User      User      `json:"user"`
+	Body      string    `json:"body"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FetchMention finds the newest comment on a notification's issue or pull
+// request that mentions username (or, for team mentions, any team of the
+// repo's owner), falling back to the issue or PR description. Returns nil
+// with no error when no mention is found, e.g. it was edited away.
+func (c *NotificationsService) FetchMention(ctx context.Context, n *Notification, username string) (*Mention, error) {
+	subject := n.Subject.URL
+	if subject == "" || (n.Subject.Type != "Issue" && n.Subject.Type != "PullRequest") {
+		return nil, nil
+	}
+	pattern := mentionPattern(n, username)
+
+	// The latest comment is usually the mention, so try it first
+	if u := n.Subject.LatestCommentURL; u != "" && u != subject {
+		var latest mentionComment
+		if err := c.getJSON(ctx, u, &latest); err == nil {
+			if m := findMention(latest, pattern); m != nil {
+				return m, nil
+			}
+		}
+	}
+
+	var comments []mentionComment
+	issueURL := strings.Replace(subject, "/pulls/", "/issues/", 1)
+	var page []mentionComment
+	if err := c.getJSON(ctx, issueURL+"/comments?per_page=100", &page); err != nil {
+		return nil, fmt.Errorf("list comments: %w", err)
+	}
+	comments = append(comments, page...)
+	if n.Subject.Type == "PullRequest" {
+		page = nil
+		if err := c.getJSON(ctx, subject+"/comments?per_page=100", &page); err == nil {
+			comments = append(comments, page...)
+		}
+	}
+	slices.SortFunc(comments, func(a, b mentionComment) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	for _, comment := range comments {
+		if m := findMention(comment, pattern); m != nil {
+			return m, nil
+		}
+	}
+
+	var description mentionComment
+	if err := c.getJSON(ctx, subject, &description); err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", n.Subject.Type, err)
+	}
+	return findMention(description, pattern), nil
+}
+
+// mentionPattern matches @username, or for team mentions any @owner/team.
+func mentionPattern(n *Notification, username string) *regexp.Regexp {
+	target := regexp.QuoteMeta(username)
+	if n.Reason == "team_mention" {
+		target = regexp.QuoteMeta(n.Repository.Owner.Login) + `/[\w-]+`
+	}
+	return regexp.MustCompile(`(?i)@` + target + `\b`)
+}
+
+// findMention returns the comment as a Mention when it matches pattern.
+func findMention(c mentionComment, pattern *regexp.Regexp) *Mention {
+	loc := pattern.FindStringIndex(c.Body)
+	if loc == nil {
+		return nil
+	}
+	return &Mention{
+		Author:    c.User.Login,
+		Excerpt:   mentionSentence(c.Body, loc[0], loc[1]),
+		URL:       c.HTMLURL,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// mentionSentence returns the sentence around body[start:end]: from the
+// previous sentence end or line break to the next, capped at
+// mentionExcerptLen runes on each side.
+func mentionSentence(body string, start, end int) string {
+	from := strings.LastIndexAny(body[:start], ".!?\n") + 1
+	to := len(body)
+	if i := strings.IndexAny(body[end:], ".!?\n"); i >= 0 {
+		to = end + i + 1
+	}
+
+	before := []rune(strings.TrimSpace(body[from:start]))
+	after := []rune(strings.TrimRight(body[end:to], "\n"))
+	prefix, suffix := "", ""
+	if len(before) > mentionExcerptLen {
+		before, prefix = before[len(before)-mentionExcerptLen:], "…"
+	}
+	if len(after) > mentionExcerptLen {
+		after, suffix = after[:mentionExcerptLen], "…"
+	}
+	sentence := prefix + string(before)
+	if len(before) > 0 {
+		sentence += " "
+	}
+	sentence += body[start:end] + string(after) + suffix
+	return truncateBody(sentence, len(sentence))
+}
+
+// mentionEntry caches a notification's mention until it's updated.
+type mentionEntry struct {
+	updatedAt time.Time
+	mention   *Mention
+}
+
+// enrichMentions fetches the mention behind each mention notification,
+// reusing cached ones for notifications that haven't changed. Returns a map
+// keyed by notification ID.
+func (p *Poller) enrichMentions(ctx context.Context, notifications []*Notification) map[string]*Mention {
+	result := make(map[string]*Mention)
+	active := make(map[string]bool)
+	var toFetch []*Notification
+	for _, n := range notifications {
+		if !IsMention(n) {
+			continue
+		}
+		active[n.ID] = true
+		if e, ok := p.mentions[n.ID]; ok && e.updatedAt.Equal(n.UpdatedAt) {
+			if e.mention != nil {
+				result[n.ID] = e.mention
+			}
+			continue
+		}
+		toFetch = append(toFetch, n)
+	}
+	for id := range p.mentions {
+		if !active[id] {
+			delete(p.mentions, id)
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, 5)
+	for _, n := range toFetch {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			m, err := p.client.Notifications.FetchMention(ctx, n, p.username)
+			if err != nil {
+				// Retried on the next poll
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			p.mentions[n.ID] = mentionEntry{updatedAt: n.UpdatedAt, mention: m}
+			if m != nil {
+				result[n.ID] = m
+			}
+		}()
+	}
+	wg.Wait()
+	return result
+}
@@ -0,0 +1,193 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ListNotifications fetches all notifications for the authenticated user
+// Uses Last-Modified header for efficient polling (returns nil if 304 Not Modified)
+func (c *NotificationsService) ListNotifications(ctx context.Context) ([]*Notification, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/notifications", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setHeaders(req)
+
+	// Add If-Modified-Since header for efficient polling
+	if c.lastModified != "" {
+		req.Header.Set("If-Modified-Since", c.lastModified)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle 304 Not Modified - no new notifications
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, nil
+	}
+
+	// Handle other error status codes
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, ErrUnauthorized
+	}
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("rate limited or forbidden (status %d)", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	// Store Last-Modified header for next request
+	if lm := resp.Header.Get("Last-Modified"); lm != "" {
+		c.lastModified = lm
+	}
+
+	// Decode notifications
+	var notifications []*Notification
+	if err := json.NewDecoder(resp.Body).Decode(&notifications); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// MarkAsRead marks a notification thread as read
+func (c *NotificationsService) MarkAsRead(ctx context.Context, threadID string) error {
+	url := fmt.Sprintf("%s/notifications/threads/%s", c.baseURL, threadID)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, nil)
+	if err != nil {
+		return err
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Expect 205 Reset Content
+	if resp.StatusCode != http.StatusResetContent {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// MarkAsDone marks a notification thread as done, removing it from the inbox
+// the way the web inbox's "Done" does. Unlike MarkAsRead the thread won't
+// show up among read notifications either.
+func (c *NotificationsService) MarkAsDone(ctx context.Context, threadID string) error {
+	url := fmt.Sprintf("%s/notifications/threads/%s", c.baseURL, threadID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Expect 204 No Content
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// FetchCommentDetail fetches the comment or review at the given API URL and
+// returns a CommentDetail with author, body preview, type and review state.
+func (c *NotificationsService) FetchCommentDetail(ctx context.Context, commentURL string) (*CommentDetail, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", commentURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch comment detail: status %d", resp.StatusCode)
+	}
+
+	var raw struct {
+		User  User   `json:"user"`
+		Body  string `json:"body"`
+		State string `json:"state"` // reviews only: APPROVED, CHANGES_REQUESTED, COMMENTED, etc.
+		// PRs and issues, when the URL is the subject itself
+		MergedAt *time.Time `json:"merged_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decode comment detail: %w", err)
+	}
+
+	detail := &CommentDetail{
+		Author:      raw.User.Login,
+		Body:        truncateBody(raw.Body, 240), // up to three wrapped lines
+		Type:        classifyCommentURL(commentURL),
+		ReviewState: strings.ToUpper(raw.State),
+	}
+	if !strings.Contains(commentURL, "/comments/") && !strings.Contains(commentURL, "/reviews/") {
+		detail.SubjectState = raw.State
+		if raw.MergedAt != nil {
+			detail.SubjectState = "merged"
+		}
+	}
+	return detail, nil
+}
+
+// classifyCommentURL determines the comment type from the API URL pattern.
+func classifyCommentURL(url string) string {
+	switch {
+	case strings.Contains(url, "/pulls/comments/"):
+		return "review_comment"
+	case strings.Contains(url, "/reviews/"):
+		return "review"
+	default:
+		return "comment"
+	}
+}
+
+// truncateBody collapses whitespace and truncates to maxLen characters.
+func truncateBody(s string, maxLen int) string {
+	// Collapse newlines and runs of whitespace into single spaces
+	var b strings.Builder
+	prevSpace := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !prevSpace {
+				b.WriteByte(' ')
+				prevSpace = true
+			}
+			continue
+		}
+		b.WriteRune(r)
+		prevSpace = false
+	}
+	result := strings.TrimSpace(b.String())
+	if len(result) > maxLen {
+		return result[:maxLen] + "..."
+	}
+	return result
+}
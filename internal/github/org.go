@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -13,10 +14,10 @@ import (
 )
 
 // ListOrgMembers fetches all members of a GitHub organization.
-func (c *Client) ListOrgMembers(ctx context.Context, org string) ([]OrgMember, error) {
+func (c *OrgsService) ListOrgMembers(ctx context.Context, org string) ([]OrgMember, error) {
 	var all []OrgMember
 	for page := 1; ; page++ {
-		u := fmt.Sprintf("%s/orgs/%s/members?per_page=100&page=%d", baseURL, org, page)
+		u := fmt.Sprintf("%s/orgs/%s/members?per_page=100&page=%d", c.baseURL, org, page)
 		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 		if err != nil {
 			return nil, err
@@ -56,19 +57,121 @@ func (c *Client) ListOrgMembers(ctx context.Context, org string) ([]OrgMember, e
 	return all, nil
 }
 
+// ListUserOrgs returns the logins of the organizations the authenticated
+// user belongs to, sorted case-insensitively. Memberships in orgs that
+// restrict third-party access may be missing.
+func (c *OrgsService) ListUserOrgs(ctx context.Context) ([]string, error) {
+	var logins []string
+	for page := 1; ; page++ {
+		var orgs []struct {
+			Login string `json:"login"`
+		}
+		u := fmt.Sprintf("%s/user/orgs?per_page=100&page=%d", c.baseURL, page)
+		if err := c.getJSON(ctx, u, &orgs); err != nil {
+			return nil, fmt.Errorf("list user orgs: %w", err)
+		}
+		for _, o := range orgs {
+			logins = append(logins, o.Login)
+		}
+		if len(orgs) < 100 {
+			break
+		}
+	}
+	slices.SortFunc(logins, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	return logins, nil
+}
+
+// ListTeamMembers fetches all members of a team within a GitHub organization.
+func (c *OrgsService) ListTeamMembers(ctx context.Context, org, teamSlug string) ([]OrgMember, error) {
+	var all []OrgMember
+	for page := 1; ; page++ {
+		u := fmt.Sprintf("%s/orgs/%s/teams/%s/members?per_page=100&page=%d", c.baseURL, org, teamSlug, page)
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.setHeaders(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusForbidden {
+			resp.Body.Close()
+			return nil, fmt.Errorf("token needs read:org scope to list team members. Update at https://github.com/settings/tokens")
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return nil, fmt.Errorf("team %q not found in org %q or not accessible", teamSlug, org)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("list team members: status %d", resp.StatusCode)
+		}
+
+		var members []OrgMember
+		if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("decode team members: %w", err)
+		}
+		resp.Body.Close()
+
+		all = append(all, members...)
+		if len(members) < 100 {
+			break
+		}
+	}
+	return all, nil
+}
+
 // SearchOrgMergedPRs fetches all merged PRs in an org since the given date.
-func (c *Client) SearchOrgMergedPRs(ctx context.Context, org string, since time.Time) ([]SearchItem, error) {
+func (c *OrgsService) SearchOrgMergedPRs(ctx context.Context, org string, since time.Time) ([]SearchItem, error) {
 	sinceStr := since.Format("2006-01-02")
 	q := fmt.Sprintf("org:%s+type:pr+is:merged+merged:>=%s", org, sinceStr)
 	return c.searchAllPages(ctx, q)
 }
 
+// SearchOrgMergedPRsFunc is like SearchOrgMergedPRs but hands each page of
+// results to onPage as it arrives.
+func (c *OrgsService) SearchOrgMergedPRsFunc(ctx context.Context, org string, since time.Time, onPage func([]SearchItem)) error {
+	sinceStr := since.Format("2006-01-02")
+	q := fmt.Sprintf("org:%s+type:pr+is:merged+merged:>=%s", org, sinceStr)
+	return c.searchPages(ctx, q, onPage)
+}
+
+// SearchOrgUnreviewedMerges returns the PRKeys of the PRs merged in an org
+// since the given date without any review.
+func (c *OrgsService) SearchOrgUnreviewedMerges(ctx context.Context, org string, since time.Time) ([]string, error) {
+	sinceStr := since.Format("2006-01-02")
+	q := fmt.Sprintf("org:%s+type:pr+is:merged+merged:>=%s+review:none", org, sinceStr)
+	items, err := c.searchAllPages(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		owner, repo := parseRepoURL(item.RepositoryURL)
+		keys = append(keys, PRKey(owner, repo, item.Number))
+	}
+	return keys, nil
+}
+
 // SearchOrgOpenPRs fetches all open PRs in an org.
-func (c *Client) SearchOrgOpenPRs(ctx context.Context, org string) ([]SearchItem, error) {
+func (c *OrgsService) SearchOrgOpenPRs(ctx context.Context, org string) ([]SearchItem, error) {
 	q := fmt.Sprintf("org:%s+type:pr+state:open", org)
 	return c.searchAllPages(ctx, q)
 }
 
+// SearchOrgOpenPRsFunc is like SearchOrgOpenPRs but hands each page of
+// results to onPage as it arrives.
+func (c *OrgsService) SearchOrgOpenPRsFunc(ctx context.Context, org string, onPage func([]SearchItem)) error {
+	q := fmt.Sprintf("org:%s+type:pr+state:open", org)
+	return c.searchPages(ctx, q, onPage)
+}
+
 func reportOrgLoading(progressCh chan<- OrgLoadingProgress, step OrgLoadingStep, startedAt time.Time, detail string, current, total int, done bool) {
 	if progressCh == nil {
 		return
@@ -105,54 +208,134 @@ func sumCounts(counts map[string]int) int {
 	return total
 }
 
+func sumInts(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// searchTotalCount returns the total_count of a search without fetching items.
+func (c *core) searchTotalCount(ctx context.Context, query string) (int, error) {
+	u := fmt.Sprintf("%s/search/issues?q=%s&per_page=1", c.baseURL, query)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("search: status %d", resp.StatusCode)
+	}
+
+	var sr struct {
+		TotalCount int `json:"total_count"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&sr); err != nil {
+		return 0, fmt.Errorf("decode search: %w", err)
+	}
+	return sr.TotalCount, nil
+}
+
+// SearchFirstTimeMergers returns the subset of logins (original case) with no
+// merged PRs in the org before since. Logins whose search fails are left out.
+func (c *OrgsService) SearchFirstTimeMergers(ctx context.Context, org string, logins []string, since time.Time) map[string]bool {
+	sinceStr := since.Format("2006-01-02")
+	result := make(map[string]bool)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, 5)
+
+	for _, login := range logins {
+		wg.Add(1)
+		go func(login string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			q := fmt.Sprintf("org:%s+type:pr+is:merged+author:%s+merged:<%s", org, login, sinceStr)
+			count, err := c.searchTotalCount(ctx, q)
+			if err != nil || count > 0 {
+				return
+			}
+			mu.Lock()
+			result[login] = true
+			mu.Unlock()
+		}(login)
+	}
+	wg.Wait()
+	return result
+}
+
 // searchAllPages performs a paginated search, up to 1000 results (GitHub limit).
-func (c *Client) searchAllPages(ctx context.Context, query string) ([]SearchItem, error) {
+func (c *core) searchAllPages(ctx context.Context, query string) ([]SearchItem, error) {
 	var all []SearchItem
+	err := c.searchPages(ctx, query, func(items []SearchItem) {
+		all = append(all, items...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// searchPages performs a paginated search, up to 1000 results (GitHub limit),
+// calling onPage with the items of each page as it arrives.
+func (c *core) searchPages(ctx context.Context, query string, onPage func([]SearchItem)) error {
+	fetched := 0
 	for page := 1; page <= 10; page++ {
-		u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=100&page=%d", baseURL, query, page)
+		u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=100&page=%d", c.baseURL, query, page)
 		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		c.setHeaders(req)
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		if resp.StatusCode == http.StatusUnprocessableEntity {
 			resp.Body.Close()
-			return nil, fmt.Errorf("search validation failed")
+			return fmt.Errorf("search validation failed")
 		}
 		if resp.StatusCode != http.StatusOK {
 			resp.Body.Close()
-			return nil, fmt.Errorf("search: status %d", resp.StatusCode)
+			return fmt.Errorf("search: status %d", resp.StatusCode)
 		}
 
 		var result SearchResult
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			resp.Body.Close()
-			return nil, fmt.Errorf("decode search: %w", err)
+			return fmt.Errorf("decode search: %w", err)
 		}
 		resp.Body.Close()
 
-		all = append(all, result.Items...)
-		if len(all) >= result.TotalCount || len(result.Items) < 100 {
+		fetched += len(result.Items)
+		onPage(result.Items)
+		if fetched >= result.TotalCount || len(result.Items) < 100 {
 			break
 		}
 	}
-	return all, nil
+	return nil
 }
 
 // SearchOrgCommits returns a map of login -> commit count for the org since the given date.
-func (c *Client) SearchOrgCommits(ctx context.Context, org string, since time.Time) (map[string]int, error) {
+func (c *OrgsService) SearchOrgCommits(ctx context.Context, org string, since time.Time) (map[string]int, error) {
 	sinceStr := since.Format("2006-01-02")
 	q := fmt.Sprintf("org:%s+author-date:>=%s", org, sinceStr)
 
 	counts := make(map[string]int)
 	for page := 1; page <= 10; page++ {
-		u := fmt.Sprintf("%s/search/commits?q=%s&sort=author-date&order=desc&per_page=100&page=%d", baseURL, q, page)
+		u := fmt.Sprintf("%s/search/commits?q=%s&sort=author-date&order=desc&per_page=100&page=%d", c.baseURL, q, page)
 		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 		if err != nil {
 			return nil, err
@@ -196,9 +379,74 @@ func (c *Client) SearchOrgCommits(ctx context.Context, org string, since time.Ti
 	return counts, nil
 }
 
+// orgTrendWeeks is the number of trailing weeks covered by member sparklines.
+const orgTrendWeeks = 8
+
+// SearchOrgWeeklyMerged returns a map of login -> merged PR counts for each of
+// the trailing weeks (oldest first). Each week is a rolling 7-day window ending
+// today, fetched with its own dated search so busy orgs aren't truncated by
+// the 1000-result search limit across the whole range.
+func (c *OrgsService) SearchOrgWeeklyMerged(ctx context.Context, org string, weeks int, progress func(current, total int)) (map[string][]int, error) {
+	type weekResult struct {
+		index int
+		items []SearchItem
+		err   error
+	}
+	ch := make(chan weekResult, weeks)
+	sem := make(chan struct{}, 3)
+	var wg sync.WaitGroup
+	var doneCount int32
+
+	today := time.Now()
+	for i := range weeks {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			end := today.AddDate(0, 0, -7*(weeks-1-index))
+			start := end.AddDate(0, 0, -6)
+			q := fmt.Sprintf("org:%s+type:pr+is:merged+merged:%s..%s", org, start.Format("2006-01-02"), end.Format("2006-01-02"))
+			items, err := c.searchAllPages(ctx, q)
+			ch <- weekResult{index: index, items: items, err: err}
+
+			if progress != nil {
+				progress(int(atomic.AddInt32(&doneCount, 1)), weeks)
+			}
+		}(i)
+	}
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	counts := make(map[string][]int)
+	var firstErr error
+	for r := range ch {
+		if r.err != nil {
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			continue
+		}
+		for _, item := range r.items {
+			login := strings.ToLower(item.User.Login)
+			if login == "" {
+				continue
+			}
+			if counts[login] == nil {
+				counts[login] = make([]int, weeks)
+			}
+			counts[login][r.index]++
+		}
+	}
+	return counts, firstErr
+}
+
 // SearchOrgReviewCounts returns a map of login -> review count for the org since the given date.
 // It searches for PRs reviewed by each member concurrently.
-func (c *Client) SearchOrgReviewCounts(ctx context.Context, org string, members []OrgMember, since time.Time, progress func(current, total int)) map[string]int {
+func (c *OrgsService) SearchOrgReviewCounts(ctx context.Context, org string, members []OrgMember, since time.Time, progress func(current, total int)) map[string]int {
 	sinceStr := since.Format("2006-01-02")
 	type result struct {
 		login string
@@ -226,7 +474,7 @@ func (c *Client) SearchOrgReviewCounts(ctx context.Context, org string, members
 			defer func() { <-sem }()
 
 			q := fmt.Sprintf("org:%s+type:pr+reviewed-by:%s+-author:%s+updated:>=%s", org, login, login, sinceStr)
-			u := fmt.Sprintf("%s/search/issues?q=%s&per_page=1", baseURL, q)
+			u := fmt.Sprintf("%s/search/issues?q=%s&per_page=1", c.baseURL, q)
 			req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 			if err != nil {
 				return
@@ -264,50 +512,189 @@ func (c *Client) SearchOrgReviewCounts(ctx context.Context, org string, members
 }
 
 // FetchOrgActivity fetches org-wide activity stats for the overview table.
-func (c *Client) FetchOrgActivity(ctx context.Context, org string) ([]OrgMemberActivity, error) {
-	members, _, err := c.FetchOrgActivityWithProgress(ctx, org, nil)
+// If team is non-empty, activity is limited to members of that team.
+func (c *OrgsService) FetchOrgActivity(ctx context.Context, org, team string) ([]OrgMemberActivity, error) {
+	members, _, err := c.FetchOrgActivityWithProgress(ctx, org, team, nil)
 	return members, err
 }
 
 // FetchOrgActivityWithProgress fetches org-wide activity stats and reports loading progress.
-func (c *Client) FetchOrgActivityWithProgress(ctx context.Context, org string, progressCh chan<- OrgLoadingProgress) ([]OrgMemberActivity, OrgActivitySummary, error) {
+// If team is non-empty, members are taken from the team and PRs by anyone
+// outside the team are ignored. Partial results are streamed on progressCh
+// (as updates with Members set) as search pages and counts arrive.
+func (c *OrgsService) FetchOrgActivityWithProgress(ctx context.Context, org, team string, progressCh chan<- OrgLoadingProgress) ([]OrgMemberActivity, OrgActivitySummary, error) {
 	overallStart := time.Now()
 	since := time.Now().AddDate(0, 0, -7)
 	summary := OrgActivitySummary{}
 
 	membersStartedAt := time.Now()
-	reportOrgLoading(progressCh, OrgStepMembers, membersStartedAt, "Listing organization members", 0, 0, false)
-	members, err := c.ListOrgMembers(ctx, org)
+	var (
+		members []OrgMember
+		err     error
+	)
+	if team != "" {
+		reportOrgLoading(progressCh, OrgStepMembers, membersStartedAt, fmt.Sprintf("Listing members of team %s", team), 0, 0, false)
+		members, err = c.ListTeamMembers(ctx, org, team)
+	} else {
+		reportOrgLoading(progressCh, OrgStepMembers, membersStartedAt, "Listing organization members", 0, 0, false)
+		members, err = c.ListOrgMembers(ctx, org)
+	}
 	if err != nil {
 		return nil, summary, fmt.Errorf("list members: %w", err)
 	}
 	summary.Members = len(members)
+
+	// inScope reports whether a login should be counted. Without a team
+	// every author in the org is included, matching the unscoped behavior.
+	memberSet := make(map[string]bool, len(members))
+	for _, m := range members {
+		memberSet[strings.ToLower(m.Login)] = true
+	}
+	inScope := func(login string) bool {
+		return team == "" || memberSet[strings.ToLower(login)]
+	}
 	reportOrgLoading(progressCh, OrgStepMembers, membersStartedAt, fmt.Sprintf("%d members found", len(members)), len(members), len(members), true)
 
+	// Activity is keyed by lowercased login and filled in as results arrive;
+	// the count maps are merged in by rank.
+	activity := make(map[string]*OrgMemberActivity)
+	commitCounts := make(map[string]int)
+	reviewCounts := make(map[string]int)
+	weeklyMerged := make(map[string][]int)
+	reviewTimes := make(map[string][]time.Time)
+	var issueStats OrgIssueStats
+
+	memberActivity := func(login string) *OrgMemberActivity {
+		lower := strings.ToLower(login)
+		a, ok := activity[lower]
+		if !ok {
+			a = &OrgMemberActivity{Login: login}
+			activity[lower] = a
+		}
+		return a
+	}
+
+	// rank merges the counts gathered so far into a sorted snapshot that
+	// shares no slices with activity.
+	rank := func() []OrgMemberActivity {
+		var result []OrgMemberActivity
+		seen := make(map[string]bool, len(activity))
+		add := func(lower string, a OrgMemberActivity) {
+			seen[lower] = true
+			a.Commits = commitCounts[lower]
+			a.Reviews = reviewCounts[lower]
+			a.WeeklyMerged = weeklyMerged[lower]
+			a.ReviewTimes = slices.Clone(reviewTimes[lower])
+			a.IssuesOpened = issueStats.OpenedBy[lower]
+			a.IssuesClosed = issueStats.ClosedBy[lower]
+			a.Bot = c.isBot(a.Login)
+			if a.Active() {
+				a.MergedPRs = slices.Clone(a.MergedPRs)
+				a.OpenPRs = slices.Clone(a.OpenPRs)
+				result = append(result, a)
+			}
+		}
+		for lower, a := range activity {
+			add(lower, *a)
+		}
+		// Members with only reviews or issue activity also appear
+		for _, m := range members {
+			lower := strings.ToLower(m.Login)
+			if !seen[lower] && !c.isBot(m.Login) {
+				add(lower, OrgMemberActivity{Login: m.Login})
+			}
+		}
+
+		// Default sort: most commits first
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].Commits > result[j].Commits
+		})
+		return result
+	}
+
+	reportPartial := func() {
+		if progressCh != nil {
+			progressCh <- OrgLoadingProgress{Members: rank(), UpdatedAt: time.Now()}
+		}
+	}
+
+	// Track merged PRs for LOC fetching
+	type prRef struct {
+		owner, repo, login string
+		number             int
+	}
+	var mergedPRRefs []prRef
+
 	mergedStartedAt := time.Now()
 	reportOrgLoading(progressCh, OrgStepMergedPRs, mergedStartedAt, "Searching merged pull requests from the last 7 days", 0, 0, false)
-	mergedItems, err := c.SearchOrgMergedPRs(ctx, org, since)
+	err = c.SearchOrgMergedPRsFunc(ctx, org, since, func(items []SearchItem) {
+		for _, item := range items {
+			summary.MergedPRs++
+			login := item.User.Login
+			if login == "" || !inScope(login) {
+				continue
+			}
+			a := memberActivity(login)
+			owner, repo := parseRepoURL(item.RepositoryURL)
+			mergedAt := time.Time{}
+			if item.ClosedAt != nil {
+				mergedAt = *item.ClosedAt
+			}
+			a.MergedPRs = append(a.MergedPRs, MergedPRInfo{
+				Owner:     owner,
+				Repo:      repo,
+				Number:    item.Number,
+				Title:     item.Title,
+				URL:       item.HTMLURL,
+				Author:    login,
+				CreatedAt: item.CreatedAt,
+				MergedAt:  mergedAt,
+			})
+			mergedPRRefs = append(mergedPRRefs, prRef{owner: owner, repo: repo, login: login, number: item.Number})
+		}
+		reportOrgLoading(progressCh, OrgStepMergedPRs, mergedStartedAt, fmt.Sprintf("%d merged PRs so far", summary.MergedPRs), summary.MergedPRs, 0, false)
+		reportPartial()
+	})
 	if err != nil {
 		return nil, summary, fmt.Errorf("search merged PRs: %w", err)
 	}
-	summary.MergedPRs = len(mergedItems)
-	reportOrgLoading(progressCh, OrgStepMergedPRs, mergedStartedAt, fmt.Sprintf("%d merged PRs found", len(mergedItems)), len(mergedItems), len(mergedItems), true)
+	reportOrgLoading(progressCh, OrgStepMergedPRs, mergedStartedAt, fmt.Sprintf("%d merged PRs found", summary.MergedPRs), summary.MergedPRs, summary.MergedPRs, true)
 
 	openStartedAt := time.Now()
 	reportOrgLoading(progressCh, OrgStepOpenPRs, openStartedAt, "Searching open pull requests", 0, 0, false)
-	openItems, err := c.SearchOrgOpenPRs(ctx, org)
+	err = c.SearchOrgOpenPRsFunc(ctx, org, func(items []SearchItem) {
+		for _, item := range items {
+			summary.OpenPRs++
+			login := item.User.Login
+			if login == "" || !inScope(login) {
+				continue
+			}
+			a := memberActivity(login)
+			owner, repo := parseRepoURL(item.RepositoryURL)
+			a.OpenPRs = append(a.OpenPRs, MergedPRInfo{
+				Owner:     owner,
+				Repo:      repo,
+				Number:    item.Number,
+				Title:     item.Title,
+				URL:       item.HTMLURL,
+				Author:    login,
+				CreatedAt: item.CreatedAt,
+			})
+		}
+		reportOrgLoading(progressCh, OrgStepOpenPRs, openStartedAt, fmt.Sprintf("%d open PRs so far", summary.OpenPRs), summary.OpenPRs, 0, false)
+		reportPartial()
+	})
 	if err != nil {
 		return nil, summary, fmt.Errorf("search open PRs: %w", err)
 	}
-	summary.OpenPRs = len(openItems)
-	reportOrgLoading(progressCh, OrgStepOpenPRs, openStartedAt, fmt.Sprintf("%d open PRs found", len(openItems)), len(openItems), len(openItems), true)
+	reportOrgLoading(progressCh, OrgStepOpenPRs, openStartedAt, fmt.Sprintf("%d open PRs found", summary.OpenPRs), summary.OpenPRs, summary.OpenPRs, true)
 
 	// Fetch commit counts (best-effort, don't fail the whole operation)
 	commitsStartedAt := time.Now()
 	reportOrgLoading(progressCh, OrgStepCommits, commitsStartedAt, "Counting authored commits", 0, 0, false)
-	commitCounts, commitErr := c.SearchOrgCommits(ctx, org, since)
-	if commitCounts == nil {
-		commitCounts = make(map[string]int)
+	counts, commitErr := c.SearchOrgCommits(ctx, org, since)
+	if counts != nil {
+		commitCounts = counts
 	}
 	summary.Commits = sumCounts(commitCounts)
 	commitDetail := fmt.Sprintf("%d commits attributed", summary.Commits)
@@ -315,83 +702,53 @@ func (c *Client) FetchOrgActivityWithProgress(ctx context.Context, org string, p
 		commitDetail = "Commit counts unavailable"
 	}
 	reportOrgLoading(progressCh, OrgStepCommits, commitsStartedAt, commitDetail, summary.Commits, summary.Commits, true)
+	reportPartial()
 
 	// Fetch review counts (best-effort)
 	reviewsStartedAt := time.Now()
 	reportOrgLoading(progressCh, OrgStepReviews, reviewsStartedAt, fmt.Sprintf("Checking review activity across %d engineers", len(members)), 0, len(members), false)
-	reviewCounts := c.SearchOrgReviewCounts(ctx, org, members, since, func(current, total int) {
+	reviewCounts = c.SearchOrgReviewCounts(ctx, org, members, since, func(current, total int) {
 		reportOrgLoading(progressCh, OrgStepReviews, reviewsStartedAt, fmt.Sprintf("Checked %d/%d engineers", current, total), current, total, false)
 	})
 	summary.Reviews = sumCounts(reviewCounts)
 	summary.ReviewedEngineers = len(reviewCounts)
 	reportOrgLoading(progressCh, OrgStepReviews, reviewsStartedAt, fmt.Sprintf("%d reviews across %d engineers", summary.Reviews, summary.ReviewedEngineers), len(members), len(members), true)
-
-	activity := make(map[string]*OrgMemberActivity)
-
-	// Track merged PRs for LOC fetching
-	type prRef struct {
-		owner, repo, login string
-		number             int
-	}
-	var mergedPRRefs []prRef
-
-	for _, item := range mergedItems {
-		login := item.User.Login
-		if login == "" || isBot(login) {
-			continue
-		}
-		a, ok := activity[login]
-		if !ok {
-			a = &OrgMemberActivity{Login: login}
-			activity[login] = a
-		}
-		owner, repo := parseRepoURL(item.RepositoryURL)
-		mergedAt := time.Time{}
-		if item.ClosedAt != nil {
-			mergedAt = *item.ClosedAt
-		}
-		a.MergedPRs = append(a.MergedPRs, MergedPRInfo{
-			Owner:     owner,
-			Repo:      repo,
-			Number:    item.Number,
-			Title:     item.Title,
-			URL:       item.HTMLURL,
-			Author:    login,
-			CreatedAt: item.CreatedAt,
-			MergedAt:  mergedAt,
-		})
-		mergedPRRefs = append(mergedPRRefs, prRef{owner: owner, repo: repo, login: login, number: item.Number})
+	reportPartial()
+
+	// Fetch issue triage metrics (best-effort)
+	issuesStartedAt := time.Now()
+	reportOrgLoading(progressCh, OrgStepIssues, issuesStartedAt, "Searching issues opened and closed in the last 7 days", 0, 0, false)
+	// A team's issue stats only count the issues its members opened
+	var issueMembers []OrgMember
+	if team != "" {
+		issueMembers = append([]OrgMember{}, members...)
 	}
-
-	for _, item := range openItems {
-		login := item.User.Login
-		if login == "" || isBot(login) {
-			continue
-		}
-		a, ok := activity[login]
-		if !ok {
-			a = &OrgMemberActivity{Login: login}
-			activity[login] = a
-		}
-		owner, repo := parseRepoURL(item.RepositoryURL)
-		a.OpenPRs = append(a.OpenPRs, MergedPRInfo{
-			Owner:     owner,
-			Repo:      repo,
-			Number:    item.Number,
-			Title:     item.Title,
-			URL:       item.HTMLURL,
-			Author:    login,
-			CreatedAt: item.CreatedAt,
-		})
+	issueStats, issueErr := c.SearchOrgIssueStats(ctx, org, issueMembers, since, func(current, total int) {
+		reportOrgLoading(progressCh, OrgStepIssues, issuesStartedAt, fmt.Sprintf("Checked %d/%d issues", current, total), current, total, false)
+	})
+	summary.IssuesOpened = issueStats.Opened
+	summary.IssuesClosed = issueStats.Closed
+	summary.IssueBacklog = issueStats.Backlog
+	summary.MedianIssueResponse = issueStats.MedianFirstResponse
+	summary.UnansweredIssues = issueStats.Unanswered
+	issueDetail := fmt.Sprintf("%d opened, %d closed", issueStats.Opened, issueStats.Closed)
+	if issueErr != nil {
+		issueDetail = "Issue metrics unavailable"
 	}
+	reportOrgLoading(progressCh, OrgStepIssues, issuesStartedAt, issueDetail, issueStats.Opened+issueStats.Closed, issueStats.Opened+issueStats.Closed, true)
+	reportPartial()
 
 	// Fetch LOC for merged PRs concurrently
 	diffStatsStartedAt := time.Now()
 	reportOrgLoading(progressCh, OrgStepDiffStats, diffStatsStartedAt, fmt.Sprintf("Fetching diff stats for %d merged PRs", len(mergedPRRefs)), 0, len(mergedPRRefs), false)
+	// Reviews of the merged PRs are fetched alongside their diffs, for
+	// when reviewers work
 	type locResult struct {
 		login     string
 		additions int
 		deletions int
+		diffOK    bool
+		reviews   []Review
 	}
 	locCh := make(chan locResult, len(mergedPRRefs))
 	sem := make(chan struct{}, 10) // limit concurrency
@@ -409,10 +766,12 @@ func (c *Client) FetchOrgActivityWithProgress(ctx context.Context, org string, p
 			}()
 			sem <- struct{}{}
 			defer func() { <-sem }()
-			pr, err := c.GetPullRequest(ctx, r.owner, r.repo, r.number)
-			if err == nil {
-				locCh <- locResult{login: r.login, additions: pr.Additions, deletions: pr.Deletions}
+			res := locResult{login: r.login}
+			if pr, err := c.client.PullRequests.GetPullRequest(ctx, r.owner, r.repo, r.number); err == nil {
+				res.additions, res.deletions, res.diffOK = pr.Additions, pr.Deletions, true
 			}
+			res.reviews, _ = c.client.PullRequests.GetPullRequestReviews(ctx, r.owner, r.repo, r.number)
+			locCh <- res
 		}(ref)
 	}
 	go func() {
@@ -421,11 +780,24 @@ func (c *Client) FetchOrgActivityWithProgress(ctx context.Context, org string, p
 	}()
 	fetchedDiffStats := 0
 	for lr := range locCh {
-		if a, ok := activity[lr.login]; ok {
+		for _, r := range lr.reviews {
+			if r.SubmittedAt.Before(since) || r.State == "PENDING" || strings.EqualFold(r.User.Login, lr.login) || !inScope(r.User.Login) {
+				continue
+			}
+			lower := strings.ToLower(r.User.Login)
+			reviewTimes[lower] = append(reviewTimes[lower], r.SubmittedAt)
+		}
+		if !lr.diffOK {
+			continue
+		}
+		if a, ok := activity[strings.ToLower(lr.login)]; ok {
 			a.Additions += lr.additions
 			a.Deletions += lr.deletions
 		}
 		fetchedDiffStats++
+		if fetchedDiffStats%25 == 0 {
+			reportPartial()
+		}
 	}
 	summary.DiffStatsFetched = fetchedDiffStats
 	diffDetail := fmt.Sprintf("Diff stats fetched for %d/%d merged PRs", fetchedDiffStats, len(mergedPRRefs))
@@ -433,53 +805,103 @@ func (c *Client) FetchOrgActivityWithProgress(ctx context.Context, org string, p
 		diffDetail = "No merged PR diffs to inspect"
 	}
 	reportOrgLoading(progressCh, OrgStepDiffStats, diffStatsStartedAt, diffDetail, len(mergedPRRefs), len(mergedPRRefs), true)
+	reportPartial()
 
-	// Assign commit and review counts
-	aggregateStartedAt := time.Now()
-	reportOrgLoading(progressCh, OrgStepAggregate, aggregateStartedAt, "Ranking active engineers", 0, 0, false)
-	for login, a := range activity {
-		lower := strings.ToLower(login)
-		a.Commits = commitCounts[lower]
-		a.Reviews = reviewCounts[lower]
-	}
-
-	// Ensure members with only reviews also appear
-	for login, count := range reviewCounts {
-		if _, ok := activity[login]; !ok && count > 0 {
-			// Find the original-case login
-			for _, m := range members {
-				if strings.ToLower(m.Login) == login {
-					activity[login] = &OrgMemberActivity{Login: m.Login, Reviews: count}
-					break
-				}
-			}
+	// Fetch weekly merge trends (best-effort)
+	trendsStartedAt := time.Now()
+	reportOrgLoading(progressCh, OrgStepTrends, trendsStartedAt, fmt.Sprintf("Searching merges for the last %d weeks", orgTrendWeeks), 0, orgTrendWeeks, false)
+	trends, trendsErr := c.SearchOrgWeeklyMerged(ctx, org, orgTrendWeeks, func(current, total int) {
+		reportOrgLoading(progressCh, OrgStepTrends, trendsStartedAt, fmt.Sprintf("Searched %d/%d weeks", current, total), current, total, false)
+	})
+	if trends != nil {
+		weeklyMerged = trends
+	}
+	trendsDetail := fmt.Sprintf("%d weeks of merge history", orgTrendWeeks)
+	if trendsErr != nil {
+		trendsDetail = "Merge trends incomplete"
+	}
+	reportOrgLoading(progressCh, OrgStepTrends, trendsStartedAt, trendsDetail, orgTrendWeeks, orgTrendWeeks, true)
+	reportPartial()
+
+	// Check for first-time contributors (best-effort). Only authors with no
+	// merges in the earlier trend weeks need a full-history search.
+	firstStartedAt := time.Now()
+	var firstCandidates []string
+	for lower, a := range activity {
+		if len(a.MergedPRs) == 0 || c.isBot(a.Login) {
+			continue
+		}
+		if weeks := weeklyMerged[lower]; trendsErr == nil && sumInts(weeks[:max(len(weeks)-1, 0)]) > 0 {
+			continue
 		}
+		firstCandidates = append(firstCandidates, a.Login)
+	}
+	reportOrgLoading(progressCh, OrgStepFirstPRs, firstStartedAt, fmt.Sprintf("Checking history of %d new authors", len(firstCandidates)), 0, len(firstCandidates), false)
+	firstTimers := c.SearchFirstTimeMergers(ctx, org, firstCandidates, since)
+	for login := range firstTimers {
+		activity[strings.ToLower(login)].FirstContribution = true
+	}
+	reportOrgLoading(progressCh, OrgStepFirstPRs, firstStartedAt, fmt.Sprintf("%d first-time contributors", len(firstTimers)), len(firstCandidates), len(firstCandidates), true)
+
+	// Find merges nobody reviewed (best-effort)
+	coverageStartedAt := time.Now()
+	reportOrgLoading(progressCh, OrgStepCoverage, coverageStartedAt, "Searching PRs merged without review", 0, 0, false)
+	unreviewed, coverageErr := c.SearchOrgUnreviewedMerges(ctx, org, since)
+	coverageDetail := fmt.Sprintf("%d merged without review", len(unreviewed))
+	if coverageErr != nil {
+		coverageDetail = "Review coverage unavailable"
+	} else {
+		summary.UnreviewedMerged = unreviewed
+		summary.CoverageKnown = true
 	}
+	reportOrgLoading(progressCh, OrgStepCoverage, coverageStartedAt, coverageDetail, len(unreviewed), len(unreviewed), true)
 
-	var result []OrgMemberActivity
+	// Assign commit, review, issue, and trend counts
+	aggregateStartedAt := time.Now()
+	reportOrgLoading(progressCh, OrgStepAggregate, aggregateStartedAt, "Ranking active engineers", 0, 0, false)
+	result := rank()
 	totalLOC := 0
-	for _, a := range activity {
-		if len(a.MergedPRs) > 0 || len(a.OpenPRs) > 0 || a.Commits > 0 || a.Reviews > 0 {
-			result = append(result, *a)
-			totalLOC += a.Additions + a.Deletions
-		}
+	for _, a := range result {
+		totalLOC += a.Additions + a.Deletions
 	}
 
-	// Default sort: most commits first
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Commits > result[j].Commits
-	})
-
-	summary.ActiveEngineers = len(result)
 	summary.LOC = totalLOC
+	active := make(map[string]bool, len(result))
+	for _, a := range result {
+		active[strings.ToLower(a.Login)] = true
+		if !a.Bot {
+			summary.ActiveEngineers++
+		}
+	}
+	for _, m := range members {
+		if !active[strings.ToLower(m.Login)] && !c.isBot(m.Login) {
+			summary.InactiveMembers = append(summary.InactiveMembers, m.Login)
+		}
+	}
+	slices.SortFunc(summary.InactiveMembers, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
 	summary.Duration = time.Since(overallStart)
-	reportOrgLoading(progressCh, OrgStepAggregate, aggregateStartedAt, fmt.Sprintf("%d active engineers ranked", len(result)), len(result), len(result), true)
+	reportOrgLoading(progressCh, OrgStepAggregate, aggregateStartedAt, fmt.Sprintf("%d active engineers ranked", summary.ActiveEngineers), len(result), len(result), true)
 
 	return result, summary, nil
 }
 
+// engineerDetailConcurrency bounds the per-PR lookups made while enriching
+// an engineer drill-down.
+const engineerDetailConcurrency = 8
+
 // FetchEngineerDetail fetches detailed activity for a single engineer.
-func (c *Client) FetchEngineerDetail(ctx context.Context, org, login string) (*EngineerDetail, error) {
+func (c *OrgsService) FetchEngineerDetail(ctx context.Context, org, login string) (*EngineerDetail, error) {
+	return c.FetchEngineerDetailWithProgress(ctx, org, login, nil)
+}
+
+// FetchEngineerDetailWithProgress fetches detailed activity for a single
+// engineer. The search results are sent on partialCh as soon as they are in,
+// followed by snapshots as per-PR diff stats, reviews, and comments are filled
+// in concurrently. partialCh may be nil and is not closed; sends give up
+// once ctx is done, so cancel ctx when partialCh is no longer read.
+func (c *OrgsService) FetchEngineerDetailWithProgress(ctx context.Context, org, login string, partialCh chan<- *EngineerDetail) (*EngineerDetail, error) {
 	since := time.Now().AddDate(0, 0, -7)
 	sinceStr := since.Format("2006-01-02")
 
@@ -495,9 +917,6 @@ func (c *Client) FetchEngineerDetail(ctx context.Context, org, login string) (*E
 	}
 
 	repoSet := make(map[string]bool)
-	var totalAdditions, totalDeletions int
-	var totalMergeDuration time.Duration
-	var longestDuration time.Duration
 
 	for _, item := range mergedItems {
 		owner, repo := parseRepoURL(item.RepositoryURL)
@@ -519,24 +938,8 @@ func (c *Client) FetchEngineerDetail(ctx context.Context, org, login string) (*E
 			MergedAt:  mergedAt,
 			CreatedAt: item.CreatedAt,
 		}
-
-		// Fetch PR detail for diff stats
-		pr, err := c.GetPullRequest(ctx, owner, repo, item.Number)
-		if err == nil {
-			d.Additions = pr.Additions
-			d.Deletions = pr.Deletions
-			totalAdditions += pr.Additions
-			totalDeletions += pr.Deletions
-		}
-
 		if !mergedAt.IsZero() && !item.CreatedAt.IsZero() {
 			d.TimeToMerge = mergedAt.Sub(item.CreatedAt)
-			totalMergeDuration += d.TimeToMerge
-			if d.TimeToMerge > longestDuration {
-				longestDuration = d.TimeToMerge
-				longest := d
-				detail.LongestPR = &longest
-			}
 		}
 
 		repoSet[owner+"/"+repo] = true
@@ -548,12 +951,6 @@ func (c *Client) FetchEngineerDetail(ctx context.Context, org, login string) (*E
 		detail.MergedPRs = append(detail.MergedPRs, d)
 	}
 
-	if len(detail.MergedPRs) > 0 {
-		detail.AvgAdditions = totalAdditions / len(detail.MergedPRs)
-		detail.AvgDeletions = totalDeletions / len(detail.MergedPRs)
-		detail.AvgTimeToMerge = totalMergeDuration / time.Duration(len(detail.MergedPRs))
-	}
-
 	// Fetch open PRs
 	q = fmt.Sprintf("org:%s+type:pr+state:open+author:%s", org, login)
 	openItems, _ := c.searchAllPages(ctx, q)
@@ -562,7 +959,7 @@ func (c *Client) FetchEngineerDetail(ctx context.Context, org, login string) (*E
 		if owner == "" {
 			continue
 		}
-		d := DetailedOpenPR{
+		detail.OpenPRs = append(detail.OpenPRs, DetailedOpenPR{
 			Owner:     owner,
 			Repo:      repo,
 			Number:    item.Number,
@@ -570,13 +967,7 @@ func (c *Client) FetchEngineerDetail(ctx context.Context, org, login string) (*E
 			URL:       item.HTMLURL,
 			CreatedAt: item.CreatedAt,
 			Age:       time.Since(item.CreatedAt),
-		}
-		pr, err := c.GetPullRequest(ctx, owner, repo, item.Number)
-		if err == nil {
-			d.Additions = pr.Additions
-			d.Deletions = pr.Deletions
-		}
-		detail.OpenPRs = append(detail.OpenPRs, d)
+		})
 		repoSet[owner+"/"+repo] = true
 	}
 
@@ -596,61 +987,184 @@ func (c *Client) FetchEngineerDetail(ctx context.Context, org, login string) (*E
 			URL:    item.HTMLURL,
 			Author: item.User.Login,
 		})
-
-		// Fetch individual reviews for daily activity tracking
-		reviews, err := c.GetPullRequestReviews(ctx, owner, repo, item.Number)
-		if err == nil {
-			for _, r := range reviews {
-				if strings.EqualFold(r.User.Login, login) && !r.SubmittedAt.Before(since) {
-					detail.DailyReviews[int(r.SubmittedAt.Weekday())]++
-				}
-			}
-		}
 	}
 
 	// Comments given (PRs commented on, not authored)
 	q = fmt.Sprintf("org:%s+type:pr+commenter:%s+-author:%s+updated:>=%s", org, login, login, sinceStr)
 	commentItems, _ := c.searchAllPages(ctx, q)
+
+	// Comments received (other people commenting on user's PRs)
+	q = fmt.Sprintf("org:%s+type:pr+author:%s+comments:>0+updated:>=%s", org, login, sinceStr)
+	receivedItems, _ := c.searchAllPages(ctx, q)
+	detail.CommentsReceived = len(receivedItems)
+
+	for repo := range repoSet {
+		detail.ReposContributed = append(detail.ReposContributed, repo)
+	}
+	sort.Strings(detail.ReposContributed)
+
+	// Each enrichment job fetches outside the lock and returns a function
+	// that applies its result to the detail, or nil on failure.
+	type enrichJob func() func(*EngineerDetail)
+	var jobs []enrichJob
+
+	for i, pr := range detail.MergedPRs {
+		jobs = append(jobs, func() func(*EngineerDetail) {
+			full, err := c.client.PullRequests.GetPullRequest(ctx, pr.Owner, pr.Repo, pr.Number)
+			if err != nil {
+				return nil
+			}
+			return func(d *EngineerDetail) {
+				d.MergedPRs[i].Additions = full.Additions
+				d.MergedPRs[i].Deletions = full.Deletions
+			}
+		})
+	}
+	for i, pr := range detail.OpenPRs {
+		jobs = append(jobs, func() func(*EngineerDetail) {
+			full, err := c.client.PullRequests.GetPullRequest(ctx, pr.Owner, pr.Repo, pr.Number)
+			if err != nil {
+				return nil
+			}
+			return func(d *EngineerDetail) {
+				d.OpenPRs[i].Additions = full.Additions
+				d.OpenPRs[i].Deletions = full.Deletions
+			}
+		})
+	}
+	// Fetch individual reviews for daily activity tracking
+	for _, pr := range detail.ReviewedPRs {
+		jobs = append(jobs, func() func(*EngineerDetail) {
+			reviews, err := c.client.PullRequests.GetPullRequestReviews(ctx, pr.Owner, pr.Repo, pr.Number)
+			if err != nil {
+				return nil
+			}
+			return func(d *EngineerDetail) {
+				for _, r := range reviews {
+					if strings.EqualFold(r.User.Login, login) && !r.SubmittedAt.Before(since) {
+						d.DailyReviews[int(r.SubmittedAt.Weekday())]++
+						d.ReviewTimes = append(d.ReviewTimes, r.SubmittedAt)
+					}
+				}
+			}
+		})
+	}
 	for _, item := range commentItems {
 		owner, repo := parseRepoURL(item.RepositoryURL)
 		if owner == "" {
 			continue
 		}
-		comments, err := c.GetIssueComments(ctx, owner, repo, item.Number, since)
-		if err == nil {
-			for _, comment := range comments {
-				if strings.EqualFold(comment.User.Login, login) {
-					detail.CommentsGiven++
-					detail.DailyComments[int(comment.CreatedAt.Weekday())]++
+		jobs = append(jobs, func() func(*EngineerDetail) {
+			comments, err := c.client.PullRequests.GetIssueComments(ctx, owner, repo, item.Number, since)
+			if err != nil {
+				return nil
+			}
+			return func(d *EngineerDetail) {
+				for _, comment := range comments {
+					if strings.EqualFold(comment.User.Login, login) {
+						d.CommentsGiven++
+						d.DailyComments[int(comment.CreatedAt.Weekday())]++
+					}
 				}
 			}
+		})
+	}
+
+	detail.EnrichTotal = len(jobs)
+	detail.computeAggregates()
+	// report sends a snapshot unless the caller stopped listening
+	report := func(snapshot *EngineerDetail) bool {
+		select {
+		case partialCh <- snapshot:
+			return true
+		case <-ctx.Done():
+			return false
 		}
 	}
+	if partialCh != nil && !report(detail.clone()) {
+		return nil, ctx.Err()
+	}
 
-	// Comments received (other people commenting on user's PRs)
-	q = fmt.Sprintf("org:%s+type:pr+author:%s+comments:>0+updated:>=%s", org, login, sinceStr)
-	receivedItems, _ := c.searchAllPages(ctx, q)
-	detail.CommentsReceived = len(receivedItems)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, engineerDetailConcurrency)
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job enrichJob) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			apply := job()
+			<-sem
 
-	for repo := range repoSet {
-		detail.ReposContributed = append(detail.ReposContributed, repo)
+			var snapshot *EngineerDetail
+			mu.Lock()
+			if apply != nil {
+				apply(detail)
+			}
+			detail.EnrichDone++
+			if partialCh != nil && shouldReportOrgProgress(detail.EnrichDone, detail.EnrichTotal) {
+				detail.computeAggregates()
+				snapshot = detail.clone()
+			}
+			mu.Unlock()
+
+			// Send outside the lock so a slow reader doesn't stall the
+			// other workers
+			if snapshot != nil {
+				report(snapshot)
+			}
+		}(job)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
-	sort.Strings(detail.ReposContributed)
 
+	detail.computeAggregates()
 	return detail, nil
 }
 
-// isBot returns true if the login appears to be a bot account.
-func isBot(login string) bool {
-	lower := strings.ToLower(login)
-	if strings.HasSuffix(lower, "[bot]") || strings.HasSuffix(lower, "-bot") {
-		return true
+// computeAggregates recomputes the averages and longest PR from MergedPRs.
+func (d *EngineerDetail) computeAggregates() {
+	d.AvgAdditions, d.AvgDeletions, d.AvgTimeToMerge, d.LongestPR = 0, 0, 0, nil
+	if len(d.MergedPRs) == 0 {
+		return
 	}
-	bots := []string{"dependabot", "renovate", "greenkeeper", "snyk-bot", "codecov", "coveralls"}
-	for _, b := range bots {
-		if lower == b {
-			return true
+	var totalAdditions, totalDeletions int
+	var totalMergeDuration time.Duration
+	for i, pr := range d.MergedPRs {
+		totalAdditions += pr.Additions
+		totalDeletions += pr.Deletions
+		totalMergeDuration += pr.TimeToMerge
+		if pr.TimeToMerge > 0 && (d.LongestPR == nil || pr.TimeToMerge > d.LongestPR.TimeToMerge) {
+			d.LongestPR = &d.MergedPRs[i]
 		}
 	}
-	return false
+	d.AvgAdditions = totalAdditions / len(d.MergedPRs)
+	d.AvgDeletions = totalDeletions / len(d.MergedPRs)
+	d.AvgTimeToMerge = totalMergeDuration / time.Duration(len(d.MergedPRs))
+	if d.LongestPR != nil {
+		longest := *d.LongestPR
+		d.LongestPR = &longest
+	}
+}
+
+// clone returns a copy of the detail that shares no slices with it, so it
+// can be handed to the UI while enrichment continues.
+func (d *EngineerDetail) clone() *EngineerDetail {
+	cp := *d
+	cp.MergedPRs = slices.Clone(d.MergedPRs)
+	cp.OpenPRs = slices.Clone(d.OpenPRs)
+	cp.ReviewedPRs = slices.Clone(d.ReviewedPRs)
+	cp.ReviewTimes = slices.Clone(d.ReviewTimes)
+	cp.ReposContributed = slices.Clone(d.ReposContributed)
+	if d.LongestPR != nil {
+		longest := *d.LongestPR
+		cp.LongestPR = &longest
+	}
+	return &cp
 }
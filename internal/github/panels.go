@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SearchIssuesRaw runs a free-form issue/PR search and returns the first page
+// of results (up to 100) as generic JSON objects, for custom panels.
+func (c *SearchService) SearchIssuesRaw(ctx context.Context, query string) ([]map[string]any, error) {
+	u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=100", c.baseURL, url.QueryEscape(query))
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		return nil, fmt.Errorf("search validation failed")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("search: status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Items []map[string]any `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode search: %w", err)
+	}
+	return result.Items, nil
+}
+
+// GraphQLRows runs a GraphQL query and returns the array found at itemsPath
+// (a dot path into the response data) as generic JSON objects.
+func (c *SearchService) GraphQLRows(ctx context.Context, query, itemsPath string) ([]map[string]any, error) {
+	var data map[string]any
+	if err := c.graphql(ctx, query, nil, &data); err != nil {
+		return nil, err
+	}
+
+	var node any = data
+	for _, key := range strings.Split(itemsPath, ".") {
+		obj, ok := node.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("items path %q: %q is not an object", itemsPath, key)
+		}
+		node = obj[key]
+	}
+	arr, ok := node.([]any)
+	if !ok {
+		return nil, fmt.Errorf("items path %q is not an array", itemsPath)
+	}
+
+	rows := make([]map[string]any, 0, len(arr))
+	for _, v := range arr {
+		if obj, ok := v.(map[string]any); ok {
+			rows = append(rows, obj)
+		}
+	}
+	return rows, nil
+}
@@ -2,7 +2,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"maps"
 	"sync"
 	"time"
@@ -27,27 +29,90 @@ type LoadingProgress struct {
 	Done    bool
 }
 
-// PollResult contains the result of a polling operation
+// PollSource is one of the independent fetches a poll is made of.
+type PollSource string
+
+const (
+	SourceNotifications PollSource = "notifications"
+	SourcePRs           PollSource = "open PRs"
+	SourceMergedPRs     PollSource = "merged PRs"
+	SourceAssigned      PollSource = "assigned issues"
+	SourceWeeklyStats   PollSource = "weekly stats"
+)
+
+// PollSources lists every PollSource in the order a poll starts them.
+var PollSources = []PollSource{SourceNotifications, SourcePRs, SourceMergedPRs, SourceAssigned, SourceWeeklyStats}
+
+// PollResult contains the result of a polling operation. A source that
+// failed leaves its fields nil and its error in Errors; the rest of the
+// result is still current.
 type PollResult struct {
 	Notifications      []*Notification
 	PRStatuses         map[string]PRStatus
 	PRInfos            map[string]PRInfo
-	PRChanges          []PRStatusChange
 	MergedPRs          []MergedPRInfo
 	WeeklyMergedCounts map[string]int // backfill: ISO week key → count (first poll only)
 	CommentDetails     map[string]*CommentDetail // keyed by notification ID
-	Error              error
+	AssignedIssues     []SearchItem              // nil when the search failed
+	// SecretScanningAlerts are the open secret scanning alerts on repos the
+	// user administers.
+	SecretScanningAlerts []SecretScanningAlert
+	// FailingMains are the watched default branches that are red, nil
+	// until the failing-main board has loaded.
+	FailingMains []BranchHealth
+	// Errors holds the error of each source that failed this poll, nil
+	// when all succeeded.
+	Errors map[PollSource]error
+	// ClosedWatched are watchlist PRs found closed or merged this poll.
+	ClosedWatched []string
+	// Mentions are the comments behind mention notifications, keyed by
+	// notification ID.
+	Mentions map[string]*Mention
+	// SubjectDetails are the releases and discussions behind Release and
+	// Discussion notifications, keyed by notification ID.
+	SubjectDetails map[string]*SubjectDetail
+	// PolledAt is when the poll finished and Interval how long until the
+	// next scheduled one.
+	PolledAt time.Time
+	Interval time.Duration
+	// RateLimit is the REST API rate limit left after the poll.
+	RateLimit RateLimit
 }
 
 // Poller polls GitHub notifications and open PR statuses at a regular interval
 type Poller struct {
 	client         *Client
 	interval       time.Duration
+	current        time.Duration // interval in effect, changed by SetInterval
 	username       string
 	prStatuses     map[string]PRStatus
 	prInfos        map[string]PRInfo
 	progressCh     chan<- LoadingProgress
 	commentDetails map[string]*CommentDetail // cache keyed by LatestCommentURL
+	mentions       map[string]mentionEntry   // cache keyed by notification ID
+	subjects       map[string]subjectEntry   // cache keyed by notification ID
+	intervalCh     chan time.Duration
+	pollNowCh      chan struct{}
+	ciMu           sync.Mutex
+	ci             CIOptions
+	prCaches       *prCaches
+	secretAlerts   map[string]time.Time // alert key → last UpdatedAt seen
+	bus            *Bus
+
+	// PRs by others polled alongside the user's own, as PR keys
+	watchMu sync.Mutex
+	watched []string
+
+	// What the previous poll saw, to publish changes; nil before the first
+	notificationsSeen map[string]time.Time // notification ID → UpdatedAt
+	mergedSeen        map[string]bool      // PR key
+
+	// Failing-main board, refreshed on its own schedule
+	mainBoard       MainBoardOptions
+	mainMu          sync.Mutex
+	failingMains    []BranchHealth
+	newFailingMains []BranchHealth
+	brokenMains     map[string]string // repo → breaking SHA, nil before the first refresh
 }
 
 // NewPoller creates a new poller
@@ -55,28 +120,140 @@ func NewPoller(client *Client, interval time.Duration, username string, progress
 	return &Poller{
 		client:         client,
 		interval:       interval,
+		current:        interval,
 		username:       username,
 		prStatuses:     make(map[string]PRStatus),
 		prInfos:        make(map[string]PRInfo),
 		progressCh:     progressCh,
 		commentDetails: make(map[string]*CommentDetail),
+		mentions:       make(map[string]mentionEntry),
+		subjects:       make(map[string]subjectEntry),
+		intervalCh:     make(chan time.Duration, 1),
+		pollNowCh:      make(chan struct{}, 1),
+		prCaches:       newPRCaches(),
+		secretAlerts:   make(map[string]time.Time),
+		bus:            NewBus(),
 	}
 }
 
-// Start begins polling and sends results on the returned channel
-func (p *Poller) Start(ctx context.Context) <-chan PollResult {
-	resultCh := make(chan PollResult, 1)
+// Subscribe returns a channel receiving the poller's events. Subscribe
+// before Start to see the first poll; the channel is closed when polling
+// stops.
+func (p *Poller) Subscribe() <-chan Event {
+	return p.bus.Subscribe()
+}
+
+// SetCIOptions configures how check runs are aggregated into PR statuses.
+// Safe to call from any goroutine; changes apply from the next poll.
+func (p *Poller) SetCIOptions(ci CIOptions) {
+	p.ciMu.Lock()
+	defer p.ciMu.Unlock()
+	p.ci = ci
+}
+
+func (p *Poller) ciOptions() CIOptions {
+	p.ciMu.Lock()
+	defer p.ciMu.Unlock()
+	return p.ci
+}
+
+// SetMainBoard configures the failing-main board. Must be called before
+// Start; the board is off unless opts.Enabled().
+func (p *Poller) SetMainBoard(opts MainBoardOptions) {
+	p.mainBoard = opts
+}
+
+// runMainBoard refreshes the failing-main board every opts.Interval. It runs
+// separately from the main poll since checking every repo in an org is
+// slow; polls pick up the latest board.
+func (p *Poller) runMainBoard(ctx context.Context) {
+	ticker := time.NewTicker(p.mainBoard.Interval)
+	defer ticker.Stop()
+	for {
+		if failing, err := p.client.Checks.FetchFailingMains(ctx, p.mainBoard); err == nil {
+			p.updateMainBoard(failing)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateMainBoard stores a board refresh and queues branches that broke
+// since the previous one (none on the first refresh).
+func (p *Poller) updateMainBoard(failing []BranchHealth) {
+	p.mainMu.Lock()
+	defer p.mainMu.Unlock()
+	broken := make(map[string]string, len(failing))
+	for _, b := range failing {
+		broken[b.FullName()] = b.BreakingSHA
+		if p.brokenMains == nil {
+			continue
+		}
+		if sha, ok := p.brokenMains[b.FullName()]; !ok || sha != b.BreakingSHA {
+			p.newFailingMains = append(p.newFailingMains, b)
+		}
+	}
+	p.brokenMains = broken
+	p.failingMains = append([]BranchHealth{}, failing...)
+}
+
+// takeMainBoard returns the latest board and drains the newly broken
+// branches.
+func (p *Poller) takeMainBoard() (failing, newlyBroken []BranchHealth) {
+	p.mainMu.Lock()
+	defer p.mainMu.Unlock()
+	failing, newlyBroken = p.failingMains, p.newFailingMains
+	p.newFailingMains = nil
+	return failing, newlyBroken
+}
+
+// SetInterval changes how often the poller runs. A non-positive duration
+// restores the interval the poller was created with. Safe to call from any
+// goroutine.
+func (p *Poller) SetInterval(d time.Duration) {
+	if d <= 0 {
+		d = p.interval
+	}
+	// Replace any pending change that has not been picked up yet
+	select {
+	case <-p.intervalCh:
+	default:
+	}
+	select {
+	case p.intervalCh <- d:
+	default:
+	}
+}
+
+// PollNow asks the poller to poll right away instead of waiting for the
+// next tick, e.g. once a rejected token has been replaced, and restarts the
+// interval from there. Safe to call from any goroutine.
+func (p *Poller) PollNow() {
+	select {
+	case p.pollNowCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start begins polling and publishes each poll's events to subscribers
+func (p *Poller) Start(ctx context.Context) {
+	if p.mainBoard.Enabled() {
+		go p.runMainBoard(ctx)
+	}
 
 	go func() {
-		defer close(resultCh)
+		defer p.bus.Close()
 
-		// Poll immediately on startup (first poll: no PR change notifications)
-		result := p.poll(ctx, true)
+		// Poll immediately on startup (first poll: no change events)
+		events := p.poll(ctx, true)
 		if p.progressCh != nil {
 			close(p.progressCh)
 			p.progressCh = nil
 		}
-		resultCh <- result
+		p.publish(ctx, events)
 
 		ticker := time.NewTicker(p.interval)
 		defer ticker.Stop()
@@ -85,27 +262,65 @@ func (p *Poller) Start(ctx context.Context) <-chan PollResult {
 			select {
 			case <-ctx.Done():
 				return
+			case d := <-p.intervalCh:
+				ticker.Reset(d)
+				p.current = d
+			case <-p.pollNowCh:
+				p.publish(ctx, p.poll(ctx, false))
+				// The next scheduled poll is a full interval away again
+				ticker.Reset(p.current)
 			case <-ticker.C:
-				result := p.poll(ctx, false)
-				resultCh <- result
+				p.publish(ctx, p.poll(ctx, false))
 			}
 		}
 	}()
+}
+
+// Inject publishes events as if a poll had produced them, to exercise
+// subscribers with synthetic data (see SyntheticEvents).
+func (p *Poller) Inject(ctx context.Context, events []Event) {
+	if ctx.Err() != nil {
+		return
+	}
+	p.publish(ctx, events)
+}
+
+// PublishOrgUpdate publishes an org dashboard refresh fetched outside the
+// poll loop to the poller's subscribers.
+func (p *Poller) PublishOrgUpdate(ctx context.Context, u OrgUpdated) {
+	p.bus.Publish(ctx, u)
+}
 
-	return resultCh
+// publish sends a poll's events to the bus in order.
+func (p *Poller) publish(ctx context.Context, events []Event) {
+	for _, e := range events {
+		p.bus.Publish(ctx, e)
+	}
 }
 
-// poll performs a single poll cycle for both notifications and PR statuses.
+// poll performs a single poll cycle for both notifications and PR statuses
+// and returns the changes it found followed by a PollCompleted.
 // All independent API calls run concurrently to minimize startup latency.
-func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
+func (p *Poller) poll(ctx context.Context, firstPoll bool) []Event {
+	start := time.Now()
+	defer func() {
+		slog.Debug("poll finished", "first", firstPoll, "duration", time.Since(start).Round(time.Millisecond))
+	}()
+
 	var (
 		notifications      []*Notification
 		notifErr           error
 		prStatuses         map[string]PRStatus
 		prInfos            map[string]PRInfo
 		prErr              error
+		closedWatched      []string
 		mergedPRs          []MergedPRInfo
+		mergedErr          error
 		weeklyMergedCounts map[string]int
+		weeklyErr          error
+		assignedIssues     []SearchItem
+		assignedErr        error
+		secretAlerts       []SecretScanningAlert
 	)
 
 	var wg sync.WaitGroup
@@ -114,7 +329,7 @@ func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		notifications, notifErr = p.client.ListNotifications(ctx)
+		notifications, notifErr = p.client.Notifications.ListNotifications(ctx)
 		if firstPoll && p.progressCh != nil {
 			p.progressCh <- LoadingProgress{Step: StepNotifications, Done: true}
 		}
@@ -128,7 +343,7 @@ func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
 		if firstPoll {
 			prProgressCh = p.progressCh
 		}
-		prStatuses, prInfos, prErr = pollAllPRs(ctx, p.client, p.username, prProgressCh)
+		prStatuses, prInfos, closedWatched, prErr = pollAllPRs(ctx, p.client, p.username, p.ciOptions(), p.prCaches, p.watchlist(), prProgressCh)
 		if firstPoll && p.progressCh != nil {
 			p.progressCh <- LoadingProgress{Step: StepPullRequests, Done: true}
 		}
@@ -138,21 +353,45 @@ func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if merged, err := p.client.SearchMergedPRsThisWeek(ctx, p.username); err == nil {
-			mergedPRs = merged
+		merged, err := p.client.Search.SearchMergedPRsThisWeek(ctx, p.username)
+		if err == nil {
+			// Non-nil even when empty so a quiet week still sets a baseline
+			mergedPRs = append([]MergedPRInfo{}, merged...)
 		}
+		mergedErr = err
 		if firstPoll && p.progressCh != nil {
 			p.progressCh <- LoadingProgress{Step: StepMergedPRs, Done: true}
 		}
 	}()
 
-	// 4. Weekly stats backfill (first poll only)
+	// 4. Open issues assigned to the user
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		issues, err := p.client.Search.SearchAssignedIssues(ctx)
+		if err == nil {
+			// Non-nil even when empty so the TUI can tell "none" from "failed"
+			assignedIssues = append([]SearchItem{}, issues...)
+		}
+		assignedErr = err
+	}()
+
+	// 5. Secret scanning alerts on administered repos
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		secretAlerts = pollSecretScanningAlerts(ctx, p.client, p.prCaches)
+	}()
+
+	// 6. Weekly stats backfill (first poll only)
 	if firstPoll {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			since := time.Now().AddDate(0, 0, -12*7)
-			if allMerged, err := p.client.SearchMergedPRsSince(ctx, p.username, since); err == nil {
+			allMerged, err := p.client.Search.SearchMergedPRsSince(ctx, p.username, since)
+			weeklyErr = err
+			if err == nil {
 				weeklyMergedCounts = make(map[string]int)
 				for _, pr := range allMerged {
 					if pr.MergedAt.IsZero() {
@@ -170,20 +409,66 @@ func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
 	}
 
 	wg.Wait()
-
-	// If both failed, return the notification error
-	if notifErr != nil && prErr != nil {
-		return PollResult{Error: notifErr}
+	slog.Debug("poll fetched",
+		"notifications", len(notifications), "notifications_err", notifErr,
+		"prs", len(prInfos), "prs_err", prErr,
+		"merged", len(mergedPRs), "assigned", len(assignedIssues), "secret_alerts", len(secretAlerts))
+
+	// A rejected token fails the whole poll so it can be replaced; any
+	// other failure only leaves its source stale
+	if errors.Is(notifErr, ErrUnauthorized) {
+		return []Event{PollFailed{Err: notifErr}}
 	}
 
 	// Enrich notifications with comment details
 	commentDetails := p.enrichNotifications(ctx, notifications)
+	mentions := p.enrichMentions(ctx, notifications)
+	subjectDetails := p.enrichSubjects(ctx, notifications)
 
+	var events []Event
 	var result PollResult
 	result.Notifications = notifications
 	result.MergedPRs = mergedPRs
 	result.WeeklyMergedCounts = weeklyMergedCounts
 	result.CommentDetails = commentDetails
+	result.Mentions = mentions
+	result.SubjectDetails = subjectDetails
+	result.AssignedIssues = assignedIssues
+	result.SecretScanningAlerts = secretAlerts
+	result.ClosedWatched = closedWatched
+	for source, err := range map[PollSource]error{
+		SourceNotifications: notifErr,
+		SourcePRs:           prErr,
+		SourceMergedPRs:     mergedErr,
+		SourceAssigned:      assignedErr,
+		SourceWeeklyStats:   weeklyErr,
+	} {
+		if err == nil {
+			continue
+		}
+		if result.Errors == nil {
+			result.Errors = make(map[PollSource]error)
+		}
+		result.Errors[source] = err
+	}
+	var newlyBroken []BranchHealth
+	result.FailingMains, newlyBroken = p.takeMainBoard()
+	for _, b := range newlyBroken {
+		events = append(events, MainBranchBroken{b})
+	}
+	events = append(events, p.notificationEvents(notifications)...)
+	events = append(events, p.mergedEvents(mergedPRs)...)
+
+	// Detect new or newly bypassed secret alerts (skip on first poll to
+	// establish baseline). Seen alerts are never forgotten so a failed
+	// fetch doesn't re-announce everything on the next poll.
+	for _, a := range secretAlerts {
+		last, ok := p.secretAlerts[a.Key()]
+		if !firstPoll && (!ok || a.UpdatedAt().After(last)) {
+			events = append(events, SecretAlertRaised{a})
+		}
+		p.secretAlerts[a.Key()] = a.UpdatedAt()
+	}
 
 	if prStatuses != nil {
 		// Detect CI status changes (skip on first poll to establish baseline)
@@ -195,7 +480,7 @@ func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
 				}
 				if oldStatus != newStatus {
 					if info, ok := prInfos[key]; ok {
-						result.PRChanges = append(result.PRChanges, PRStatusChange{
+						events = append(events, PRStatusChanged{PRStatusChange{
 							Owner:     info.Owner,
 							Repo:      info.Repo,
 							Number:    info.Number,
@@ -203,7 +488,7 @@ func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
 							URL:       info.URL,
 							OldStatus: oldStatus,
 							NewStatus: newStatus,
-						})
+						}})
 					}
 				}
 			}
@@ -219,7 +504,52 @@ func (p *Poller) poll(ctx context.Context, firstPoll bool) PollResult {
 		maps.Copy(result.PRInfos, prInfos)
 	}
 
-	return result
+	result.PolledAt = time.Now()
+	result.Interval = p.current
+	result.RateLimit = p.client.RateLimit()
+	return append(events, PollCompleted{Result: result})
+}
+
+// notificationEvents returns a NotificationAdded for each unread notification
+// that is new or updated since the previous listing. The first listing only
+// establishes a baseline; nil (unchanged or failed) listings are skipped.
+func (p *Poller) notificationEvents(notifications []*Notification) []Event {
+	if notifications == nil {
+		return nil
+	}
+	var events []Event
+	seen := make(map[string]time.Time, len(notifications))
+	for _, n := range notifications {
+		seen[n.ID] = n.UpdatedAt
+		if p.notificationsSeen == nil || !n.Unread {
+			continue
+		}
+		if last, ok := p.notificationsSeen[n.ID]; ok && !n.UpdatedAt.After(last) {
+			continue
+		}
+		events = append(events, NotificationAdded{n})
+	}
+	p.notificationsSeen = seen
+	return events
+}
+
+// mergedEvents returns a PRMerged for each PR merged since the previous
+// search. The first search only establishes a baseline.
+func (p *Poller) mergedEvents(merged []MergedPRInfo) []Event {
+	if merged == nil {
+		return nil
+	}
+	var events []Event
+	seen := make(map[string]bool, len(merged))
+	for _, pr := range merged {
+		key := PRKey(pr.Owner, pr.Repo, pr.Number)
+		seen[key] = true
+		if p.mergedSeen != nil && !p.mergedSeen[key] {
+			events = append(events, PRMerged{pr})
+		}
+	}
+	p.mergedSeen = seen
+	return events
 }
 
 // enrichNotifications concurrently fetches comment details for notifications
@@ -234,6 +564,7 @@ func (p *Poller) enrichNotifications(ctx context.Context, notifications []*Notif
 	type fetchItem struct {
 		notifID string
 		url     string
+		key     string
 	}
 	var toFetch []fetchItem
 	result := make(map[string]*CommentDetail)
@@ -244,11 +575,17 @@ func (p *Poller) enrichNotifications(ctx context.Context, notifications []*Notif
 		if url == "" {
 			continue
 		}
-		activeURLs[url] = struct{}{}
-		if detail, ok := p.commentDetails[url]; ok {
+		// A URL pointing at the subject itself carries its state, which
+		// changes without the URL changing
+		key := url
+		if url == n.Subject.URL {
+			key = url + "@" + n.UpdatedAt.Format(time.RFC3339)
+		}
+		activeURLs[key] = struct{}{}
+		if detail, ok := p.commentDetails[key]; ok {
 			result[n.ID] = detail
 		} else {
-			toFetch = append(toFetch, fetchItem{notifID: n.ID, url: url})
+			toFetch = append(toFetch, fetchItem{notifID: n.ID, url: url, key: key})
 		}
 	}
 
@@ -266,7 +603,7 @@ func (p *Poller) enrichNotifications(ctx context.Context, notifications []*Notif
 	// Fetch concurrently with a semaphore of 5
 	type fetchResult struct {
 		notifID string
-		url     string
+		key     string
 		detail  *CommentDetail
 	}
 	resultCh := make(chan fetchResult, len(toFetch))
@@ -280,18 +617,18 @@ func (p *Poller) enrichNotifications(ctx context.Context, notifications []*Notif
 			sem <- struct{}{}
 			defer func() { <-sem }()
 
-			detail, err := p.client.FetchCommentDetail(ctx, fi.url)
+			detail, err := p.client.Notifications.FetchCommentDetail(ctx, fi.url)
 			if err != nil {
 				return
 			}
-			resultCh <- fetchResult{notifID: fi.notifID, url: fi.url, detail: detail}
+			resultCh <- fetchResult{notifID: fi.notifID, key: fi.key, detail: detail}
 		}(item)
 	}
 	wg.Wait()
 	close(resultCh)
 
 	for fr := range resultCh {
-		p.commentDetails[fr.url] = fr.detail
+		p.commentDetails[fr.key] = fr.detail
 		result[fr.notifID] = fr.detail
 	}
 
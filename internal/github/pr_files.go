@@ -0,0 +1,88 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// PullRequestFile is one changed file in a pull request.
+type PullRequestFile struct {
+	Filename         string `json:"filename"`
+	Status           string `json:"status"` // added, removed, modified, renamed, copied, changed, unchanged
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	// Patch is the file's unified diff hunks; empty for binary and very
+	// large files
+	Patch string `json:"patch,omitempty"`
+}
+
+// ListPullRequestFiles fetches the files changed in a pull request. GitHub
+// returns at most 3000 files.
+func (c *PullRequestsService) ListPullRequestFiles(ctx context.Context, owner, repo string, number int) ([]PullRequestFile, error) {
+	var all []PullRequestFile
+
+	for page := 1; page <= 30; page++ {
+		u := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/files?per_page=100&page=%d", c.baseURL, owner, repo, number, page)
+
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.setHeaders(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("list pull request files: status %d", resp.StatusCode)
+		}
+
+		var files []PullRequestFile
+		if err := json.NewDecoder(resp.Body).Decode(&files); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("decode pull request files: %w", err)
+		}
+		resp.Body.Close()
+
+		all = append(all, files...)
+		if len(files) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// ListConflictingFiles returns which of a PR's changed files have also
+// changed on its base branch since the PR branched off. For a conflicted PR
+// these are the files that need attention when rebasing.
+func (c *PullRequestsService) ListConflictingFiles(ctx context.Context, owner, repo, headSHA, baseBranch string, prFiles []PullRequestFile) ([]string, error) {
+	// Comparing head...base lists what landed on base after the merge base
+	cmp, err := c.client.Repos.CompareCommits(ctx, owner, repo, headSHA, baseBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	changedOnBase := make(map[string]bool, len(cmp.Files))
+	for _, f := range cmp.Files {
+		changedOnBase[f.Filename] = true
+		if f.PreviousFilename != "" {
+			changedOnBase[f.PreviousFilename] = true
+		}
+	}
+
+	var conflicts []string
+	for _, f := range prFiles {
+		if changedOnBase[f.Filename] || (f.PreviousFilename != "" && changedOnBase[f.PreviousFilename]) {
+			conflicts = append(conflicts, f.Filename)
+		}
+	}
+	return conflicts, nil
+}
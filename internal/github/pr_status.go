@@ -3,20 +3,24 @@ package github
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"path"
 	"strings"
 	"sync"
 	"sync/atomic"
 )
 
 // pollAllPRs fetches all open PRs and their CI statuses concurrently.
-// If progressCh is non-nil, per-PR progress updates are sent on it.
-func pollAllPRs(ctx context.Context, client *Client, username string, progressCh chan<- LoadingProgress) (map[string]PRStatus, map[string]PRInfo, error) {
-	searchResult, err := client.SearchUserOpenPRs(ctx, username)
+// If progressCh is non-nil, per-PR progress updates are sent on it. When
+// ci.RequiredOnly is set, required checks are looked up as well. Details that
+// rarely change are served from caches.
+func pollAllPRs(ctx context.Context, client *Client, username string, ci CIOptions, caches *prCaches, watched []string, progressCh chan<- LoadingProgress) (map[string]PRStatus, map[string]PRInfo, []string, error) {
+	searchResult, err := client.Search.SearchUserOpenPRs(ctx, username)
 	if err != nil {
-		return nil, nil, fmt.Errorf("searching open PRs: %w", err)
+		return nil, nil, nil, fmt.Errorf("searching open PRs: %w", err)
 	}
 
-	total := len(searchResult.Items)
+	total := len(searchResult.Items) + len(watched)
 	statuses := make(map[string]PRStatus)
 	infos := make(map[string]PRInfo)
 
@@ -30,6 +34,7 @@ func pollAllPRs(ctx context.Context, client *Client, username string, progressCh
 	for _, item := range searchResult.Items {
 		owner, repo := parseRepoURL(item.RepositoryURL)
 		if owner == "" || repo == "" {
+			slog.Debug("skipping PR with unparseable repository URL", "url", item.HTMLURL, "repository_url", item.RepositoryURL)
 			continue
 		}
 
@@ -40,62 +45,8 @@ func pollAllPRs(ctx context.Context, client *Client, username string, progressCh
 			defer func() { <-sem }() // release
 
 			key := PRKey(owner, repo, item.Number)
-			info := PRInfo{
-				Owner:     owner,
-				Repo:      repo,
-				Number:    item.Number,
-				Title:     item.Title,
-				URL:       item.HTMLURL,
-				CreatedAt: item.CreatedAt,
-			}
-			status := PRStatusNone
-
-			pr, err := client.GetPullRequest(ctx, owner, repo, item.Number)
-			if err == nil {
-				info.Branch = pr.Head.Ref
-				info.Additions = pr.Additions
-				info.Deletions = pr.Deletions
-
-				// Fetch check runs, commit status, and reviews concurrently
-				var (
-					checkRuns    *CheckRunsResponse
-					commitStatus *CombinedStatus
-					reviews      []Review
-					crErr        error
-					innerWg      sync.WaitGroup
-				)
-
-				innerWg.Add(3)
-				go func() {
-					defer innerWg.Done()
-					checkRuns, crErr = client.GetCheckRuns(ctx, owner, repo, pr.Head.SHA)
-				}()
-				go func() {
-					defer innerWg.Done()
-					commitStatus, _ = client.GetCommitStatus(ctx, owner, repo, pr.Head.SHA)
-				}()
-				go func() {
-					defer innerWg.Done()
-					reviews, _ = client.GetPullRequestReviews(ctx, owner, repo, item.Number)
-				}()
-				innerWg.Wait()
-
-				if crErr == nil {
-					if commitStatus != nil {
-						for _, s := range commitStatus.Statuses {
-							checkRuns.CheckRuns = append(checkRuns.CheckRuns, statusToCheckRun(s))
-							checkRuns.TotalCount++
-						}
-					}
-					status = computeAggregateStatus(checkRuns)
-					info.CheckRuns = checkRuns.CheckRuns
-				}
-
-				if reviews != nil {
-					info.ReviewState = computeReviewState(reviews)
-					info.Reviews = reviews
-				}
-			}
+			// A PR whose details failed is still listed from its search result
+			status, info, _ := fetchPR(ctx, client, username, ci, caches, owner, repo, item)
 
 			done := atomic.AddInt32(&completed, 1)
 			if progressCh != nil {
@@ -109,8 +60,205 @@ func pollAllPRs(ctx context.Context, client *Client, username string, progressCh
 		}(item, owner, repo)
 	}
 
+	// Watched PRs by others; closed ones are reported so they can be dropped
+	// from the watchlist
+	var closed []string
+	for _, key := range watched {
+		owner, repo, number, ok := ParsePRRef(key)
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}        // acquire
+			defer func() { <-sem }() // release
+
+			status, info, err := fetchPR(ctx, client, username, ci, caches, owner, repo, SearchItem{Number: number})
+			info.Watched = true
+			if err != nil && info.Title == "" {
+				info.Title = key
+			}
+
+			done := atomic.AddInt32(&completed, 1)
+			if progressCh != nil {
+				progressCh <- LoadingProgress{Step: StepPullRequests, Current: int(done), Total: total}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if info.State == "closed" || info.State == "merged" {
+				closed = append(closed, key)
+				return
+			}
+			if _, mine := infos[key]; !mine {
+				statuses[key] = status
+				infos[key] = info
+			}
+		}()
+	}
+
 	wg.Wait()
-	return statuses, infos, nil
+	return statuses, infos, closed, nil
+}
+
+// fetchPR fetches one open PR's details, CI status and reviews. item is the
+// PR's search result; only its Number is required, the rest is filled in
+// from the PR itself. The error is the PR's own fetch failing, in which case
+// info only holds what item had.
+func fetchPR(ctx context.Context, client *Client, username string, ci CIOptions, caches *prCaches, owner, repo string, item SearchItem) (PRStatus, PRInfo, error) {
+	key := PRKey(owner, repo, item.Number)
+	info := PRInfo{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    item.Number,
+		Title:     item.Title,
+		URL:       item.HTMLURL,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+	status := PRStatusNone
+
+	pr, err := client.PullRequests.GetPullRequest(ctx, owner, repo, item.Number)
+	if err != nil {
+		slog.Debug("PR details unavailable, showing search result only", "pr", key, "err", err)
+	}
+	if err == nil {
+		if pr.Title != "" {
+			info.Title = pr.Title
+		}
+		if pr.HTMLURL != "" {
+			info.URL = pr.HTMLURL
+		}
+		if info.CreatedAt.IsZero() {
+			info.CreatedAt, info.UpdatedAt = pr.CreatedAt, pr.UpdatedAt
+		}
+		info.Author = pr.User.Login
+		info.State = pr.State
+		if pr.Merged {
+			info.State = "merged"
+		}
+		info.NodeID = pr.NodeID
+		info.AutoMergeMethod = ""
+		if pr.AutoMerge != nil {
+			info.AutoMergeMethod = pr.AutoMerge.MergeMethod
+		}
+		info.Labels = pr.Labels
+		info.Milestone = pr.Milestone
+		info.Assignees = nil
+		for _, u := range pr.Assignees {
+			if u.Login != pr.User.Login {
+				info.Assignees = append(info.Assignees, u.Login)
+			}
+		}
+		info.Branch = pr.Head.Ref
+		info.Additions = pr.Additions
+		info.Deletions = pr.Deletions
+		info.BaseBranch = pr.Base.Ref
+		info.HeadSHA = pr.Head.SHA
+		info.Conflicted = (pr.Mergeable != nil && !*pr.Mergeable) || pr.MergeableState == "dirty"
+		if head := pr.Head.Repo; head != nil && head.FullName != owner+"/"+repo {
+			info.HeadRepo = head.FullName
+		}
+
+		// Fetch check runs, commit status, and reviews concurrently
+		var (
+			checkRuns    *CheckRunsResponse
+			commitStatus *CombinedStatus
+			reviews      []Review
+			crErr        error
+			innerWg      sync.WaitGroup
+		)
+
+		var requiredChecks []string
+		if ci.RequiredOnly {
+			innerWg.Add(1)
+			go func() {
+				defer innerWg.Done()
+				requiredChecks = caches.requiredChecks.get(owner+"/"+repo+":"+pr.Base.Ref, func() []string {
+					// Errors are treated as "no required checks"
+					checks, _ := client.Checks.GetRequiredStatusChecks(ctx, owner, repo, pr.Base.Ref)
+					return checks
+				})
+			}()
+		}
+
+		if info.HeadRepo != "" {
+			innerWg.Add(1)
+			go func() {
+				defer innerWg.Done()
+				forkOwner := pr.Head.Repo.Owner.Login
+				info.ForkBehindBy = caches.behindBy.get(info.HeadRepo+":"+pr.Base.Ref+":"+pr.Base.SHA, func() int {
+					// Errors (e.g. the fork has no such branch) are
+					// treated as "not behind"
+					if cmp, err := client.Repos.CompareCommits(ctx, owner, repo, pr.Base.Ref, forkOwner+":"+pr.Base.Ref); err == nil {
+						return cmp.BehindBy
+					}
+					return 0
+				})
+			}()
+		}
+
+		innerWg.Add(5)
+		go func() {
+			defer innerWg.Done()
+			info.BehindBy = caches.behindBy.get(owner+"/"+repo+":"+pr.Base.Ref+":"+pr.Head.SHA, func() int {
+				// Errors are treated as "not behind"
+				if cmp, err := client.Repos.CompareCommits(ctx, owner, repo, pr.Base.Ref, pr.Head.SHA); err == nil {
+					return cmp.BehindBy
+				}
+				return 0
+			})
+		}()
+		go func() {
+			defer innerWg.Done()
+			info.CodeScanningAlerts = caches.codeScanning.get(key+":"+pr.Head.SHA, func() []CodeScanningAlert {
+				// Repos without code scanning (or access to it) have no alerts
+				alerts, _ := client.Repos.ListIntroducedCodeScanningAlerts(ctx, owner, repo, item.Number, pr.Base.Ref)
+				return alerts
+			})
+		}()
+		go func() {
+			defer innerWg.Done()
+			checkRuns, crErr = client.Checks.GetCheckRuns(ctx, owner, repo, pr.Head.SHA)
+		}()
+		go func() {
+			defer innerWg.Done()
+			commitStatus, _ = client.Checks.GetCommitStatus(ctx, owner, repo, pr.Head.SHA)
+		}()
+		go func() {
+			defer innerWg.Done()
+			reviews, _ = client.PullRequests.GetPullRequestReviews(ctx, owner, repo, item.Number)
+		}()
+		innerWg.Wait()
+
+		if crErr == nil {
+			if commitStatus != nil {
+				for _, s := range commitStatus.Statuses {
+					checkRuns.CheckRuns = append(checkRuns.CheckRuns, statusToCheckRun(s))
+					checkRuns.TotalCount++
+				}
+			}
+			runs := filterIgnoredChecks(checkRuns.CheckRuns, ci.IgnoreChecks)
+			status = computeRequiredStatus(runs, requiredChecks)
+			info.CheckRuns = checkRuns.CheckRuns
+		}
+
+		info.Reviewers = reviewerStates(pr, reviews)
+		if reviews != nil {
+			info.ReviewState = computeReviewState(reviews)
+			info.Reviews = reviews
+			for _, r := range reviews {
+				if strings.EqualFold(r.User.Login, username) || r.SubmittedAt.IsZero() {
+					continue
+				}
+				if info.FirstReviewAt.IsZero() || r.SubmittedAt.Before(info.FirstReviewAt) {
+					info.FirstReviewAt = r.SubmittedAt
+				}
+			}
+		}
+	}
+	return status, info, err
 }
 
 // PRKey builds the map key for a PR: "owner/repo#number"
@@ -133,6 +281,57 @@ func parseRepoURL(repoURL string) (string, string) {
 	return parts[0], parts[1]
 }
 
+// reviewerStates lists a PR's reviewers with their latest review: pending
+// requests first (including re-requests of someone who already reviewed),
+// then everyone else who reviewed, excluding the author.
+func reviewerStates(pr *PullRequest, reviews []Review) []ReviewerState {
+	latest := make(map[string]PRReviewState)
+	var order []string
+	for _, r := range reviews {
+		login := r.User.Login
+		if login == "" || login == pr.User.Login {
+			continue
+		}
+		var state PRReviewState
+		switch r.State {
+		case "APPROVED":
+			state = PRReviewApproved
+		case "CHANGES_REQUESTED":
+			state = PRReviewChangesRequested
+		case "COMMENTED":
+			// A comment doesn't undo an approval or change request
+			if _, ok := latest[login]; ok {
+				continue
+			}
+			state = PRReviewReviewed
+		case "DISMISSED":
+			state = PRReviewReviewed
+		default:
+			continue
+		}
+		if _, ok := latest[login]; !ok {
+			order = append(order, login)
+		}
+		latest[login] = state
+	}
+
+	var states []ReviewerState
+	requested := make(map[string]bool)
+	for _, u := range pr.RequestedReviewers {
+		requested[u.Login] = true
+		states = append(states, ReviewerState{Login: u.Login})
+	}
+	for _, t := range pr.RequestedTeams {
+		states = append(states, ReviewerState{Login: t.Slug, Team: true})
+	}
+	for _, login := range order {
+		if !requested[login] {
+			states = append(states, ReviewerState{Login: login, State: latest[login]})
+		}
+	}
+	return states
+}
+
 // computeReviewState computes the aggregate review state from PR reviews.
 // It takes the latest review per user (by position in the list) and returns
 // the most significant state: changes_requested > approved > reviewed > none.
@@ -200,6 +399,28 @@ func computeAggregateStatus(checkRuns *CheckRunsResponse) PRStatus {
 	return PRStatusSuccess
 }
 
+// filterIgnoredChecks drops check runs whose name matches any of the given
+// path.Match patterns. Invalid patterns never match.
+func filterIgnoredChecks(checkRuns []CheckRun, patterns []string) []CheckRun {
+	if len(patterns) == 0 {
+		return checkRuns
+	}
+	kept := make([]CheckRun, 0, len(checkRuns))
+	for _, cr := range checkRuns {
+		ignored := false
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, cr.Name); ok {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			kept = append(kept, cr)
+		}
+	}
+	return kept
+}
+
 // statusToCheckRun converts a legacy CommitStatus into a CheckRun so the
 // display layer can handle both uniformly.
 func statusToCheckRun(s CommitStatus) CheckRun {
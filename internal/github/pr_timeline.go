@@ -0,0 +1,118 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PRTimelineEvent is one event in a pull request's history.
+type PRTimelineEvent struct {
+	// Kind is the GitHub timeline event name: "committed", "reviewed",
+	// "commented", "head_ref_force_pushed", "deployed", "merged", ...
+	Kind  string
+	Actor string
+	At    time.Time
+	// Detail is the commit headline, review state, comment preview or
+	// requested reviewer, depending on Kind.
+	Detail string
+	URL    string
+}
+
+// prTimelineKinds are the timeline events kept; the rest (subscriptions,
+// mentions, references) are noise in a PR's history.
+var prTimelineKinds = []string{
+	"committed", "reviewed", "commented", "head_ref_force_pushed", "deployed",
+	"review_requested", "ready_for_review", "convert_to_draft",
+	"merged", "closed", "reopened",
+}
+
+// prTimelineMaxPages caps how many pages of timeline are fetched for very
+// long-lived PRs.
+const prTimelineMaxPages = 5
+
+// GetPRTimeline returns a pull request's commits, reviews, comments,
+// force-pushes, deployments and state changes, oldest first.
+func (c *PullRequestsService) GetPRTimeline(ctx context.Context, owner, repo string, number int) ([]PRTimelineEvent, error) {
+	type actor struct {
+		Login string `json:"login"`
+	}
+	var events []PRTimelineEvent
+	for page := 1; page <= prTimelineMaxPages; page++ {
+		var raw []struct {
+			Event     string    `json:"event"`
+			Actor     *actor    `json:"actor"`
+			User      *actor    `json:"user"`
+			CreatedAt time.Time `json:"created_at"`
+			HTMLURL   string    `json:"html_url"`
+			// committed
+			SHA     string `json:"sha"`
+			Message string `json:"message"`
+			Author  *struct {
+				Name string    `json:"name"`
+				Date time.Time `json:"date"`
+			} `json:"author"`
+			// reviewed
+			State       string    `json:"state"`
+			SubmittedAt time.Time `json:"submitted_at"`
+			// commented
+			Body string `json:"body"`
+			// review_requested
+			RequestedReviewer *actor `json:"requested_reviewer"`
+			RequestedTeam     *struct {
+				Slug string `json:"slug"`
+			} `json:"requested_team"`
+		}
+		u := fmt.Sprintf("%s/repos/%s/%s/issues/%d/timeline?per_page=100&page=%d", c.baseURL, owner, repo, number, page)
+		if err := c.getJSON(ctx, u, &raw); err != nil {
+			return nil, fmt.Errorf("get PR timeline: %w", err)
+		}
+
+		for _, r := range raw {
+			if !slices.Contains(prTimelineKinds, r.Event) {
+				continue
+			}
+			e := PRTimelineEvent{Kind: r.Event, At: r.CreatedAt, URL: r.HTMLURL}
+			switch {
+			case r.Actor != nil:
+				e.Actor = r.Actor.Login
+			case r.User != nil:
+				e.Actor = r.User.Login
+			}
+			switch r.Event {
+			case "committed":
+				headline, _, _ := strings.Cut(r.Message, "\n")
+				e.Detail = r.SHA[:min(7, len(r.SHA))] + " " + headline
+				if r.Author != nil {
+					e.Actor, e.At = r.Author.Name, r.Author.Date
+				}
+			case "reviewed":
+				e.Detail = strings.ToLower(strings.ReplaceAll(r.State, "_", " "))
+				e.At = r.SubmittedAt
+				if body := truncateBody(r.Body, 120); body != "" {
+					e.Detail += ": " + body
+				}
+			case "commented":
+				e.Detail = truncateBody(r.Body, 120)
+			case "review_requested":
+				switch {
+				case r.RequestedReviewer != nil:
+					e.Detail = "@" + r.RequestedReviewer.Login
+				case r.RequestedTeam != nil:
+					e.Detail = "@" + owner + "/" + r.RequestedTeam.Slug
+				}
+			}
+			events = append(events, e)
+		}
+		if len(raw) < 100 {
+			break
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b PRTimelineEvent) int {
+		return a.At.Compare(b.At)
+	})
+	return events, nil
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit is the REST API rate limit as of the latest response.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	// Reset is when the window restores the full limit.
+	Reset time.Time
+}
+
+// recordRateLimit keeps the core REST rate limit a response reports. Search
+// and GraphQL have their own, much smaller, limits and are left out.
+func (c *core) recordRateLimit(resp *http.Response) {
+	if resource := resp.Header.Get("X-RateLimit-Resource"); resource != "" && resource != "core" {
+		return
+	}
+	limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	c.rateLimit.Store(&RateLimit{Limit: limit, Remaining: remaining, Reset: time.Unix(reset, 0)})
+}
+
+// RateLimit returns the REST API rate limit as of the latest response, or
+// the zero RateLimit before any. Requests through a client set with
+// WithHTTPClient aren't counted.
+func (c *Client) RateLimit() RateLimit {
+	if rl := c.rateLimit.Load(); rl != nil {
+		return *rl
+	}
+	return RateLimit{}
+}
@@ -0,0 +1,12 @@
+package github
+
+import (
+	"context"
+)
+
+// RefreshPR fetches one PR's details, CI status and reviews immediately,
+// outside the poll cycle, e.g. right after pushing a fix. The next poll
+// still refreshes it as usual.
+func (p *Poller) RefreshPR(ctx context.Context, owner, repo string, number int) (PRStatus, PRInfo, error) {
+	return fetchPR(ctx, p.client, p.username, p.ciOptions(), p.prCaches, owner, repo, SearchItem{Number: number})
+}
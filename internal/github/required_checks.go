@@ -0,0 +1,116 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CIOptions controls how check runs are aggregated into a PR status.
+type CIOptions struct {
+	// RequiredOnly computes PR status from the base branch's required status
+	// checks only, falling back to all checks when none are configured.
+	RequiredOnly bool
+	// IgnoreChecks lists check name patterns (path.Match syntax, e.g.
+	// "codecov/*") that never affect the aggregate status.
+	IgnoreChecks []string
+}
+
+// branchProtection is the subset of GET /repos/{owner}/{repo}/branches/{branch}
+// needed to read required status checks.
+type branchProtection struct {
+	Protected  bool `json:"protected"`
+	Protection struct {
+		RequiredStatusChecks *struct {
+			Contexts []string `json:"contexts"`
+			Checks   []struct {
+				Context string `json:"context"`
+			} `json:"checks"`
+		} `json:"required_status_checks"`
+	} `json:"protection"`
+}
+
+// GetRequiredStatusChecks returns the names of the status checks required by
+// branch protection on the given branch. It returns nil if the branch is not
+// protected or requires no checks.
+func (c *ChecksService) GetRequiredStatusChecks(ctx context.Context, owner, repo, branch string) ([]string, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/branches/%s", c.baseURL, owner, repo, url.PathEscape(branch))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("branch: status %d", resp.StatusCode)
+	}
+
+	var b branchProtection
+	if err := json.NewDecoder(resp.Body).Decode(&b); err != nil {
+		return nil, fmt.Errorf("decode branch: %w", err)
+	}
+
+	rsc := b.Protection.RequiredStatusChecks
+	if !b.Protected || rsc == nil {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	var required []string
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			required = append(required, name)
+		}
+	}
+	for _, name := range rsc.Contexts {
+		add(name)
+	}
+	for _, check := range rsc.Checks {
+		add(check.Context)
+	}
+	return required, nil
+}
+
+// computeRequiredStatus computes the CI status from the required checks only.
+// A required check that has not reported yet counts as pending. With no
+// required checks, e.g. an unprotected branch, every check counts.
+func computeRequiredStatus(checkRuns []CheckRun, required []string) PRStatus {
+	if len(required) == 0 {
+		return computeAggregateStatus(&CheckRunsResponse{TotalCount: len(checkRuns), CheckRuns: checkRuns})
+	}
+
+	isRequired := make(map[string]bool, len(required))
+	for _, name := range required {
+		isRequired[name] = true
+	}
+
+	filtered := &CheckRunsResponse{}
+	reported := make(map[string]bool)
+	for _, cr := range checkRuns {
+		if isRequired[cr.Name] {
+			filtered.CheckRuns = append(filtered.CheckRuns, cr)
+			filtered.TotalCount++
+			reported[cr.Name] = true
+		}
+	}
+
+	status := computeAggregateStatus(filtered)
+	if status == PRStatusFailure || status == PRStatusPending {
+		return status
+	}
+	if len(reported) < len(isRequired) {
+		return PRStatusPending
+	}
+	return status
+}
@@ -0,0 +1,90 @@
+package github
+
+import "testing"
+
+func TestComputeRequiredStatus(t *testing.T) {
+	pass := func(name string) CheckRun { return CheckRun{Name: name, Status: "completed", Conclusion: "success"} }
+	fail := func(name string) CheckRun { return CheckRun{Name: name, Status: "completed", Conclusion: "failure"} }
+	running := func(name string) CheckRun { return CheckRun{Name: name, Status: "in_progress"} }
+
+	tests := []struct {
+		name     string
+		runs     []CheckRun
+		required []string
+		want     PRStatus
+	}{
+		{
+			name:     "required passing",
+			runs:     []CheckRun{pass("build"), pass("test")},
+			required: []string{"build", "test"},
+			want:     PRStatusSuccess,
+		},
+		{
+			name:     "required failing, optional passing",
+			runs:     []CheckRun{fail("build"), pass("lint"), pass("codecov")},
+			required: []string{"build"},
+			want:     PRStatusFailure,
+		},
+		{
+			name:     "optional failing, required passing",
+			runs:     []CheckRun{pass("build"), fail("lint")},
+			required: []string{"build"},
+			want:     PRStatusSuccess,
+		},
+		{
+			name:     "optional pending, required passing",
+			runs:     []CheckRun{pass("build"), running("deploy-preview")},
+			required: []string{"build"},
+			want:     PRStatusSuccess,
+		},
+		{
+			name:     "required pending",
+			runs:     []CheckRun{pass("build"), running("test")},
+			required: []string{"build", "test"},
+			want:     PRStatusPending,
+		},
+		{
+			name:     "required missing",
+			runs:     []CheckRun{pass("build"), pass("lint")},
+			required: []string{"build", "test"},
+			want:     PRStatusPending,
+		},
+		{
+			name:     "required failing beats required missing",
+			runs:     []CheckRun{fail("build")},
+			required: []string{"build", "test"},
+			want:     PRStatusFailure,
+		},
+		{
+			name:     "no required checks reported",
+			runs:     nil,
+			required: []string{"build"},
+			want:     PRStatusPending,
+		},
+		{
+			name:     "no branch protection, all passing",
+			runs:     []CheckRun{pass("build"), pass("lint")},
+			required: nil,
+			want:     PRStatusSuccess,
+		},
+		{
+			name:     "no branch protection, any failing",
+			runs:     []CheckRun{pass("build"), fail("lint")},
+			required: nil,
+			want:     PRStatusFailure,
+		},
+		{
+			name:     "no branch protection, no checks",
+			runs:     nil,
+			required: nil,
+			want:     PRStatusNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computeRequiredStatus(tt.runs, tt.required); got != tt.want {
+				t.Errorf("computeRequiredStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
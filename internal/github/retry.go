@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CallClass groups API calls that share a retry policy.
+type CallClass int
+
+const (
+	// CallRead is a GET against a REST endpoint.
+	CallRead CallClass = iota
+	// CallSearch is a search or GraphQL query, which GitHub limits more
+	// tightly with secondary rate limits.
+	CallSearch
+	// CallWrite is any request that changes state, e.g. marking a
+	// notification read or updating a branch.
+	CallWrite
+)
+
+// RetryPolicy controls how a class of calls is retried after transient
+// failures: network errors, 5xx responses and rate limits.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries; 1 disables retries.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, doubled for each
+	// later one and jittered.
+	BaseDelay time.Duration
+	// MaxDelay caps each wait, including one asked for by Retry-After; a
+	// longer rate limit is returned to the caller instead of waited out.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicies are the policies a Client starts with. Writes are
+// only retried when GitHub rate limited them, since a failed write may
+// still have been applied.
+var DefaultRetryPolicies = map[CallClass]RetryPolicy{
+	CallRead:   {MaxAttempts: 3, BaseDelay: 500 * time.Millisecond, MaxDelay: 10 * time.Second},
+	CallSearch: {MaxAttempts: 4, BaseDelay: 2 * time.Second, MaxDelay: time.Minute},
+	CallWrite:  {MaxAttempts: 2, BaseDelay: time.Second, MaxDelay: 30 * time.Second},
+}
+
+// WithRetryPolicy sets the retry policy of one class of calls.
+func WithRetryPolicy(class CallClass, p RetryPolicy) Option {
+	return func(c *core) {
+		c.retry[class] = p
+	}
+}
+
+// callClassKey carries a CallClass set by the caller on a request's context.
+type callClassKey struct{}
+
+// withCallClass marks requests made with ctx as class, for calls whose
+// method and path don't tell, like GraphQL mutations.
+func withCallClass(ctx context.Context, class CallClass) context.Context {
+	return context.WithValue(ctx, callClassKey{}, class)
+}
+
+// callClass classifies a request for its retry policy.
+func callClass(req *http.Request) CallClass {
+	if class, ok := req.Context().Value(callClassKey{}).(CallClass); ok {
+		return class
+	}
+	switch {
+	case strings.Contains(req.URL.Path, "/search/") || strings.HasSuffix(req.URL.Path, "/graphql"):
+		return CallSearch
+	case req.Method == http.MethodGet || req.Method == http.MethodHead:
+		return CallRead
+	default:
+		return CallWrite
+	}
+}
+
+// retryTransport retries transient failures with exponential backoff and
+// jitter, honoring Retry-After, and bounds each attempt by the client's
+// timeout.
+type retryTransport struct {
+	base http.RoundTripper
+	core *core
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	class := callClass(req)
+	policy := t.core.retry[class]
+	// A body that can't be replayed can only be sent once
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		policy.MaxAttempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.attempt(req)
+		if attempt >= policy.MaxAttempts || req.Context().Err() != nil {
+			return resp, err
+		}
+		wait, retry := retryDelay(resp, err, class)
+		if !retry {
+			return resp, err
+		}
+		if wait == 0 {
+			wait = backoff(policy, attempt)
+		}
+		if wait > policy.MaxDelay {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// attempt sends req once, bounded by the client's timeout. The timeout
+// keeps running while the caller reads the body.
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.core.timeout <= 0 {
+		resp, err := t.base.RoundTrip(req)
+		if err == nil {
+			t.core.recordRateLimit(resp)
+		}
+		return resp, err
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.core.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	t.core.recordRateLimit(resp)
+	resp.Body = cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases an attempt's timeout once its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// retryDelay reports whether a failed attempt should be retried and how long
+// GitHub asked to wait; 0 means use the backoff.
+func retryDelay(resp *http.Response, err error, class CallClass) (time.Duration, bool) {
+	if err != nil {
+		return 0, class != CallWrite
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusForbidden:
+		if s := resp.Header.Get("Retry-After"); s != "" {
+			// Secondary rate limit
+			secs, err := strconv.Atoi(s)
+			return time.Duration(max(secs, 1)) * time.Second, err == nil
+		}
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			// Primary rate limit: wait for the window to reset
+			reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+			if err != nil {
+				return 0, false
+			}
+			return max(time.Until(time.Unix(reset, 0)), time.Second), true
+		}
+		return 0, resp.StatusCode == http.StatusTooManyRequests
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return 0, class != CallWrite
+	}
+	return 0, false
+}
+
+// backoff returns the jittered wait before retry number attempt (from 1):
+// BaseDelay doubled per attempt, capped at MaxDelay, then drawn from its
+// upper half so clients that failed together don't retry together.
+func backoff(p RetryPolicy, attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d/2 + rand.N(d/2+1)
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastPolicy retries quickly so the tests don't wait on real backoff.
+var fastPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		ctx        context.Context
+		responses  []int
+		retryAfter string
+		wantHits   int
+		wantStatus int
+		minElapsed time.Duration
+	}{
+		{
+			name:       "429 with Retry-After",
+			method:     http.MethodGet,
+			path:       "/repos/o/r",
+			responses:  []int{429, 200},
+			retryAfter: "1",
+			wantHits:   2,
+			wantStatus: 200,
+			minElapsed: time.Second,
+		},
+		{
+			name:       "Retry-After beyond MaxDelay",
+			method:     http.MethodGet,
+			path:       "/repos/o/r",
+			responses:  []int{429, 200},
+			retryAfter: "120",
+			wantHits:   1,
+			wantStatus: 429,
+		},
+		{
+			name:       "5xx backoff then success",
+			method:     http.MethodGet,
+			path:       "/repos/o/r",
+			responses:  []int{503, 502, 200},
+			wantHits:   3,
+			wantStatus: 200,
+		},
+		{
+			name:       "5xx gives up after MaxAttempts",
+			method:     http.MethodGet,
+			path:       "/repos/o/r",
+			responses:  []int{500, 500, 500, 500},
+			wantHits:   3,
+			wantStatus: 500,
+		},
+		{
+			name:       "4xx not retried",
+			method:     http.MethodGet,
+			path:       "/repos/o/r",
+			responses:  []int{404, 200},
+			wantHits:   1,
+			wantStatus: 404,
+		},
+		{
+			name:       "write not retried on 5xx",
+			method:     http.MethodPatch,
+			path:       "/notifications/threads/1",
+			responses:  []int{502, 200},
+			wantHits:   1,
+			wantStatus: 502,
+		},
+		{
+			name:       "write retried on rate limit up to its policy",
+			method:     http.MethodPost,
+			path:       "/repos/o/r/pulls/1/update-branch",
+			responses:  []int{429, 429, 429},
+			retryAfter: "1",
+			wantHits:   2,
+			wantStatus: 429,
+		},
+		{
+			name:       "graphql mutation classed as write",
+			method:     http.MethodPost,
+			path:       "/graphql",
+			ctx:        withCallClass(context.Background(), CallWrite),
+			responses:  []int{502, 200},
+			wantHits:   1,
+			wantStatus: 502,
+		},
+		{
+			name:       "graphql query retried as search",
+			method:     http.MethodPost,
+			path:       "/graphql",
+			responses:  []int{502, 200},
+			wantHits:   2,
+			wantStatus: 200,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(hits.Add(1))
+				status := tt.responses[min(n, len(tt.responses))-1]
+				if status == http.StatusTooManyRequests && tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(status)
+			}))
+			defer srv.Close()
+
+			c := NewClient("token",
+				WithBaseURL(srv.URL),
+				WithRetryPolicy(CallRead, fastPolicy),
+				WithRetryPolicy(CallSearch, fastPolicy),
+				WithRetryPolicy(CallWrite, RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second}),
+			)
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, srv.URL+tt.path, strings.NewReader("{}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			start := time.Now()
+			resp, err := c.httpClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := int(hits.Load()); got != tt.wantHits {
+				t.Errorf("server hit %d times, want %d", got, tt.wantHits)
+			}
+			if elapsed := time.Since(start); elapsed < tt.minElapsed {
+				t.Errorf("returned after %v, want at least %v", elapsed, tt.minElapsed)
+			}
+		})
+	}
+}
+
+func TestRetryTransportCancelledDuringWait(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewClient("token", WithBaseURL(srv.URL), WithRetryPolicy(CallRead, fastPolicy))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/repos/o/r", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.httpClient.Do(req); err == nil {
+		t.Fatal("Do succeeded after its context was cancelled")
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server hit %d times, want 1", got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	tests := []struct {
+		attempt int
+		lo, hi  time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{5, 500 * time.Millisecond, time.Second},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		for range 20 {
+			if d := backoff(p, tt.attempt); d < tt.lo || d > tt.hi {
+				t.Fatalf("backoff(attempt %d) = %v, want within [%v, %v]", tt.attempt, d, tt.lo, tt.hi)
+			}
+		}
+	}
+}
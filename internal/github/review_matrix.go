@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ReviewMatrix records who reviewed whose pull requests over a window.
+// Counts[reviewer][author] is the number of PRs by author that reviewer
+// reviewed. Logins are lowercased.
+type ReviewMatrix struct {
+	Reviewers []string
+	Authors   []string
+	Counts    map[string]map[string]int
+	Since     time.Time
+}
+
+// ReviewsGiven returns the total number of PRs reviewed by reviewer.
+func (rm *ReviewMatrix) ReviewsGiven(reviewer string) int {
+	total := 0
+	for _, n := range rm.Counts[reviewer] {
+		total += n
+	}
+	return total
+}
+
+// ReviewsReceived returns the total number of reviews on author's PRs.
+func (rm *ReviewMatrix) ReviewsReceived(author string) int {
+	total := 0
+	for _, row := range rm.Counts {
+		total += row[author]
+	}
+	return total
+}
+
+// FetchReviewMatrix builds a who-reviews-whom matrix for the given members
+// from reviewed-by searches over the last seven days. Only authors in the
+// member list are kept so the matrix stays square-ish.
+func (c *OrgsService) FetchReviewMatrix(ctx context.Context, org string, members []string) (*ReviewMatrix, error) {
+	since := time.Now().AddDate(0, 0, -7)
+	sinceStr := since.Format("2006-01-02")
+
+	inScope := make(map[string]bool, len(members))
+	for _, login := range members {
+		inScope[strings.ToLower(login)] = true
+	}
+
+	rm := &ReviewMatrix{
+		Counts: make(map[string]map[string]int),
+		Since:  since,
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	sem := make(chan struct{}, 5)
+
+	for _, login := range members {
+		wg.Add(1)
+		go func(login string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			q := fmt.Sprintf("org:%s+type:pr+reviewed-by:%s+-author:%s+updated:>=%s", org, login, login, sinceStr)
+			items, err := c.searchAllPages(ctx, q)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("reviews by %s: %w", login, err)
+				}
+				return
+			}
+			reviewer := strings.ToLower(login)
+			for _, item := range items {
+				author := strings.ToLower(item.User.Login)
+				if !inScope[author] {
+					continue
+				}
+				if rm.Counts[reviewer] == nil {
+					rm.Counts[reviewer] = make(map[string]int)
+				}
+				rm.Counts[reviewer][author]++
+			}
+		}(login)
+	}
+	wg.Wait()
+
+	if firstErr != nil && len(rm.Counts) == 0 {
+		return nil, firstErr
+	}
+
+	authors := make(map[string]bool)
+	for reviewer, row := range rm.Counts {
+		rm.Reviewers = append(rm.Reviewers, reviewer)
+		for author := range row {
+			authors[author] = true
+		}
+	}
+	for author := range authors {
+		rm.Authors = append(rm.Authors, author)
+	}
+	sort.Slice(rm.Reviewers, func(i, j int) bool {
+		gi, gj := rm.ReviewsGiven(rm.Reviewers[i]), rm.ReviewsGiven(rm.Reviewers[j])
+		if gi != gj {
+			return gi > gj
+		}
+		return rm.Reviewers[i] < rm.Reviewers[j]
+	})
+	sort.Slice(rm.Authors, func(i, j int) bool {
+		ri, rj := rm.ReviewsReceived(rm.Authors[i]), rm.ReviewsReceived(rm.Authors[j])
+		if ri != rj {
+			return ri > rj
+		}
+		return rm.Authors[i] < rm.Authors[j]
+	})
+
+	return rm, nil
+}
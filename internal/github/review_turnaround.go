@@ -0,0 +1,115 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ReviewTurnaround is how quickly a user answers review requests.
+type ReviewTurnaround struct {
+	// Turnarounds are the times from a review request to the user's next
+	// review, for PRs by others the user reviewed in the window.
+	Turnarounds []time.Duration
+	// Pending holds when each open PR still waiting on the user's review
+	// asked for it, or the PR's creation when the request can't be found.
+	Pending []time.Time
+}
+
+// Median returns the median turnaround, or zero when there are none.
+func (t *ReviewTurnaround) Median() time.Duration {
+	if len(t.Turnarounds) == 0 {
+		return 0
+	}
+	sorted := slices.Sorted(slices.Values(t.Turnarounds))
+	return sorted[len(sorted)/2]
+}
+
+// reviewTurnaroundMaxPRs caps how many PRs each search looks at, as every
+// one costs a timeline request.
+const reviewTurnaroundMaxPRs = 30
+
+// FetchReviewTurnaround measures username's review turnaround on PRs by
+// others updated since the given time, pairing each review request to them
+// in the PR timeline with their next review, and lists the requests still
+// waiting on them. Requests to a team are not attributed to the user.
+func (c *SearchService) FetchReviewTurnaround(ctx context.Context, username string, since time.Time) (*ReviewTurnaround, error) {
+	var reviewed, requested SearchResult
+	q := fmt.Sprintf("reviewed-by:%s+-author:%s+type:pr+updated:>=%s", username, username, since.Format("2006-01-02"))
+	u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=%d", c.baseURL, q, reviewTurnaroundMaxPRs)
+	if err := c.getJSON(ctx, u, &reviewed); err != nil {
+		return nil, fmt.Errorf("search reviewed PRs: %w", err)
+	}
+	q = fmt.Sprintf("review-requested:%s+type:pr+is:open", username)
+	u = fmt.Sprintf("%s/search/issues?q=%s&sort=created&order=asc&per_page=%d", c.baseURL, q, reviewTurnaroundMaxPRs)
+	if err := c.getJSON(ctx, u, &requested); err != nil {
+		return nil, fmt.Errorf("search review requests: %w", err)
+	}
+
+	result := &ReviewTurnaround{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, 5)
+	measure := func(item SearchItem, pending bool) {
+		defer wg.Done()
+		sem <- struct{}{}
+		defer func() { <-sem }()
+
+		owner, repo := parseRepoURL(item.RepositoryURL)
+		if owner == "" || repo == "" {
+			return
+		}
+		events, err := c.client.PullRequests.GetPRTimeline(ctx, owner, repo, item.Number)
+		if err != nil && !pending {
+			// Left out rather than failing the whole measurement
+			return
+		}
+		turnarounds, waitingSince := requestTurnarounds(events, username)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if !pending {
+			result.Turnarounds = append(result.Turnarounds, turnarounds...)
+			return
+		}
+		if waitingSince.IsZero() {
+			waitingSince = item.CreatedAt
+		}
+		result.Pending = append(result.Pending, waitingSince)
+	}
+	for _, item := range reviewed.Items {
+		wg.Add(1)
+		go measure(item, false)
+	}
+	for _, item := range requested.Items {
+		wg.Add(1)
+		go measure(item, true)
+	}
+	wg.Wait()
+
+	slices.SortFunc(result.Pending, func(a, b time.Time) int { return a.Compare(b) })
+	return result, nil
+}
+
+// requestTurnarounds walks a PR timeline, oldest first, pairing each review
+// request to username with their next review. It also returns when the
+// request they haven't answered yet was made, or zero.
+func requestTurnarounds(events []PRTimelineEvent, username string) (turnarounds []time.Duration, waitingSince time.Time) {
+	for _, e := range events {
+		switch {
+		case e.Kind == "review_requested" && strings.EqualFold(e.Detail, "@"+username):
+			if waitingSince.IsZero() {
+				waitingSince = e.At
+			}
+		case e.Kind == "reviewed" && strings.EqualFold(e.Actor, username):
+			if !waitingSince.IsZero() {
+				turnarounds = append(turnarounds, e.At.Sub(waitingSince))
+				waitingSince = time.Time{}
+			}
+		}
+	}
+	return turnarounds, waitingSince
+}
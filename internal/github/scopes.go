@@ -0,0 +1,74 @@
+package github
+
+import (
+	"slices"
+	"strings"
+)
+
+// ScopeRequirement is an OAuth scope hubell relies on and the features that
+// degrade without it.
+type ScopeRequirement struct {
+	Scope    string
+	Features string
+	// grantedBy lists the scopes that include this one
+	grantedBy []string
+	// org is set for scopes only needed when an org is configured
+	org bool
+}
+
+// ScopeRequirements are the classic token scopes hubell checks for.
+var ScopeRequirements = []ScopeRequirement{
+	{
+		Scope:     "notifications",
+		Features:  "notification inbox, mark as read/done",
+		grantedBy: []string{"notifications", "repo"},
+	},
+	{
+		Scope:     "repo",
+		Features:  "private repo PRs, CI checks, branch updates and secret scanning",
+		grantedBy: []string{"repo"},
+	},
+	{
+		Scope:     "read:org",
+		Features:  "org dashboard and team members",
+		grantedBy: []string{"read:org", "write:org", "admin:org"},
+		org:       true,
+	},
+}
+
+// parseScopes splits an X-OAuth-Scopes header. It returns nil when the
+// header is absent, as it is for fine-grained and GitHub App tokens, whose
+// permissions can't be checked this way.
+func parseScopes(header []string) []string {
+	if header == nil {
+		return nil
+	}
+	scopes := []string{}
+	for _, h := range header {
+		for s := range strings.SplitSeq(h, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	return scopes
+}
+
+// MissingScopes returns the requirements the granted scopes don't cover.
+// withOrg includes the scopes only the org features need. Returns nil when
+// granted is nil, i.e. the token's scopes are unknown.
+func MissingScopes(granted []string, withOrg bool) []ScopeRequirement {
+	if granted == nil {
+		return nil
+	}
+	var missing []ScopeRequirement
+	for _, r := range ScopeRequirements {
+		if r.org && !withOrg {
+			continue
+		}
+		if !slices.ContainsFunc(r.grantedBy, func(s string) bool { return slices.Contains(granted, s) }) {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
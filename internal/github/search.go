@@ -0,0 +1,268 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SearchUserOpenPRs fetches all open pull requests created by the authenticated user.
+// It merges results from /user/issues (which includes private repos when the token has
+// repo scope) and the search API (which includes PRs on repos where the user is not a
+// member, e.g. open source contributions via forks). Both sources are queried concurrently.
+func (c *SearchService) SearchUserOpenPRs(ctx context.Context, username string) (*SearchResult, error) {
+	type sourceResult struct {
+		items []SearchItem
+		err   error
+	}
+
+	userCh := make(chan sourceResult, 1)
+	searchCh := make(chan sourceResult, 1)
+
+	// /user/issues covers private repos where the user is a collaborator/member
+	go func() {
+		result, err := c.listUserOpenPRs(ctx)
+		if err != nil {
+			userCh <- sourceResult{err: err}
+		} else {
+			userCh <- sourceResult{items: result.Items}
+		}
+	}()
+
+	// Search API covers external repos (forks, open source contributions)
+	go func() {
+		result, err := c.searchUserOpenPRs(ctx, username)
+		if err != nil {
+			searchCh <- sourceResult{err: err}
+		} else {
+			searchCh <- sourceResult{items: result.Items}
+		}
+	}()
+
+	ur := <-userCh
+	sr := <-searchCh
+
+	seen := make(map[string]struct{})
+	var allItems []SearchItem
+
+	if ur.err == nil {
+		for _, item := range ur.items {
+			key := item.HTMLURL
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				allItems = append(allItems, item)
+			}
+		}
+	}
+
+	if sr.err == nil {
+		for _, item := range sr.items {
+			key := item.HTMLURL
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				allItems = append(allItems, item)
+			}
+		}
+	}
+
+	if len(allItems) == 0 {
+		return nil, fmt.Errorf("failed to fetch open PRs from any source")
+	}
+
+	return &SearchResult{Items: allItems}, nil
+}
+
+// listUserOpenPRs uses GET /user/issues to list PRs including private repos.
+// Requires repo scope on the token.
+func (c *SearchService) listUserOpenPRs(ctx context.Context) (*SearchResult, error) {
+	var allItems []SearchItem
+
+	for page := 1; ; page++ {
+		pageURL := fmt.Sprintf("%s/user/issues?filter=created&state=open&per_page=100&page=%d", c.baseURL, page)
+
+		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		c.setHeaders(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("/user/issues: status %d", resp.StatusCode)
+		}
+
+		var items []SearchItem
+		if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to decode user issues: %w", err)
+		}
+		resp.Body.Close()
+
+		for _, item := range items {
+			// Only include pull requests (items with a pull_request ref)
+			if item.PullRequestRef.URL != "" {
+				allItems = append(allItems, item)
+			}
+		}
+
+		if len(items) < 100 {
+			break
+		}
+	}
+
+	return &SearchResult{Items: allItems}, nil
+}
+
+// searchUserOpenPRs uses the search API as a fallback. Works for public repos
+// without repo scope but does not reliably include private repos.
+func (c *SearchService) searchUserOpenPRs(ctx context.Context, username string) (*SearchResult, error) {
+	q := fmt.Sprintf("author:%s+type:pr+state:open", username)
+	u := fmt.Sprintf("%s/search/issues?q=%s&per_page=100", c.baseURL, q)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("search: status %d", resp.StatusCode)
+	}
+
+	var result SearchResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode search results: %w", err)
+	}
+
+	return &result, nil
+}
+
+// SearchMergedPRsThisWeek fetches PRs merged by the user since the start of the current week (Monday).
+func (c *SearchService) SearchMergedPRsThisWeek(ctx context.Context, username string) ([]MergedPRInfo, error) {
+	now := time.Now()
+	weekday := now.Weekday()
+	if weekday == time.Sunday {
+		weekday = 7
+	}
+	monday := now.AddDate(0, 0, -int(weekday-time.Monday))
+	mondayStr := monday.Format("2006-01-02")
+
+	q := fmt.Sprintf("author:%s+type:pr+is:merged+merged:>=%s", username, mondayStr)
+	u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=30", c.baseURL, q)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("search merged PRs: status %d", resp.StatusCode)
+	}
+
+	var result SearchResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode merged PR search: %w", err)
+	}
+
+	var merged []MergedPRInfo
+	for _, item := range result.Items {
+		owner, repo := parseRepoURL(item.RepositoryURL)
+		if owner == "" || repo == "" {
+			continue
+		}
+		mergedAt := time.Time{}
+		if item.ClosedAt != nil {
+			mergedAt = *item.ClosedAt
+		}
+		merged = append(merged, MergedPRInfo{
+			Owner:     owner,
+			Repo:      repo,
+			Number:    item.Number,
+			Title:     item.Title,
+			URL:       item.HTMLURL,
+			Author:    item.User.Login,
+			CreatedAt: item.CreatedAt,
+			MergedAt:  mergedAt,
+		})
+	}
+
+	return merged, nil
+}
+
+// SearchMergedPRsSince fetches PRs merged by the user since the given date.
+// Uses per_page=100 to cover typical 12-week history in a single request.
+func (c *SearchService) SearchMergedPRsSince(ctx context.Context, username string, since time.Time) ([]MergedPRInfo, error) {
+	sinceStr := since.Format("2006-01-02")
+
+	q := fmt.Sprintf("author:%s+type:pr+is:merged+merged:>=%s", username, sinceStr)
+	u := fmt.Sprintf("%s/search/issues?q=%s&sort=updated&order=desc&per_page=100", c.baseURL, q)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("search merged PRs since %s: status %d", sinceStr, resp.StatusCode)
+	}
+
+	var result SearchResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode merged PR search: %w", err)
+	}
+
+	var merged []MergedPRInfo
+	for _, item := range result.Items {
+		owner, repo := parseRepoURL(item.RepositoryURL)
+		if owner == "" || repo == "" {
+			continue
+		}
+		mergedAt := time.Time{}
+		if item.ClosedAt != nil {
+			mergedAt = *item.ClosedAt
+		}
+		merged = append(merged, MergedPRInfo{
+			Owner:     owner,
+			Repo:      repo,
+			Number:    item.Number,
+			Title:     item.Title,
+			URL:       item.HTMLURL,
+			Author:    item.User.Login,
+			CreatedAt: item.CreatedAt,
+			MergedAt:  mergedAt,
+		})
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,187 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SecretScanningAlert is an open secret scanning alert on a repository the
+// user administers.
+type SecretScanningAlert struct {
+	Owner      string
+	Repo       string
+	Number     int
+	SecretType string // display name, e.g. "GitHub Personal Access Token"
+	Validity   string // "active", "inactive" or "unknown"
+	HTMLURL    string
+	CreatedAt  time.Time
+
+	// PushProtectionBypassed is set when someone pushed the secret past
+	// push protection; BypassedBy is who.
+	PushProtectionBypassed bool
+	BypassedBy             string
+	BypassedAt             time.Time
+}
+
+// Key identifies the alert across polls.
+func (a SecretScanningAlert) Key() string {
+	return fmt.Sprintf("%s/%s#%d", a.Owner, a.Repo, a.Number)
+}
+
+// Title is a one-line headline for notifications, e.g. "Secret leaked in
+// owner/repo".
+func (a SecretScanningAlert) Title() string {
+	if a.PushProtectionBypassed {
+		return "Push protection bypassed in " + a.Owner + "/" + a.Repo
+	}
+	return "Secret leaked in " + a.Owner + "/" + a.Repo
+}
+
+// Summary describes the alert: the secret type, who bypassed push
+// protection and whether the secret is still valid.
+func (a SecretScanningAlert) Summary() string {
+	summary := fmt.Sprintf("#%d %s", a.Number, a.SecretType)
+	if a.PushProtectionBypassed && a.BypassedBy != "" {
+		summary += " · bypassed by @" + a.BypassedBy
+	}
+	if a.Validity == "active" {
+		summary += " · still active"
+	}
+	return summary
+}
+
+// UpdatedAt is when the alert was raised, or when push protection was
+// bypassed for it.
+func (a SecretScanningAlert) UpdatedAt() time.Time {
+	if a.BypassedAt.After(a.CreatedAt) {
+		return a.BypassedAt
+	}
+	return a.CreatedAt
+}
+
+// secretScanningAlertResponse is the subset of the secret scanning alert API
+// response used here.
+type secretScanningAlertResponse struct {
+	Number                   int        `json:"number"`
+	HTMLURL                  string     `json:"html_url"`
+	CreatedAt                time.Time  `json:"created_at"`
+	SecretTypeDisplayName    string     `json:"secret_type_display_name"`
+	SecretType               string     `json:"secret_type"`
+	Validity                 string     `json:"validity"`
+	PushProtectionBypassed   bool       `json:"push_protection_bypassed"`
+	PushProtectionBypassedAt *time.Time `json:"push_protection_bypassed_at"`
+	PushProtectionBypassedBy *User      `json:"push_protection_bypassed_by"`
+}
+
+// adminRepo is the subset of the repository API response used to find the
+// repos the user administers.
+type adminRepo struct {
+	FullName    string `json:"full_name"`
+	Archived    bool   `json:"archived"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+	} `json:"permissions"`
+}
+
+// ListAdminRepos returns "owner/repo" for every unarchived repository the
+// user has admin access to.
+func (c *ReposService) ListAdminRepos(ctx context.Context) ([]string, error) {
+	var repos []string
+	for page := 1; ; page++ {
+		u := fmt.Sprintf("%s/user/repos?affiliation=owner,organization_member&per_page=100&page=%d", c.baseURL, page)
+		var batch []adminRepo
+		if err := c.getJSON(ctx, u, &batch); err != nil {
+			return nil, fmt.Errorf("list repos: %w", err)
+		}
+		for _, r := range batch {
+			if r.Permissions.Admin && !r.Archived {
+				repos = append(repos, r.FullName)
+			}
+		}
+		if len(batch) < 100 {
+			return repos, nil
+		}
+	}
+}
+
+// ListSecretScanningAlerts fetches the open secret scanning alerts of a repo.
+func (c *ReposService) ListSecretScanningAlerts(ctx context.Context, owner, repo string) ([]SecretScanningAlert, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/secret-scanning/alerts?state=open&per_page=100", c.baseURL, owner, repo)
+
+	var raw []secretScanningAlertResponse
+	if err := c.getJSON(ctx, u, &raw); err != nil {
+		return nil, fmt.Errorf("list secret scanning alerts: %w", err)
+	}
+
+	alerts := make([]SecretScanningAlert, 0, len(raw))
+	for _, r := range raw {
+		a := SecretScanningAlert{
+			Owner:                  owner,
+			Repo:                   repo,
+			Number:                 r.Number,
+			SecretType:             r.SecretTypeDisplayName,
+			Validity:               r.Validity,
+			HTMLURL:                r.HTMLURL,
+			CreatedAt:              r.CreatedAt,
+			PushProtectionBypassed: r.PushProtectionBypassed,
+		}
+		if a.SecretType == "" {
+			a.SecretType = r.SecretType
+		}
+		if r.PushProtectionBypassedAt != nil {
+			a.BypassedAt = *r.PushProtectionBypassedAt
+		}
+		if r.PushProtectionBypassedBy != nil {
+			a.BypassedBy = r.PushProtectionBypassedBy.Login
+		}
+		alerts = append(alerts, a)
+	}
+	return alerts, nil
+}
+
+// pollSecretScanningAlerts collects the open secret scanning alerts across
+// the repos the user administers, newest first. Repos without secret scanning
+// (or where the token lacks access) contribute nothing.
+func pollSecretScanningAlerts(ctx context.Context, client *Client, caches *prCaches) []SecretScanningAlert {
+	repos := caches.adminRepos.get("", func() []string {
+		repos, _ := client.Repos.ListAdminRepos(ctx)
+		return repos
+	})
+
+	var (
+		mu  sync.Mutex
+		all []SecretScanningAlert
+		wg  sync.WaitGroup
+	)
+	sem := make(chan struct{}, 5)
+	for _, fullName := range repos {
+		owner, repo, ok := strings.Cut(fullName, "/")
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			alerts := caches.secretScanning.get(fullName, func() []SecretScanningAlert {
+				alerts, _ := client.Repos.ListSecretScanningAlerts(ctx, owner, repo)
+				return alerts
+			})
+			mu.Lock()
+			all = append(all, alerts...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].UpdatedAt().After(all[j].UpdatedAt())
+	})
+	return all
+}
@@ -0,0 +1,218 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SubjectDetail holds type-specific info about the subject of a Release or
+// Discussion notification.
+type SubjectDetail struct {
+	// URL is the subject's web URL, which can't be derived from the API URL
+	// for releases.
+	URL string
+
+	// Releases
+	Tag        string
+	Name       string
+	Notes      string // truncated summary of the release notes
+	Prerelease bool
+
+	// Discussions
+	Category   string
+	Answerable bool // the category takes answers, e.g. Q&A
+	Answered   bool
+	AnsweredBy string
+}
+
+// hasSubjectDetail reports whether FetchSubjectDetail enriches a
+// notification's subject type.
+func hasSubjectDetail(n *Notification) bool {
+	return n.Subject.Type == "Release" || n.Subject.Type == "Discussion"
+}
+
+// FetchSubjectDetail fetches the release or discussion a notification is
+// about. Returns nil with no error for other subject types.
+func (c *NotificationsService) FetchSubjectDetail(ctx context.Context, n *Notification) (*SubjectDetail, error) {
+	switch n.Subject.Type {
+	case "Release":
+		return c.fetchRelease(ctx, n)
+	case "Discussion":
+		return c.fetchDiscussion(ctx, n)
+	}
+	return nil, nil
+}
+
+// fetchRelease fetches a Release notification's release from its API URL.
+func (c *NotificationsService) fetchRelease(ctx context.Context, n *Notification) (*SubjectDetail, error) {
+	if n.Subject.URL == "" {
+		return nil, nil
+	}
+	var raw struct {
+		TagName    string `json:"tag_name"`
+		Name       string `json:"name"`
+		Body       string `json:"body"`
+		HTMLURL    string `json:"html_url"`
+		Prerelease bool   `json:"prerelease"`
+	}
+	if err := c.getJSON(ctx, n.Subject.URL, &raw); err != nil {
+		return nil, fmt.Errorf("fetch release: %w", err)
+	}
+	return &SubjectDetail{
+		URL:        raw.HTMLURL,
+		Tag:        raw.TagName,
+		Name:       raw.Name,
+		Notes:      truncateBody(raw.Body, 160),
+		Prerelease: raw.Prerelease,
+	}, nil
+}
+
+// discussionFields are the Discussion fields read into a SubjectDetail.
+const discussionFields = `title url isAnswered answer { author { login } } category { name isAnswerable }`
+
+// discussionNode is a Discussion as returned for discussionFields.
+type discussionNode struct {
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	IsAnswered bool   `json:"isAnswered"`
+	Answer     *struct {
+		Author struct {
+			Login string `json:"login"`
+		} `json:"author"`
+	} `json:"answer"`
+	Category struct {
+		Name         string `json:"name"`
+		IsAnswerable bool   `json:"isAnswerable"`
+	} `json:"category"`
+}
+
+// fetchDiscussion fetches a Discussion notification's discussion over
+// GraphQL. Discussions have no REST API, and their notifications often
+// carry no subject URL, in which case the discussion is matched by title
+// among the repo's recently updated ones.
+func (c *NotificationsService) fetchDiscussion(ctx context.Context, n *Notification) (*SubjectDetail, error) {
+	vars := map[string]any{
+		"owner": n.Repository.Owner.Login,
+		"name":  n.Repository.Name,
+	}
+
+	var node *discussionNode
+	if _, num, ok := strings.Cut(n.Subject.URL, "/discussions/"); ok {
+		number, err := strconv.Atoi(num)
+		if err != nil {
+			return nil, fmt.Errorf("discussion number %q: %w", num, err)
+		}
+		vars["number"] = number
+		var data struct {
+			Repository struct {
+				Discussion *discussionNode `json:"discussion"`
+			} `json:"repository"`
+		}
+		query := `query($owner: String!, $name: String!, $number: Int!) {
+			repository(owner: $owner, name: $name) { discussion(number: $number) { ` + discussionFields + ` } }
+		}`
+		if err := c.graphql(ctx, query, vars, &data); err != nil {
+			return nil, fmt.Errorf("fetch discussion: %w", err)
+		}
+		node = data.Repository.Discussion
+	} else {
+		var data struct {
+			Repository struct {
+				Discussions struct {
+					Nodes []discussionNode `json:"nodes"`
+				} `json:"discussions"`
+			} `json:"repository"`
+		}
+		query := `query($owner: String!, $name: String!) {
+			repository(owner: $owner, name: $name) {
+				discussions(first: 25, orderBy: {field: UPDATED_AT, direction: DESC}) { nodes { ` + discussionFields + ` } }
+			}
+		}`
+		if err := c.graphql(ctx, query, vars, &data); err != nil {
+			return nil, fmt.Errorf("list discussions: %w", err)
+		}
+		for i, d := range data.Repository.Discussions.Nodes {
+			if d.Title == n.Subject.Title {
+				node = &data.Repository.Discussions.Nodes[i]
+				break
+			}
+		}
+	}
+	if node == nil {
+		return nil, nil
+	}
+
+	detail := &SubjectDetail{
+		URL:        node.URL,
+		Category:   node.Category.Name,
+		Answerable: node.Category.IsAnswerable,
+		Answered:   node.IsAnswered,
+	}
+	if node.Answer != nil {
+		detail.AnsweredBy = node.Answer.Author.Login
+	}
+	return detail, nil
+}
+
+// subjectEntry caches a notification's subject detail until it's updated.
+type subjectEntry struct {
+	updatedAt time.Time
+	detail    *SubjectDetail
+}
+
+// enrichSubjects fetches the release or discussion behind each Release and
+// Discussion notification, reusing cached details for notifications that
+// haven't changed. Returns a map keyed by notification ID.
+func (p *Poller) enrichSubjects(ctx context.Context, notifications []*Notification) map[string]*SubjectDetail {
+	result := make(map[string]*SubjectDetail)
+	active := make(map[string]bool)
+	var toFetch []*Notification
+	for _, n := range notifications {
+		if !hasSubjectDetail(n) {
+			continue
+		}
+		active[n.ID] = true
+		if e, ok := p.subjects[n.ID]; ok && e.updatedAt.Equal(n.UpdatedAt) {
+			if e.detail != nil {
+				result[n.ID] = e.detail
+			}
+			continue
+		}
+		toFetch = append(toFetch, n)
+	}
+	for id := range p.subjects {
+		if !active[id] {
+			delete(p.subjects, id)
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, 5)
+	for _, n := range toFetch {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			detail, err := p.client.Notifications.FetchSubjectDetail(ctx, n)
+			if err != nil {
+				// Retried on the next poll
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			p.subjects[n.ID] = subjectEntry{updatedAt: n.UpdatedAt, detail: detail}
+			if detail != nil {
+				result[n.ID] = detail
+			}
+		}()
+	}
+	wg.Wait()
+	return result
+}
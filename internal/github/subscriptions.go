@@ -0,0 +1,209 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ListWatchedRepos fetches all repositories the authenticated user is watching.
+func (c *NotificationsService) ListWatchedRepos(ctx context.Context) ([]Repository, error) {
+	var all []Repository
+	for page := 1; ; page++ {
+		u := fmt.Sprintf("%s/user/subscriptions?per_page=100&page=%d", c.baseURL, page)
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.setHeaders(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("list subscriptions: status %d", resp.StatusCode)
+		}
+
+		var repos []Repository
+		if err := json.NewDecoder(resp.Body).Decode(&repos); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("decode subscriptions: %w", err)
+		}
+		resp.Body.Close()
+
+		all = append(all, repos...)
+		if len(repos) < 100 {
+			break
+		}
+	}
+	return all, nil
+}
+
+// ListStarredRepos fetches all repositories the authenticated user has starred.
+func (c *NotificationsService) ListStarredRepos(ctx context.Context) ([]Repository, error) {
+	var all []Repository
+	for page := 1; ; page++ {
+		u := fmt.Sprintf("%s/user/starred?per_page=100&page=%d", c.baseURL, page)
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.setHeaders(req)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("list starred repos: status %d", resp.StatusCode)
+		}
+
+		var repos []Repository
+		if err := json.NewDecoder(resp.Body).Decode(&repos); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("decode starred repos: %w", err)
+		}
+		resp.Body.Close()
+
+		all = append(all, repos...)
+		if len(repos) < 100 {
+			break
+		}
+	}
+	return all, nil
+}
+
+// UpdateSubscription changes the subscription of any subscribable node
+// (repository, issue, PR, discussion...) via the GraphQL updateSubscription
+// mutation. The GraphQL API only exposes subscribed, unsubscribed and ignored;
+// custom per-event-type watching (e.g. releases only) is not available.
+func (c *NotificationsService) UpdateSubscription(ctx context.Context, subscribableID string, state SubscriptionState) error {
+	var gqlState string
+	switch state {
+	case SubscriptionWatching:
+		gqlState = "SUBSCRIBED"
+	case SubscriptionIgnored:
+		gqlState = "IGNORED"
+	default:
+		gqlState = "UNSUBSCRIBED"
+	}
+
+	const mutation = `mutation($id: ID!, $state: SubscriptionState!) {
+  updateSubscription(input: {subscribableId: $id, state: $state}) {
+    subscribable { viewerSubscription }
+  }
+}`
+	return c.graphql(ctx, mutation, map[string]any{"id": subscribableID, "state": gqlState}, nil)
+}
+
+// GetRepoSubscription returns the user's subscription to a repository.
+// A repository without a subscription is SubscriptionParticipating: GitHub
+// then only notifies on threads the user participates in or is @mentioned on.
+func (c *NotificationsService) GetRepoSubscription(ctx context.Context, owner, repo string) (SubscriptionState, error) {
+	u := fmt.Sprintf("%s/repos/%s/%s/subscription", c.baseURL, owner, repo)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return SubscriptionParticipating, nil
+	default:
+		return "", fmt.Errorf("get subscription: status %d", resp.StatusCode)
+	}
+
+	var sub struct {
+		Subscribed bool `json:"subscribed"`
+		Ignored    bool `json:"ignored"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&sub); err != nil {
+		return "", fmt.Errorf("decode subscription: %w", err)
+	}
+	switch {
+	case sub.Ignored:
+		return SubscriptionIgnored, nil
+	case sub.Subscribed:
+		return SubscriptionWatching, nil
+	default:
+		return SubscriptionParticipating, nil
+	}
+}
+
+// SetRepoSubscription updates the user's subscription to a repository.
+// SubscriptionWatching receives all activity and SubscriptionIgnored blocks
+// all notifications. SubscriptionParticipating deletes the subscription,
+// which is how the API expresses participating-only: GitHub then notifies
+// on threads the user participates in or is @mentioned on.
+func (c *NotificationsService) SetRepoSubscription(ctx context.Context, owner, repo string, state SubscriptionState) error {
+	if state == SubscriptionParticipating {
+		return c.DeleteRepoSubscription(ctx, owner, repo)
+	}
+	body := struct {
+		Subscribed bool `json:"subscribed"`
+		Ignored    bool `json:"ignored"`
+	}{
+		Subscribed: state == SubscriptionWatching,
+		Ignored:    state == SubscriptionIgnored,
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/repos/%s/%s/subscription", c.baseURL, owner, repo)
+	req, err := http.NewRequestWithContext(ctx, "PUT", u, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("set subscription: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// DeleteRepoSubscription stops watching a repository, leaving only the
+// notifications for threads the user participates in.
+func (c *NotificationsService) DeleteRepoSubscription(ctx context.Context, owner, repo string) error {
+	u := fmt.Sprintf("%s/repos/%s/%s/subscription", c.baseURL, owner, repo)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("delete subscription: status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SyntheticScenarios names the batches of fake events SyntheticEvents can
+// build, in the order the debug key cycles through them.
+var SyntheticScenarios = []string{"long titles", "hundreds of items", "alerts"}
+
+// syntheticOwner is the owner of every synthetic repo, so injected items are
+// easy to tell apart from real ones.
+const syntheticOwner = "hubell-synthetic"
+
+// syntheticTitles are edge cases for list rendering: long, wide, emoji and
+// right-to-left text.
+var syntheticTitles = []string{
+	strings.Repeat("Refactor the notification pipeline so that ", 6) + "it never wraps",
+	"🚀🔥 Ship it 🎉✨ (emoji everywhere 👀🙏🏽🧑‍💻)",
+	"修复在窄终端中标题截断时的宽字符对齐问题",
+	"إصلاح عرض النص من اليمين إلى اليسار في القائمة",
+	"fix:\ttabs\tand  double  spaces",
+}
+
+// SyntheticEvents builds a poll's worth of fake events for the named
+// scenario (one of SyntheticScenarios), ending with a PollCompleted, to
+// exercise notification rules, themes and layouts with edge-case data.
+func SyntheticEvents(scenario string, now time.Time) []Event {
+	var (
+		events []Event
+		result = PollResult{
+			PRStatuses: make(map[string]PRStatus),
+			PRInfos:    make(map[string]PRInfo),
+		}
+	)
+
+	count := len(syntheticTitles)
+	if scenario == "hundreds of items" {
+		count = 300
+	}
+	statuses := []PRStatus{PRStatusSuccess, PRStatusFailure, PRStatusPending, PRStatusNone}
+	reviews := []PRReviewState{PRReviewApproved, PRReviewChangesRequested, PRReviewReviewed, PRReviewNone}
+	for i := range count {
+		title := syntheticTitles[i%len(syntheticTitles)]
+		if count > len(syntheticTitles) {
+			title = fmt.Sprintf("#%d %s", i+1, title)
+		}
+		repo := fmt.Sprintf("repo-%d", i%7)
+		updated := now.Add(-time.Duration(i) * 17 * time.Minute)
+		result.Notifications = append(result.Notifications, &Notification{
+			ID:        fmt.Sprintf("synthetic-%d", i),
+			Unread:    i%3 != 0,
+			Reason:    []string{"review_requested", "mention", "author", "ci_activity"}[i%4],
+			UpdatedAt: updated,
+			Subject: Subject{
+				Title: title,
+				Type:  "PullRequest",
+				URL:   fmt.Sprintf("%s/repos/%s/%s/pulls/%d", defaultBaseURL, syntheticOwner, repo, i+1),
+			},
+			Repository: Repository{
+				Name:     repo,
+				FullName: syntheticOwner + "/" + repo,
+				Owner:    Owner{Login: syntheticOwner},
+			},
+		})
+		if i%2 == 1 {
+			continue
+		}
+		key := PRKey(syntheticOwner, repo, i+1)
+		status := statuses[i%len(statuses)]
+		result.PRStatuses[key] = status
+		result.PRInfos[key] = PRInfo{
+			Owner:       syntheticOwner,
+			Repo:        repo,
+			Number:      i + 1,
+			Title:       title,
+			Branch:      fmt.Sprintf("synthetic/branch-%d", i),
+			URL:         fmt.Sprintf("https://github.com/%s/%s/pull/%d", syntheticOwner, repo, i+1),
+			CreatedAt:   updated.Add(-48 * time.Hour),
+			UpdatedAt:   updated,
+			ReviewState: reviews[i%len(reviews)],
+			Additions:   i * 13,
+			Deletions:   i * 5,
+			CheckRuns: []CheckRun{
+				{ID: i, Name: "build", Status: "completed", Conclusion: "success"},
+				{ID: i + 1, Name: "test " + title, Status: "completed", Conclusion: string(status)},
+			},
+			BaseBranch: "main",
+			BehindBy:   i % 4,
+		}
+	}
+
+	if scenario == "alerts" {
+		first := result.Notifications[0]
+		events = append(events, NotificationAdded{first})
+		for key, info := range result.PRInfos {
+			events = append(events, PRStatusChanged{PRStatusChange{
+				Owner:     info.Owner,
+				Repo:      info.Repo,
+				Number:    info.Number,
+				Title:     info.Title,
+				URL:       info.URL,
+				OldStatus: PRStatusPending,
+				NewStatus: result.PRStatuses[key],
+			}})
+			events = append(events, PRMerged{MergedPRInfo{
+				Owner:    info.Owner,
+				Repo:     info.Repo,
+				Number:   info.Number,
+				Title:    info.Title,
+				URL:      info.URL,
+				MergedAt: now,
+			}})
+			break
+		}
+		alert := SecretScanningAlert{
+			Owner:                  syntheticOwner,
+			Repo:                   "repo-0",
+			Number:                 1,
+			SecretType:             "GitHub Personal Access Token",
+			Validity:               "active",
+			HTMLURL:                "https://github.com/" + syntheticOwner + "/repo-0/security/secret-scanning/1",
+			CreatedAt:              now,
+			PushProtectionBypassed: true,
+			BypassedBy:             "octocat",
+			BypassedAt:             now,
+		}
+		result.SecretScanningAlerts = []SecretScanningAlert{alert}
+		events = append(events, SecretAlertRaised{alert})
+		broken := BranchHealth{
+			Owner:           syntheticOwner,
+			Repo:            "repo-1",
+			Branch:          "main",
+			Status:          PRStatusFailure,
+			HeadSHA:         "0123456789abcdef0123456789abcdef01234567",
+			BreakingSHA:     "fedcba9876543210fedcba9876543210fedcba98",
+			BreakingAuthor:  "octocat",
+			BreakingMessage: syntheticTitles[1],
+			RedSince:        now.Add(-90 * time.Minute),
+		}
+		result.FailingMains = []BranchHealth{broken}
+		events = append(events, MainBranchBroken{broken})
+	}
+
+	return append(events, PollCompleted{Result: result})
+}
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// ThreadComment is one comment in an issue or pull request conversation.
+type ThreadComment struct {
+	Author    string
+	Body      string
+	CreatedAt time.Time
+	URL       string
+}
+
+// Thread is an issue or pull request conversation with its labels and
+// milestone.
+type Thread struct {
+	Labels    []Label
+	Milestone *Milestone
+	Comments  []ThreadComment
+}
+
+const threadCommentsQuery = `query($owner: String!, $repo: String!, $number: Int!, $last: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issueOrPullRequest(number: $number) {
+      ... on Issue {
+        labels(first: 20) { nodes { name color } }
+        milestone { title url dueOn }
+        comments(last: $last) { nodes { author { login } body createdAt url } }
+      }
+      ... on PullRequest {
+        labels(first: 20) { nodes { name color } }
+        milestone { title url dueOn }
+        comments(last: $last) { nodes { author { login } body createdAt url } }
+      }
+    }
+  }
+}`
+
+// GetThread returns the labels, milestone and last n conversation comments
+// (oldest first) of an issue or pull request.
+func (c *NotificationsService) GetThread(ctx context.Context, owner, repo string, number, n int) (*Thread, error) {
+	var data struct {
+		Repository struct {
+			IssueOrPullRequest struct {
+				Labels struct {
+					Nodes []Label `json:"nodes"`
+				} `json:"labels"`
+				Milestone *struct {
+					Title string     `json:"title"`
+					URL   string     `json:"url"`
+					DueOn *time.Time `json:"dueOn"`
+				} `json:"milestone"`
+				Comments struct {
+					Nodes []struct {
+						Author *struct {
+							Login string `json:"login"`
+						} `json:"author"`
+						Body      string    `json:"body"`
+						CreatedAt time.Time `json:"createdAt"`
+						URL       string    `json:"url"`
+					} `json:"nodes"`
+				} `json:"comments"`
+			} `json:"issueOrPullRequest"`
+		} `json:"repository"`
+	}
+	err := c.graphql(ctx, threadCommentsQuery, map[string]any{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+		"last":   n,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	item := data.Repository.IssueOrPullRequest
+	thread := &Thread{Labels: item.Labels.Nodes}
+	if ms := item.Milestone; ms != nil {
+		thread.Milestone = &Milestone{Title: ms.Title, HTMLURL: ms.URL, DueOn: ms.DueOn}
+	}
+	nodes := item.Comments.Nodes
+	comments := make([]ThreadComment, 0, len(nodes))
+	for _, node := range nodes {
+		author := "ghost"
+		if node.Author != nil {
+			author = node.Author.Login
+		}
+		comments = append(comments, ThreadComment{
+			Author:    author,
+			Body:      node.Body,
+			CreatedAt: node.CreatedAt,
+			URL:       node.URL,
+		})
+	}
+	thread.Comments = comments
+	return thread, nil
+}
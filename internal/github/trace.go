@@ -0,0 +1,49 @@
+package github
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// traceTransport logs every API request with its status, duration and the
+// rate limit left, at debug level, for diagnosing what a poll fetched.
+type traceTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !slog.Default().Enabled(req.Context(), slog.LevelDebug) {
+		return t.base.RoundTrip(req)
+	}
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	attrs := []any{
+		"method", req.Method,
+		"url", req.URL.Path + querySuffix(req),
+		"duration", time.Since(start).Round(time.Millisecond),
+	}
+	if err != nil {
+		slog.Debug("api request failed", append(attrs, "err", err)...)
+		return resp, err
+	}
+	attrs = append(attrs, "status", resp.StatusCode)
+	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining != "" {
+		attrs = append(attrs,
+			"ratelimit_remaining", remaining,
+			"ratelimit_limit", resp.Header.Get("X-RateLimit-Limit"),
+			"ratelimit_resource", resp.Header.Get("X-RateLimit-Resource"))
+	}
+	slog.Debug("api request", attrs...)
+	return resp, err
+}
+
+// querySuffix returns the request's query string with its leading "?", or
+// "" when it has none.
+func querySuffix(req *http.Request) string {
+	if req.URL.RawQuery == "" {
+		return ""
+	}
+	return "?" + req.URL.RawQuery
+}
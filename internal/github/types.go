@@ -26,12 +26,21 @@ type CommentDetail struct {
 	Body        string // truncated preview
 	Type        string // "comment", "review", "review_comment"
 	ReviewState string // "APPROVED", "CHANGES_REQUESTED", "COMMENTED", etc.
+
+	// SubjectState is the issue or PR's "open", "closed" or "merged" state
+	// when the latest comment URL is the subject itself, as it is for state
+	// change notifications.
+	SubjectState string
 }
 
 // Repository represents the repository info
 type Repository struct {
+	NodeID   string `json:"node_id"`
+	Name     string `json:"name"`
 	FullName string `json:"full_name"`
 	Owner    Owner  `json:"owner"`
+	HTMLURL  string `json:"html_url"`
+	Private  bool   `json:"private"`
 }
 
 // Owner represents the repository owner
@@ -42,6 +51,9 @@ type Owner struct {
 // User represents the authenticated GitHub user
 type User struct {
 	Login string `json:"login"`
+	// Scopes are the token's OAuth scopes from the X-OAuth-Scopes header,
+	// nil when the token doesn't report them.
+	Scopes []string `json:"-"`
 }
 
 // SearchResult represents a GitHub search API response
@@ -57,9 +69,35 @@ type SearchItem struct {
 	HTMLURL        string         `json:"html_url"`
 	User           User           `json:"user"`
 	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
 	ClosedAt       *time.Time     `json:"closed_at"`
+	Comments       int            `json:"comments"`
 	PullRequestRef PullRequestRef `json:"pull_request"`
 	RepositoryURL  string         `json:"repository_url"`
+	Labels         []Label        `json:"labels"`
+	Milestone      *Milestone     `json:"milestone"`
+}
+
+// Label is an issue or pull request label
+type Label struct {
+	Name  string `json:"name"`
+	Color string `json:"color"` // hex without the leading #, e.g. "d73a4a"
+}
+
+// Milestone is the milestone an issue or pull request belongs to
+type Milestone struct {
+	Title   string     `json:"title"`
+	HTMLURL string     `json:"html_url"`
+	DueOn   *time.Time `json:"due_on"`
+}
+
+// RepoFullName returns the "owner/repo" name of the repository the item belongs to.
+func (s SearchItem) RepoFullName() string {
+	owner, repo := parseRepoURL(s.RepositoryURL)
+	if owner == "" {
+		return ""
+	}
+	return owner + "/" + repo
 }
 
 // PullRequestRef contains pull request metadata from a search result
@@ -69,17 +107,58 @@ type PullRequestRef struct {
 
 // PullRequest represents a GitHub pull request
 type PullRequest struct {
-	Number    int    `json:"number"`
-	Title     string `json:"title"`
-	Head      PRHead `json:"head"`
-	Additions int    `json:"additions"`
-	Deletions int    `json:"deletions"`
+	NodeID    string    `json:"node_id"`
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	HTMLURL   string    `json:"html_url"`
+	State     string    `json:"state"`
+	Merged    bool      `json:"merged"`
+	User      User      `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Head      PRHead    `json:"head"`
+	Base      PRHead    `json:"base"`
+	Additions int       `json:"additions"`
+	Deletions int       `json:"deletions"`
+	// Mergeable is nil while GitHub is still computing mergeability.
+	Mergeable      *bool      `json:"mergeable"`
+	MergeableState string     `json:"mergeable_state"`
+	Labels         []Label    `json:"labels"`
+	Milestone      *Milestone `json:"milestone"`
+	// RequestedReviewers and RequestedTeams are reviews still pending;
+	// GitHub drops a reviewer from them once they review.
+	RequestedReviewers []User `json:"requested_reviewers"`
+	RequestedTeams     []Team `json:"requested_teams"`
+	Assignees          []User `json:"assignees"`
+	// AutoMerge is nil unless auto-merge is armed.
+	AutoMerge *AutoMerge `json:"auto_merge"`
+}
+
+// AutoMerge is a PR's armed auto-merge
+type AutoMerge struct {
+	MergeMethod string `json:"merge_method"`
+	EnabledBy   User   `json:"enabled_by"`
 }
 
-// PRHead represents the head ref of a pull request
+// Team is a GitHub team, as requested for review
+type Team struct {
+	Slug string `json:"slug"`
+}
+
+// PRHead represents the head or base ref of a pull request
 type PRHead struct {
 	Ref string `json:"ref"`
 	SHA string `json:"sha"`
+	// Repo is nil when the head repository (a fork) has been deleted.
+	Repo *PRRepo `json:"repo"`
+}
+
+// PRRepo is the repository a PR ref lives in
+type PRRepo struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Owner    Owner  `json:"owner"`
+	Fork     bool   `json:"fork"`
 }
 
 // CheckRunsResponse represents the response from the check-runs API
@@ -120,11 +199,79 @@ type PRInfo struct {
 	Branch      string
 	URL         string
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
 	ReviewState PRReviewState
 	Reviews     []Review
 	Additions   int
 	Deletions   int
 	CheckRuns   []CheckRun
+	BaseBranch  string
+	HeadSHA     string
+
+	// Conflicted is set when GitHub reports the PR cannot be merged cleanly
+	// into its base branch.
+	Conflicted bool
+
+	// BehindBy is how many commits the base branch has gained since the
+	// PR branched off.
+	BehindBy int
+
+	// HeadRepo is the "owner/repo" of the fork the PR comes from; empty
+	// when the head branch lives in the base repository.
+	HeadRepo string
+
+	// ForkBehindBy is how many commits the fork's copy of the base branch
+	// is behind upstream. Only set for PRs from forks.
+	ForkBehindBy int
+
+	// CodeScanningAlerts are open code scanning alerts the PR introduces.
+	CodeScanningAlerts []CodeScanningAlert
+
+	// FirstReviewAt is when someone other than the author first reviewed
+	// the PR; zero if nobody has yet.
+	FirstReviewAt time.Time
+
+	// Author is the PR author's login, and State is "open", "closed" or
+	// "merged"; both are empty when the PR's details couldn't be fetched.
+	Author string
+	State  string
+
+	// Watched is set for PRs by others that are on the watchlist.
+	Watched bool
+
+	// Labels and Milestone are the PR's labels and milestone, if any.
+	Labels    []Label
+	Milestone *Milestone
+
+	// Reviewers are everyone asked for or giving a review, in request
+	// order followed by unrequested reviewers; Assignees are the logins
+	// assigned to the PR other than its author.
+	Reviewers []ReviewerState
+	Assignees []string
+
+	// NodeID is the PR's GraphQL ID, and AutoMergeMethod the merge method
+	// auto-merge will use, or "" when it isn't armed.
+	NodeID          string
+	AutoMergeMethod string
+}
+
+// ReviewerState is one reviewer of a PR and where their review stands.
+type ReviewerState struct {
+	// Login is the user's login, or the team's slug when Team is set.
+	Login string
+	Team  bool
+	// State is PRReviewNone while their review is pending.
+	State PRReviewState
+}
+
+// UnreviewedFor returns how long the PR has been open without a review from
+// someone other than the author. It returns zero once the PR has been
+// reviewed, or if its reviews could not be fetched.
+func (p PRInfo) UnreviewedFor(now time.Time) time.Duration {
+	if p.Reviews == nil || !p.FirstReviewAt.IsZero() || p.CreatedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(p.CreatedAt)
 }
 
 // MergedPRInfo contains metadata about a merged pull request
@@ -185,6 +332,15 @@ type PRStatusChange struct {
 	NewStatus PRStatus
 }
 
+// SubscriptionState describes how the user is watching a repository.
+type SubscriptionState string
+
+const (
+	SubscriptionWatching      SubscriptionState = "watching"
+	SubscriptionParticipating SubscriptionState = "participating"
+	SubscriptionIgnored       SubscriptionState = "ignored"
+)
+
 // OrgMember represents a member of a GitHub organization
 type OrgMember struct {
 	Login string `json:"login"`
@@ -199,6 +355,30 @@ type OrgMemberActivity struct {
 	Reviews   int
 	Additions int
 	Deletions int
+
+	// WeeklyMerged holds merged PR counts for the trailing weeks, oldest
+	// first; the last entry is the current 7-day window.
+	WeeklyMerged []int
+
+	// ReviewTimes are when the member's reviews on PRs merged in the window
+	// were submitted.
+	ReviewTimes []time.Time
+
+	IssuesOpened int
+	IssuesClosed int
+
+	// FirstContribution is true when the member's earliest merged PR in the
+	// window is their first ever merged PR in the org.
+	FirstContribution bool
+
+	// Bot is true when the login matches a bot pattern.
+	Bot bool
+}
+
+// Active reports whether the member authored, reviewed or triaged anything
+// in the window.
+func (a OrgMemberActivity) Active() bool {
+	return len(a.MergedPRs) > 0 || len(a.OpenPRs) > 0 || a.Commits > 0 || a.Reviews > 0 || a.IssuesOpened > 0 || a.IssuesClosed > 0
 }
 
 // OrgLoadingStep identifies a step in org activity loading.
@@ -210,7 +390,11 @@ const (
 	OrgStepOpenPRs
 	OrgStepCommits
 	OrgStepReviews
+	OrgStepIssues
 	OrgStepDiffStats
+	OrgStepTrends
+	OrgStepFirstPRs
+	OrgStepCoverage
 	OrgStepAggregate
 )
 
@@ -226,8 +410,16 @@ func (s OrgLoadingStep) String() string {
 		return "Commits"
 	case OrgStepReviews:
 		return "Reviews"
+	case OrgStepIssues:
+		return "Issues"
 	case OrgStepDiffStats:
 		return "Diff Stats"
+	case OrgStepTrends:
+		return "Trends"
+	case OrgStepFirstPRs:
+		return "First PRs"
+	case OrgStepCoverage:
+		return "Coverage"
 	case OrgStepAggregate:
 		return "Aggregate"
 	default:
@@ -244,6 +436,10 @@ type OrgLoadingProgress struct {
 	StartedAt time.Time
 	UpdatedAt time.Time
 	Done      bool
+
+	// Members, when set, is a partial snapshot of the results so far rather
+	// than a step update.
+	Members []OrgMemberActivity
 }
 
 // OrgActivitySummary captures high-level stats from an org activity refresh.
@@ -258,6 +454,34 @@ type OrgActivitySummary struct {
 	ActiveEngineers   int
 	LOC               int
 	Duration          time.Duration
+
+	// InactiveMembers are the logins of members (bots aside) with no
+	// activity in the window, sorted.
+	InactiveMembers []string
+
+	// UnreviewedMerged are the PRKeys of PRs merged in the window without
+	// any review; CoverageKnown is false when they couldn't be searched.
+	UnreviewedMerged []string
+	CoverageKnown    bool
+
+	// Issue triage over the window
+	IssuesOpened        int
+	IssuesClosed        int
+	IssueBacklog        int // currently open issues
+	MedianIssueResponse time.Duration
+	UnansweredIssues    int
+}
+
+// OrgIssueStats summarizes issue triage activity in an org over a window.
+// OpenedBy and ClosedBy are keyed by lowercased login.
+type OrgIssueStats struct {
+	Opened              int
+	Closed              int
+	Backlog             int
+	MedianFirstResponse time.Duration
+	Unanswered          int
+	OpenedBy            map[string]int
+	ClosedBy            map[string]int
 }
 
 // EngineerDetail holds the full drill-down data for a single engineer
@@ -269,6 +493,7 @@ type EngineerDetail struct {
 	DailyMerges      [7]int // indexed by time.Weekday (0=Sun, 1=Mon, ..., 6=Sat)
 	DailyReviews     [7]int
 	DailyComments    [7]int
+	ReviewTimes      []time.Time // submission time of each review in the window
 	AvgAdditions     int
 	AvgDeletions     int
 	AvgTimeToMerge   time.Duration
@@ -276,6 +501,11 @@ type EngineerDetail struct {
 	ReposContributed []string
 	CommentsGiven    int
 	CommentsReceived int
+
+	// EnrichDone and EnrichTotal track per-PR lookups while the detail is
+	// still being filled in.
+	EnrichDone  int
+	EnrichTotal int
 }
 
 // DetailedMergedPR contains a merged PR with diff stats and timing
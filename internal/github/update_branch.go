@@ -0,0 +1,120 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UpdatePullRequestBranch merges the base branch into a PR's head branch.
+// expectedHeadSHA guards against updating a branch that moved since it was
+// last seen; pass "" to skip the check. GitHub performs the update
+// asynchronously, so success only means it was accepted.
+func (c *PullRequestsService) UpdatePullRequestBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) error {
+	body := map[string]string{}
+	if expectedHeadSHA != "" {
+		body["expected_head_sha"] = expectedHeadSHA
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/update-branch", c.baseURL, owner, repo, number)
+	req, err := http.NewRequestWithContext(ctx, "PUT", u, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return nil
+	case http.StatusUnprocessableEntity:
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("update branch: %s", apiErr.Message)
+		}
+		return fmt.Errorf("update branch: status %d", resp.StatusCode)
+	default:
+		return fmt.Errorf("update branch: status %d", resp.StatusCode)
+	}
+}
+
+// CreateIssueComment posts a comment on an issue or pull request.
+func (c *PullRequestsService) CreateIssueComment(ctx context.Context, owner, repo string, number int, text string) error {
+	data, err := json.Marshal(map[string]string{"body": text})
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments", c.baseURL, owner, repo, number)
+	req, err := http.NewRequestWithContext(ctx, "POST", u, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("create comment: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SyncFork merges the upstream repository's branch into the same branch of
+// a fork and returns GitHub's description of what happened.
+func (c *ReposService) SyncFork(ctx context.Context, forkFullName, branch string) (string, error) {
+	data, err := json.Marshal(map[string]string{"branch": branch})
+	if err != nil {
+		return "", err
+	}
+
+	u := fmt.Sprintf("%s/repos/%s/merge-upstream", c.baseURL, forkFullName)
+	req, err := http.NewRequestWithContext(ctx, "POST", u, bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	c.setHeaders(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Message string `json:"message"`
+	}
+	decodeErr := json.NewDecoder(resp.Body).Decode(&result)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return result.Message, nil
+	case http.StatusConflict:
+		return "", fmt.Errorf("sync fork: %s has conflicts with upstream", branch)
+	default:
+		if decodeErr == nil && result.Message != "" {
+			return "", fmt.Errorf("sync fork: %s", result.Message)
+		}
+		return "", fmt.Errorf("sync fork: status %d", resp.StatusCode)
+	}
+}
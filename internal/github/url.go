@@ -1,18 +1,158 @@
 package github
 
 import (
+	"net/url"
+	"strconv"
 	"strings"
 )
 
-// ConvertAPIURLToWeb converts a GitHub API URL to a web URL
-// Example: https://api.github.com/repos/owner/repo/issues/123
-//       -> https://github.com/owner/repo/issues/123
+// ConvertAPIURLToWeb converts a GitHub API URL to the web page showing the
+// same thing, e.g. https://api.github.com/repos/owner/repo/pulls/123 to
+// https://github.com/owner/repo/pull/123. Resources without a page of their
+// own map to the closest one: a release by ID to the repo's releases, a
+// check suite to its Actions tab, a comment to its repo. URLs that aren't
+// API URLs are returned unchanged.
 func ConvertAPIURLToWeb(apiURL string) string {
-	// Replace api.github.com/repos/ with github.com/
-	webURL := strings.Replace(apiURL, "https://api.github.com/repos/", "https://github.com/", 1)
+	host, rest, ok := splitAPIURL(apiURL)
+	if !ok {
+		return apiURL
+	}
+	rest, _, _ = strings.Cut(rest, "?")
+	parts := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(parts) < 2 {
+		return apiURL
+	}
+	repo := host + "/" + parts[0] + "/" + parts[1]
+	path := parts[2:]
 
-	// Handle pulls -> pull (GitHub uses 'pull' in web URLs, 'pulls' in API)
-	webURL = strings.Replace(webURL, "/pulls/", "/pull/", 1)
+	at := func(i int) string {
+		if i < len(path) {
+			return path[i]
+		}
+		return ""
+	}
+	switch at(0) {
+	case "":
+		return repo
+	case "pulls":
+		if _, err := strconv.Atoi(at(1)); err == nil {
+			return repo + "/pull/" + at(1)
+		}
+		return repo + "/pulls"
+	case "issues":
+		if _, err := strconv.Atoi(at(1)); err == nil {
+			return repo + "/issues/" + at(1)
+		}
+		return repo + "/issues"
+	case "commits":
+		if at(1) != "" {
+			return repo + "/commit/" + at(1)
+		}
+		return repo + "/commits"
+	case "git":
+		if at(1) == "commits" && at(2) != "" {
+			return repo + "/commit/" + at(2)
+		}
+	case "compare":
+		if at(1) != "" {
+			return repo + "/compare/" + strings.Join(path[1:], "/")
+		}
+	case "releases":
+		if at(1) == "tags" && at(2) != "" {
+			return repo + "/releases/tag/" + strings.Join(path[2:], "/")
+		}
+		// Releases by ID have no page; the tag is needed for that
+		return repo + "/releases"
+	case "discussions":
+		if at(1) != "" {
+			return repo + "/discussions/" + at(1)
+		}
+		return repo + "/discussions"
+	case "check-runs":
+		if at(1) != "" {
+			return repo + "/runs/" + at(1)
+		}
+	case "check-suites":
+		return repo + "/actions"
+	case "actions":
+		if at(1) == "runs" && at(2) != "" {
+			return repo + "/actions/runs/" + at(2)
+		}
+		return repo + "/actions"
+	case "security-advisories":
+		if at(1) != "" {
+			return repo + "/security/advisories/" + at(1)
+		}
+		return repo + "/security/advisories"
+	case "dependabot", "code-scanning", "secret-scanning":
+		if at(1) == "alerts" && at(2) != "" {
+			return repo + "/security/" + at(0) + "/" + at(2)
+		}
+		return repo + "/security"
+	case "branches":
+		if at(1) != "" {
+			return repo + "/tree/" + strings.Join(path[1:], "/")
+		}
+		return repo + "/branches"
+	}
+	return repo
+}
+
+// splitAPIURL splits a repos API URL into the web host it belongs to and
+// the path after /repos/.
+func splitAPIURL(apiURL string) (host, rest string, ok bool) {
+	if rest, found := strings.CutPrefix(apiURL, "https://api.github.com/repos/"); found {
+		return "https://github.com", rest, true
+	}
+	// GitHub Enterprise Server: https://HOST/api/v3/repos/...
+	host, rest, found := strings.Cut(apiURL, "/api/v3/repos/")
+	if !found {
+		return "", "", false
+	}
+	return host, rest, true
+}
+
+// NotificationWebURL returns the web URL of a notification's subject.
+// Subjects without an API URL, such as check suites and some discussions,
+// link to the matching page of their repo.
+func NotificationWebURL(n *Notification) string {
+	if n.Subject.URL != "" {
+		return ConvertAPIURLToWeb(n.Subject.URL)
+	}
+	repo := n.Repository.HTMLURL
+	if repo == "" {
+		repo = "https://github.com/" + n.Repository.FullName
+	}
+	switch n.Subject.Type {
+	case "CheckSuite":
+		return repo + "/actions"
+	case "Discussion":
+		return repo + "/discussions?discussions_q=" + url.QueryEscape(n.Subject.Title)
+	case "RepositoryVulnerabilityAlert", "RepositoryDependabotAlertsThread":
+		return repo + "/security/dependabot"
+	}
+	return repo
+}
 
-	return webURL
+// ParseSubjectURL extracts the owner, repo and issue or pull request number
+// from a notification subject API URL such as
+// https://api.github.com/repos/owner/repo/pulls/123.
+func ParseSubjectURL(apiURL string) (owner, repo string, number int, ok bool) {
+	rest, found := strings.CutPrefix(apiURL, "https://api.github.com/repos/")
+	if !found {
+		// GitHub Enterprise Server: https://HOST/api/v3/repos/...
+		_, rest, found = strings.Cut(apiURL, "/api/v3/repos/")
+	}
+	if !found {
+		return "", "", 0, false
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) < 4 || (parts[2] != "issues" && parts[2] != "pulls") {
+		return "", "", 0, false
+	}
+	n, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return "", "", 0, false
+	}
+	return parts[0], parts[1], n, true
 }
@@ -0,0 +1,114 @@
+package github
+
+import "testing"
+
+func TestConvertAPIURLToWeb(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiURL string
+		want   string
+	}{
+		{"pull", "https://api.github.com/repos/o/r/pulls/123", "https://github.com/o/r/pull/123"},
+		{"pulls list", "https://api.github.com/repos/o/r/pulls", "https://github.com/o/r/pulls"},
+		{"issue", "https://api.github.com/repos/o/r/issues/7", "https://github.com/o/r/issues/7"},
+		{"issue comment", "https://api.github.com/repos/o/r/issues/comments/99", "https://github.com/o/r/issues"},
+		{"commit", "https://api.github.com/repos/o/r/commits/abc123", "https://github.com/o/r/commit/abc123"},
+		{"git commit", "https://api.github.com/repos/o/r/git/commits/abc123", "https://github.com/o/r/commit/abc123"},
+		{"release by tag", "https://api.github.com/repos/o/r/releases/tags/v1.2.0", "https://github.com/o/r/releases/tag/v1.2.0"},
+		{"release by id", "https://api.github.com/repos/o/r/releases/4567", "https://github.com/o/r/releases"},
+		{"discussion", "https://api.github.com/repos/o/r/discussions/12", "https://github.com/o/r/discussions/12"},
+		{"check suite", "https://api.github.com/repos/o/r/check-suites/888", "https://github.com/o/r/actions"},
+		{"check run", "https://api.github.com/repos/o/r/check-runs/42", "https://github.com/o/r/runs/42"},
+		{"actions run", "https://api.github.com/repos/o/r/actions/runs/5", "https://github.com/o/r/actions/runs/5"},
+		{"repo", "https://api.github.com/repos/o/r", "https://github.com/o/r"},
+		{"query stripped", "https://api.github.com/repos/o/r/pulls/1?foo=bar", "https://github.com/o/r/pull/1"},
+		{"ghe pull", "https://ghe.example.com/api/v3/repos/o/r/pulls/5", "https://ghe.example.com/o/r/pull/5"},
+		{"ghe check suite", "https://ghe.example.com/api/v3/repos/o/r/check-suites/1", "https://ghe.example.com/o/r/actions"},
+		{"web url unchanged", "https://github.com/o/r/pull/1", "https://github.com/o/r/pull/1"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertAPIURLToWeb(tt.apiURL); got != tt.want {
+				t.Errorf("ConvertAPIURLToWeb(%q) = %q, want %q", tt.apiURL, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationWebURL(t *testing.T) {
+	repo := Repository{FullName: "o/r", HTMLURL: "https://github.com/o/r"}
+	tests := []struct {
+		name string
+		n    Notification
+		want string
+	}{
+		{
+			name: "pull request",
+			n:    Notification{Subject: Subject{Type: "PullRequest", URL: "https://api.github.com/repos/o/r/pulls/3"}, Repository: repo},
+			want: "https://github.com/o/r/pull/3",
+		},
+		{
+			name: "check suite without url",
+			n:    Notification{Subject: Subject{Type: "CheckSuite", Title: "CI failed"}, Repository: repo},
+			want: "https://github.com/o/r/actions",
+		},
+		{
+			name: "check suite without repo html url",
+			n:    Notification{Subject: Subject{Type: "CheckSuite"}, Repository: Repository{FullName: "o/r"}},
+			want: "https://github.com/o/r/actions",
+		},
+		{
+			name: "ghe check suite without url",
+			n:    Notification{Subject: Subject{Type: "CheckSuite"}, Repository: Repository{FullName: "o/r", HTMLURL: "https://ghe.example.com/o/r"}},
+			want: "https://ghe.example.com/o/r/actions",
+		},
+		{
+			name: "discussion without url",
+			n:    Notification{Subject: Subject{Type: "Discussion", Title: "Q & A"}, Repository: repo},
+			want: "https://github.com/o/r/discussions?discussions_q=Q+%26+A",
+		},
+		{
+			name: "dependabot alert",
+			n:    Notification{Subject: Subject{Type: "RepositoryDependabotAlertsThread"}, Repository: repo},
+			want: "https://github.com/o/r/security/dependabot",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NotificationWebURL(&tt.n); got != tt.want {
+				t.Errorf("NotificationWebURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSubjectURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiURL     string
+		wantOwner  string
+		wantRepo   string
+		wantNumber int
+		wantOK     bool
+	}{
+		{"pull", "https://api.github.com/repos/o/r/pulls/123", "o", "r", 123, true},
+		{"issue", "https://api.github.com/repos/o/r/issues/9", "o", "r", 9, true},
+		{"ghe pull", "https://ghe.example.com/api/v3/repos/o/r/pulls/5", "o", "r", 5, true},
+		{"commit", "https://api.github.com/repos/o/r/commits/abc", "", "", 0, false},
+		{"release", "https://api.github.com/repos/o/r/releases/1", "", "", 0, false},
+		{"check suite", "https://api.github.com/repos/o/r/check-suites/1", "", "", 0, false},
+		{"not a number", "https://api.github.com/repos/o/r/pulls/abc", "", "", 0, false},
+		{"web url", "https://github.com/o/r/pull/1", "", "", 0, false},
+		{"empty", "", "", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, repo, number, ok := ParseSubjectURL(tt.apiURL)
+			if owner != tt.wantOwner || repo != tt.wantRepo || number != tt.wantNumber || ok != tt.wantOK {
+				t.Errorf("ParseSubjectURL(%q) = %q, %q, %d, %v; want %q, %q, %d, %v",
+					tt.apiURL, owner, repo, number, ok, tt.wantOwner, tt.wantRepo, tt.wantNumber, tt.wantOK)
+			}
+		})
+	}
+}
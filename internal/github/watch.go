@@ -0,0 +1,38 @@
+package github
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// prRefPattern matches "owner/repo#123" and github.com PR URLs.
+var prRefPattern = regexp.MustCompile(`^(?:https?://[^/]+/)?([\w.-]+)/([\w.-]+)(?:#|/pull/)(\d+)/?$`)
+
+// ParsePRRef parses a PR reference, either "owner/repo#123" (a PRKey) or a
+// PR's web URL.
+func ParsePRRef(ref string) (owner, repo string, number int, ok bool) {
+	m := prRefPattern.FindStringSubmatch(ref)
+	if m == nil {
+		return "", "", 0, false
+	}
+	number, err := strconv.Atoi(m[3])
+	if err != nil || number <= 0 {
+		return "", "", 0, false
+	}
+	return m[1], m[2], number, true
+}
+
+// SetWatchlist sets the PRs by others, as PRKeys, polled alongside the
+// user's own. Safe to call from any goroutine; changes apply from the next
+// poll.
+func (p *Poller) SetWatchlist(keys []string) {
+	p.watchMu.Lock()
+	defer p.watchMu.Unlock()
+	p.watched = append([]string(nil), keys...)
+}
+
+func (p *Poller) watchlist() []string {
+	p.watchMu.Lock()
+	defer p.watchMu.Unlock()
+	return p.watched
+}
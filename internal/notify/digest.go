@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// digestPreview is how many queued titles are listed in a digest.
+const digestPreview = 5
+
+// Digest queues desktop notifications held back during do-not-disturb so
+// they can be delivered as a single summary later. Safe for concurrent use.
+type Digest struct {
+	mu    sync.Mutex
+	items []string
+}
+
+// Add queues a notification.
+func (d *Digest) Add(title, body string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.items = append(d.items, title+": "+body)
+}
+
+// Len returns the number of queued notifications.
+func (d *Digest) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.items)
+}
+
+// Flush sends a single desktop notification summarizing everything queued
+// and empties the queue. It does nothing when the queue is empty.
+func (d *Digest) Flush(heading string) {
+	d.mu.Lock()
+	items := d.items
+	d.items = nil
+	d.mu.Unlock()
+
+	if len(items) == 0 {
+		return
+	}
+	preview := items[:min(len(items), digestPreview)]
+	body := strings.Join(preview, " · ")
+	if len(items) > len(preview) {
+		body += fmt.Sprintf(" · and %d more", len(items)-len(preview))
+	}
+	SendDesktopNotification(fmt.Sprintf("%s (%d)", heading, len(items)), body)
+}
@@ -3,13 +3,26 @@ package notify
 import (
 	"fmt"
 	"os"
+	"sync/atomic"
 )
 
+// desktopOff silences desktop notifications and spoken announcements.
+var desktopOff atomic.Bool
+
+// SetDesktopEnabled turns desktop notifications and spoken announcements on
+// or off, e.g. for --no-notify.
+func SetDesktopEnabled(on bool) {
+	desktopOff.Store(!on)
+}
+
 // SendDesktopNotification sends a desktop notification using OSC 777 escape sequences
 // Format: \033]777;notify;<title>;<body>\007
 // If running in tmux, wraps with tmux escape sequences:
 // \033Ptmux;\033\033]777;notify;<title>;<body>\007\033\\
 func SendDesktopNotification(title, body string) {
+	if desktopOff.Load() {
+		return
+	}
 	var escape string
 
 	// Check if we're inside tmux
@@ -0,0 +1,101 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Backend delivers a notification to an outbound chat service.
+type Backend interface {
+	Send(ctx context.Context, title, body string) error
+}
+
+var outboundClient = &http.Client{Timeout: 15 * time.Second}
+
+// postJSON sends payload as JSON with the given method and expects a 2xx reply.
+func postJSON(ctx context.Context, method, endpoint string, headers map[string]string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := outboundClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Discord posts to a Discord channel webhook.
+type Discord struct {
+	WebhookURL string
+}
+
+// Send implements Backend.
+func (d Discord) Send(ctx context.Context, title, body string) error {
+	if err := postJSON(ctx, http.MethodPost, d.WebhookURL, nil, map[string]string{
+		"content": fmt.Sprintf("**%s**\n%s", title, body),
+	}); err != nil {
+		return fmt.Errorf("discord: %w", err)
+	}
+	return nil
+}
+
+// Telegram sends a message through a Telegram bot.
+type Telegram struct {
+	BotToken string
+	ChatID   string
+}
+
+// Send implements Backend.
+func (t Telegram) Send(ctx context.Context, title, body string) error {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", t.BotToken)
+	if err := postJSON(ctx, http.MethodPost, endpoint, nil, map[string]any{
+		"chat_id":                  t.ChatID,
+		"text":                     title + "\n" + body,
+		"disable_web_page_preview": true,
+	}); err != nil {
+		return fmt.Errorf("telegram: %w", err)
+	}
+	return nil
+}
+
+// Matrix sends a text message to a room on a Matrix homeserver.
+type Matrix struct {
+	Homeserver  string // e.g. https://matrix.org
+	AccessToken string
+	RoomID      string
+}
+
+// Send implements Backend.
+func (m Matrix) Send(ctx context.Context, title, body string) error {
+	txnID := strconv.FormatInt(time.Now().UnixNano(), 10)
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		m.Homeserver, url.PathEscape(m.RoomID), txnID)
+	if err := postJSON(ctx, http.MethodPut, endpoint, map[string]string{
+		"Authorization": "Bearer " + m.AccessToken,
+	}, map[string]string{
+		"msgtype": "m.text",
+		"body":    title + "\n" + body,
+	}); err != nil {
+		return fmt.Errorf("matrix: %w", err)
+	}
+	return nil
+}
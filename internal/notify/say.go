@@ -4,6 +4,9 @@ import "os/exec"
 
 // Say uses the macOS say command to speak the given text aloud.
 func Say(text string) {
+	if desktopOff.Load() {
+		return
+	}
 	cmd := exec.Command("say", text)
 	_ = cmd.Start()
 }
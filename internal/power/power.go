@@ -0,0 +1,99 @@
+package power
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// State describes the machine's current power source and network cost.
+type State struct {
+	// OnBattery is true when the machine is running from a discharging battery.
+	OnBattery bool
+	// BatteryPercent is the remaining charge, or -1 when unknown.
+	BatteryPercent int
+	// Metered is true when the active network connection is marked as metered.
+	Metered bool
+}
+
+// Detect inspects the power supply and network connection. Detection is best
+// effort: anything that cannot be determined is reported as "not on battery"
+// and "not metered".
+func Detect(ctx context.Context) State {
+	s := State{BatteryPercent: -1}
+
+	switch runtime.GOOS {
+	case "linux":
+		s.OnBattery, s.BatteryPercent = linuxBattery()
+		s.Metered = linuxMetered(ctx)
+	case "darwin":
+		s.OnBattery, s.BatteryPercent = darwinBattery(ctx)
+	}
+
+	return s
+}
+
+// linuxBattery reads battery state from /sys/class/power_supply.
+func linuxBattery() (bool, int) {
+	supplies, err := filepath.Glob("/sys/class/power_supply/*")
+	if err != nil {
+		return false, -1
+	}
+
+	for _, dir := range supplies {
+		if readTrimmed(filepath.Join(dir, "type")) != "Battery" {
+			continue
+		}
+		percent := -1
+		if n, err := strconv.Atoi(readTrimmed(filepath.Join(dir, "capacity"))); err == nil {
+			percent = n
+		}
+		return readTrimmed(filepath.Join(dir, "status")) == "Discharging", percent
+	}
+	return false, -1
+}
+
+// linuxMetered asks NetworkManager whether any active device is metered.
+func linuxMetered(ctx context.Context) bool {
+	out, err := exec.CommandContext(ctx, "nmcli", "-t", "-f", "GENERAL.METERED", "device", "show").Output()
+	if err != nil {
+		return false
+	}
+	for line := range strings.SplitSeq(string(out), "\n") {
+		// e.g. "GENERAL.METERED:yes (guessed)"
+		_, value, ok := strings.Cut(line, ":")
+		if ok && strings.HasPrefix(value, "yes") {
+			return true
+		}
+	}
+	return false
+}
+
+var pmsetPercent = regexp.MustCompile(`(\d+)%`)
+
+// darwinBattery parses the output of `pmset -g batt`.
+func darwinBattery(ctx context.Context) (bool, int) {
+	out, err := exec.CommandContext(ctx, "pmset", "-g", "batt").Output()
+	if err != nil {
+		return false, -1
+	}
+	text := string(out)
+	percent := -1
+	if m := pmsetPercent.FindStringSubmatch(text); m != nil {
+		percent, _ = strconv.Atoi(m[1])
+	}
+	return strings.Contains(text, "'Battery Power'"), percent
+}
+
+func readTrimmed(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
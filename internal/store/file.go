@@ -0,0 +1,87 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// fileStore keeps each key in its own JSON file in a directory.
+type fileStore struct {
+	dir string
+}
+
+func openFile(dir string) (*fileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", fmt.Errorf("invalid store key %q", key)
+	}
+	return filepath.Join(s.dir, key+".json"), nil
+}
+
+func (s *fileStore) Get(key string, v any) (bool, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("parse %s: %w", p, err)
+	}
+	return true, nil
+}
+
+// Put writes the value to a temporary file and renames it into place so a
+// crash never leaves a truncated file behind.
+func (s *fileStore) Put(key string, v any) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(s.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
+
+func (s *fileStore) Delete(key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) Close() error {
+	return nil
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// schemaKey holds the number of migrations applied to a store.
+const schemaKey = "schema_version"
+
+// migration brings a store from one schema version to the next. dir is
+// hubell's config directory, for migrations that import older files.
+type migration struct {
+	name string
+	up   func(s Store, dir string) error
+}
+
+// migrations are applied in order; a store at version n has had the first n
+// applied. Only ever append to this list.
+var migrations = []migration{
+	{"import theme, org cache and weekly stats files", importLegacyFiles},
+	{"remove imported legacy files", removeImportedFiles},
+}
+
+// legacyFiles are the flat files hubell used before the store, by the key
+// they were imported into.
+var legacyFiles = map[string]string{
+	KeyTheme:       "theme",
+	KeyOrgCache:    "org_cache.json",
+	KeyWeeklyStats: "weekly_stats.json",
+}
+
+// migrate applies the migrations a store hasn't seen yet, recording the
+// version after each one so a failure resumes where it stopped.
+func migrate(s Store, dir string) error {
+	var version int
+	if _, err := s.Get(schemaKey, &version); err != nil {
+		return err
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("store schema version %d is newer than this hubell (%d)", version, len(migrations))
+	}
+	for i := version; i < len(migrations); i++ {
+		if err := migrations[i].up(s, dir); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", i+1, migrations[i].name, err)
+		}
+		if err := s.Put(schemaKey, i+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importLegacyFiles copies the flat files hubell used before the store into
+// it, removing each once imported.
+func importLegacyFiles(s Store, dir string) error {
+	for key, file := range legacyFiles {
+		p := filepath.Join(dir, file)
+		data, err := os.ReadFile(p)
+		if err != nil {
+			continue
+		}
+		var value any
+		if key == KeyTheme {
+			name := strings.TrimSpace(string(data))
+			if name == "" {
+				continue
+			}
+			value = name
+		} else {
+			if !json.Valid(data) {
+				// Corrupt caches are rebuilt on the next fetch
+				continue
+			}
+			value = json.RawMessage(data)
+		}
+		if err := s.Put(key, value); err != nil {
+			return err
+		}
+		if err := os.Remove(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeImportedFiles removes the legacy files that stores migrated before
+// importLegacyFiles removed them still left behind, once their value is in
+// the store.
+func removeImportedFiles(s Store, dir string) error {
+	for key, file := range legacyFiles {
+		var v json.RawMessage
+		found, err := s.Get(key, &v)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, file)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "modernc.org/sqlite" // registers the "sqlite" driver
+)
+
+// sqliteStore keeps every key as a row of a SQLite database.
+type sqliteStore struct {
+	db *sql.DB
+}
+
+func openSQLite(path string) (*sqliteStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	// Both the TUI and a daemon may have the database open
+	if _, err := db.Exec(`PRAGMA busy_timeout = 5000`); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS kv (
+		key        TEXT PRIMARY KEY,
+		value      BLOB NOT NULL,
+		updated_at INTEGER NOT NULL
+	)`); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("create %s: %w", path, err)
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+func (s *sqliteStore) Get(key string, v any) (bool, error) {
+	if !validKey(key) {
+		return false, fmt.Errorf("invalid store key %q", key)
+	}
+	var data []byte
+	err := s.db.QueryRow(`SELECT value FROM kv WHERE key = ?`, key).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("parse %s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (s *sqliteStore) Put(key string, v any) error {
+	if !validKey(key) {
+		return fmt.Errorf("invalid store key %q", key)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`INSERT INTO kv (key, value, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT(key) DO UPDATE SET value = excluded.value, updated_at = excluded.updated_at`,
+		key, data, time.Now().Unix())
+	return err
+}
+
+func (s *sqliteStore) Delete(key string) error {
+	_, err := s.db.Exec(`DELETE FROM kv WHERE key = ?`, key)
+	return err
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,107 @@
+// Package store persists hubell's state (preferences, caches and session
+// data) behind one interface, kept either as JSON files or in a SQLite
+// database.
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Keys of the values hubell keeps in the store.
+const (
+	KeyTheme       = "theme"
+	KeyOrgCache    = "org_cache"
+	KeyWeeklyStats = "weekly_stats"
+	KeyAttention   = "attention"
+	KeyWatchlist   = "watchlist"
+	KeyCIHistory   = "ci_history"
+	KeyFlakyChecks = "flaky_checks"
+	KeyArchive     = "archive"
+)
+
+// Backend names accepted by Open and the HUBELL_STORE environment variable.
+const (
+	BackendFile   = "file"
+	BackendSQLite = "sqlite"
+)
+
+// Store is a key-value store of JSON-encoded values.
+type Store interface {
+	// Get decodes the value stored under key into v. Returns false with no
+	// error if nothing is stored under key.
+	Get(key string, v any) (bool, error)
+	// Put stores v under key, replacing any previous value.
+	Put(key string, v any) error
+	// Delete removes key. Deleting a missing key is not an error.
+	Delete(key string) error
+	// Close releases the store's resources.
+	Close() error
+}
+
+// Dir returns hubell's config directory, which holds the store.
+func Dir() string {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configDir, "hubell")
+}
+
+// Open opens the store of the given backend in dir and brings its schema up
+// to date. An empty backend means BackendFile.
+func Open(dir, backend string) (Store, error) {
+	var (
+		s   Store
+		err error
+	)
+	switch backend {
+	case "", BackendFile:
+		s, err = openFile(filepath.Join(dir, "state"))
+	case BackendSQLite:
+		s, err = openSQLite(filepath.Join(dir, "state.db"))
+	default:
+		return nil, fmt.Errorf("unknown store backend %q", backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := migrate(s, dir); err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+var (
+	defaultOnce  sync.Once
+	defaultStore Store
+	defaultErr   error
+)
+
+// Default returns the store in Dir(), opened once per process with the
+// backend named by HUBELL_STORE (file unless set).
+func Default() (Store, error) {
+	defaultOnce.Do(func() {
+		dir := Dir()
+		if dir == "" {
+			defaultErr = fmt.Errorf("no config directory")
+			return
+		}
+		defaultStore, defaultErr = Open(dir, strings.TrimSpace(os.Getenv("HUBELL_STORE")))
+	})
+	return defaultStore, defaultErr
+}
+
+// validKey reports whether key is usable by every backend: non-empty and
+// free of path separators.
+func validKey(key string) bool {
+	return key != "" && !strings.ContainsAny(key, `/\`) && key != "." && key != ".."
+}
@@ -0,0 +1,170 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var backends = []string{BackendFile, BackendSQLite}
+
+func openTest(t *testing.T, dir, backend string) Store {
+	t.Helper()
+	s, err := Open(dir, backend)
+	if err != nil {
+		t.Fatalf("Open(%q): %v", backend, err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestRoundTrip(t *testing.T) {
+	type value struct {
+		Name  string
+		Count int
+	}
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTest(t, t.TempDir(), backend)
+
+			var got value
+			found, err := s.Get("missing", &got)
+			if err != nil || found {
+				t.Fatalf("Get(missing) = %v, %v; want false, nil", found, err)
+			}
+
+			want := value{Name: "hubell", Count: 3}
+			if err := s.Put("key", want); err != nil {
+				t.Fatalf("Put: %v", err)
+			}
+			if found, err := s.Get("key", &got); err != nil || !found || got != want {
+				t.Fatalf("Get = %+v, %v, %v; want %+v, true, nil", got, found, err, want)
+			}
+
+			want.Count = 4
+			if err := s.Put("key", want); err != nil {
+				t.Fatalf("Put again: %v", err)
+			}
+			if _, err := s.Get("key", &got); err != nil || got != want {
+				t.Fatalf("Get after overwrite = %+v, %v; want %+v", got, err, want)
+			}
+
+			if err := s.Delete("key"); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if found, err := s.Get("key", &got); err != nil || found {
+				t.Fatalf("Get after Delete = %v, %v; want false, nil", found, err)
+			}
+			if err := s.Delete("key"); err != nil {
+				t.Fatalf("Delete of a missing key: %v", err)
+			}
+
+			if err := s.Put("../escape", want); err == nil {
+				t.Fatal("Put with a path separator in the key succeeded")
+			}
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			dir := t.TempDir()
+			s := openTest(t, dir, backend)
+
+			var version int
+			if _, err := s.Get(schemaKey, &version); err != nil || version != len(migrations) {
+				t.Fatalf("schema version = %d, %v; want %d", version, err, len(migrations))
+			}
+
+			// Running again applies nothing and keeps the version
+			if err := s.Put(KeyTheme, "dark"); err != nil {
+				t.Fatal(err)
+			}
+			if err := migrate(s, dir); err != nil {
+				t.Fatalf("second migrate: %v", err)
+			}
+			if _, err := s.Get(schemaKey, &version); err != nil || version != len(migrations) {
+				t.Fatalf("schema version after second migrate = %d, %v; want %d", version, err, len(migrations))
+			}
+			var theme string
+			if _, err := s.Get(KeyTheme, &theme); err != nil || theme != "dark" {
+				t.Fatalf("theme after second migrate = %q, %v; want dark", theme, err)
+			}
+
+			if err := s.Put(schemaKey, len(migrations)+1); err != nil {
+				t.Fatal(err)
+			}
+			if err := migrate(s, dir); err == nil {
+				t.Fatal("migrate accepted a newer schema version")
+			}
+		})
+	}
+}
+
+func TestImportLegacyFiles(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string]string{
+				"theme":             "solarized\n",
+				"org_cache.json":    `{"acme":{"members":[]}}`,
+				"weekly_stats.json": "not json",
+			}
+			for name, data := range files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			s := openTest(t, dir, backend)
+
+			var theme string
+			if found, err := s.Get(KeyTheme, &theme); err != nil || !found || theme != "solarized" {
+				t.Fatalf("theme = %q, %v, %v; want solarized", theme, found, err)
+			}
+			var cache map[string]any
+			if found, err := s.Get(KeyOrgCache, &cache); err != nil || !found || cache["acme"] == nil {
+				t.Fatalf("org cache = %v, %v, %v; want the acme entry", cache, found, err)
+			}
+			var stats any
+			if found, err := s.Get(KeyWeeklyStats, &stats); err != nil || found {
+				t.Fatalf("corrupt weekly stats imported: %v, %v", found, err)
+			}
+
+			for _, name := range []string{"theme", "org_cache.json"} {
+				if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+					t.Errorf("%s not removed after import: %v", name, err)
+				}
+			}
+			if _, err := os.Stat(filepath.Join(dir, "weekly_stats.json")); err != nil {
+				t.Errorf("corrupt weekly_stats.json removed: %v", err)
+			}
+		})
+	}
+}
+
+func TestRemoveImportedFiles(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend, func(t *testing.T) {
+			dir := t.TempDir()
+			s := openTest(t, dir, backend)
+
+			// A file left behind by a store migrated before imports removed
+			// their files
+			if err := s.Put(KeyTheme, "dark"); err != nil {
+				t.Fatal(err)
+			}
+			p := filepath.Join(dir, "theme")
+			if err := os.WriteFile(p, []byte("dark\n"), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := removeImportedFiles(s, dir); err != nil {
+				t.Fatalf("removeImportedFiles: %v", err)
+			}
+			if _, err := os.Stat(p); !os.IsNotExist(err) {
+				t.Errorf("theme not removed: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package tickets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Tracker kinds that support fetching a ticket's status.
+const (
+	KindJira   = "jira"
+	KindLinear = "linear"
+)
+
+// Tracker describes an external ticket system that PRs can reference.
+type Tracker struct {
+	// Name is shown next to ticket keys, e.g. "Jira".
+	Name string
+	// Kind is KindJira, KindLinear, or empty for link-only trackers.
+	Kind string
+	// Pattern matches ticket keys. If it has a capture group, the first
+	// group is the key; otherwise the whole match is.
+	Pattern *regexp.Regexp
+	// LinkTemplate is the ticket's web URL with "{key}" as placeholder.
+	LinkTemplate string
+	// APIURL is the Jira site root (e.g. https://acme.atlassian.net).
+	// Unused for Linear, which has a single API endpoint.
+	APIURL string
+	// Email and Token authenticate status requests. Jira uses basic auth with
+	// both; Linear uses Token as its API key.
+	Email string
+	Token string
+}
+
+// Ticket is a ticket reference found in a PR.
+type Ticket struct {
+	Key     string
+	URL     string
+	Tracker int // index into the trackers it was found with
+}
+
+// Find returns the distinct ticket keys referenced in texts, in order of
+// first appearance.
+func Find(trackers []Tracker, texts ...string) []Ticket {
+	var found []Ticket
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for ti, t := range trackers {
+			for _, match := range t.Pattern.FindAllStringSubmatch(text, -1) {
+				key := match[0]
+				if len(match) > 1 && match[1] != "" {
+					key = match[1]
+				}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				found = append(found, Ticket{Key: key, URL: t.Link(key), Tracker: ti})
+			}
+		}
+	}
+	return found
+}
+
+// Link returns the web URL for a ticket key.
+func (t Tracker) Link(key string) string {
+	return strings.ReplaceAll(t.LinkTemplate, "{key}", url.PathEscape(key))
+}
+
+// CanFetchStatus reports whether the tracker is configured for status lookups.
+func (t Tracker) CanFetchStatus() bool {
+	switch t.Kind {
+	case KindJira:
+		return t.APIURL != "" && t.Token != ""
+	case KindLinear:
+		return t.Token != ""
+	}
+	return false
+}
+
+var httpClient = &http.Client{Timeout: 15 * time.Second}
+
+// linearEndpoint is Linear's GraphQL API.
+const linearEndpoint = "https://api.linear.app/graphql"
+
+// FetchStatus returns the workflow status name of a ticket, e.g. "In Progress".
+func (t Tracker) FetchStatus(ctx context.Context, key string) (string, error) {
+	switch t.Kind {
+	case KindJira:
+		return t.fetchJiraStatus(ctx, key)
+	case KindLinear:
+		return t.fetchLinearStatus(ctx, key)
+	}
+	return "", fmt.Errorf("%s: status lookups not supported", t.Name)
+}
+
+func (t Tracker) fetchJiraStatus(ctx context.Context, key string) (string, error) {
+	endpoint := strings.TrimRight(t.APIURL, "/") + "/rest/api/2/issue/" + url.PathEscape(key) + "?fields=status"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	if t.Email != "" {
+		req.SetBasicAuth(t.Email, t.Token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+t.Token)
+	}
+
+	var result struct {
+		Fields struct {
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+	if err := doJSON(req, &result); err != nil {
+		return "", fmt.Errorf("jira %s: %w", key, err)
+	}
+	return result.Fields.Status.Name, nil
+}
+
+func (t Tracker) fetchLinearStatus(ctx context.Context, key string) (string, error) {
+	payload, err := json.Marshal(map[string]any{
+		"query":     `query($id: String!) { issue(id: $id) { state { name } } }`,
+		"variables": map[string]string{"id": key},
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, linearEndpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", t.Token)
+
+	var result struct {
+		Data struct {
+			Issue *struct {
+				State struct {
+					Name string `json:"name"`
+				} `json:"state"`
+			} `json:"issue"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := doJSON(req, &result); err != nil {
+		return "", fmt.Errorf("linear %s: %w", key, err)
+	}
+	if len(result.Errors) > 0 {
+		return "", fmt.Errorf("linear %s: %s", key, result.Errors[0].Message)
+	}
+	if result.Data.Issue == nil {
+		return "", fmt.Errorf("linear %s: not found", key)
+	}
+	return result.Data.Issue.State.Name, nil
+}
+
+// doJSON performs req and decodes a 2xx JSON response into v.
+func doJSON(req *http.Request, v any) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,182 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// Account is a GitHub account the TUI can switch between.
+type Account struct {
+	// Name labels the account in the status line.
+	Name     string
+	Client   *github.Client
+	Username string
+	// MissingScopes are the token scopes the account lacks, warned about
+	// while it is active.
+	MissingScopes []github.ScopeRequirement
+	// Err is why the account's user couldn't be looked up at startup.
+	// Username stays empty until switching to the account looks it up.
+	Err error
+}
+
+// accountResolvedMsg reports the retried user lookup of an account that
+// couldn't be signed in at startup.
+type accountResolvedMsg struct {
+	account int
+	user    *github.User
+	err     error
+}
+
+// accountEventMsg wraps a message read from an account's poller, so one
+// still in flight when the account is switched can be dropped.
+type accountEventMsg struct {
+	account int
+	msg     tea.Msg
+}
+
+// waitForEvent waits for the next event from the active account's poller.
+func (m *Model) waitForEvent() tea.Cmd {
+	events, account := m.events, m.account
+	return func() tea.Msg {
+		return accountEventMsg{account: account, msg: waitForEvent(events)()}
+	}
+}
+
+// switchAccount switches to the next account. One whose user couldn't be
+// looked up at startup is looked up again first, and skipped with its
+// error shown when that fails again.
+func (m *Model) switchAccount() tea.Cmd {
+	if len(m.accounts) < 2 || m.startPoller == nil || m.accountResolving {
+		return nil
+	}
+	next := (m.account + 1) % len(m.accounts)
+	if a := m.accounts[next]; a.Username == "" {
+		m.accountResolving = true
+		m.notice = "signing in to " + a.Name + "…"
+		m.noticeLink = ""
+		return resolveAccount(m.ctx, a.Client, next)
+	}
+	return m.activateAccount(next)
+}
+
+// unavailableAccountsNotice names the accounts that couldn't be signed in at
+// startup, or returns "" when all were.
+func unavailableAccountsNotice(accounts []Account) string {
+	var failed []string
+	for _, a := range accounts {
+		if a.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%v)", a.Name, a.Err))
+		}
+	}
+	if len(failed) == 0 {
+		return ""
+	}
+	return "accounts unavailable, retried on switch: " + strings.Join(failed, ", ")
+}
+
+// resolveAccount retries looking up an account's user.
+func resolveAccount(ctx context.Context, client *github.Client, account int) tea.Cmd {
+	return func() tea.Msg {
+		user, err := client.GetAuthenticatedUser(ctx)
+		return accountResolvedMsg{account: account, user: user, err: err}
+	}
+}
+
+// handleAccountResolved switches to an account once its user was looked up,
+// or stays on the active account and shows why it is unavailable.
+func (m *Model) handleAccountResolved(msg accountResolvedMsg) tea.Cmd {
+	m.accountResolving = false
+	a := &m.accounts[msg.account]
+	if msg.err != nil {
+		a.Err = msg.err
+		m.notice = fmt.Sprintf("%s unavailable: %v", a.Name, msg.err)
+		m.noticeLink = ""
+		return nil
+	}
+	a.Err = nil
+	a.Username = msg.user.Login
+	a.MissingScopes = github.MissingScopes(msg.user.Scopes, m.orgName != "")
+	return m.activateAccount(msg.account)
+}
+
+// activateAccount stops the active account's poller and starts polling
+// account, clearing everything loaded for the previous one.
+func (m *Model) activateAccount(account int) tea.Cmd {
+	if m.stopPoller != nil {
+		m.stopPoller()
+	}
+	m.account = account
+	a := m.accounts[m.account]
+	m.githubClient = a.Client
+	m.username = a.Username
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.stopPoller = cancel
+	m.poller, m.events = m.startPoller(ctx, a)
+	m.poller.SetCIOptions(m.ciSettings.CIOptions())
+	m.poller.SetWatchlist(m.watchlist)
+	if m.lowPower {
+		m.poller.SetInterval(m.powerSettings.LowPowerPollInterval())
+	}
+
+	m.allNotifications = make(map[string]*github.Notification)
+	m.prStatuses = make(map[string]github.PRStatus)
+	m.prInfos = make(map[string]github.PRInfo)
+	m.commentDetails = make(map[string]*github.CommentDetail)
+	m.mentions = make(map[string]*github.Mention)
+	m.subjectDetails = make(map[string]*github.SubjectDetail)
+	m.assignedIssues = nil
+	m.secretAlerts = nil
+	m.failingMains = nil
+	m.archive = loadArchive(m.username)
+	m.readAt = make(map[string]time.Time)
+	m.sourceErrs = nil
+	m.fetchedAt = make(map[github.PollSource]time.Time)
+	m.showReauth, m.reauthDismissed, m.reauthChecking = false, false, false
+	m.branchUpdates = make(map[string]branchUpdate)
+	m.forkSyncs = make(map[string]forkSync)
+	m.autoMerges = make(map[string]autoMergeRequest)
+	m.prRefreshes = make(map[string]bool)
+	m.announcedReadyPRs = make(map[string]bool)
+	m.dashboardStats.ReviewTurnaround = nil
+	m.dashboardStats.TurnaroundFetchedAt = time.Time{}
+	m.reviewTurnaroundLoading = false
+	m.userOrgs, m.userOrgsErr, m.userOrgsLoading = nil, nil, false
+	m.firstPoll = true
+	m.updateNotifications(nil)
+	m.updatePRList()
+	m.updateTimelineList()
+
+	m.notice = "switched to " + a.Name + ", loading…"
+	m.noticeLink = ""
+	return m.waitForEvent()
+}
+
+// scopeWarning describes the active account's missing token scopes and
+// what they break, or returns "" when none are missing.
+func (m *Model) scopeWarning() string {
+	if len(m.accounts) == 0 || len(m.accounts[m.account].MissingScopes) == 0 {
+		return ""
+	}
+	var scopes, features []string
+	for _, r := range m.accounts[m.account].MissingScopes {
+		scopes = append(scopes, r.Scope)
+		features = append(features, r.Features)
+	}
+	return fmt.Sprintf("⚠ Token lacks scopes %s (degraded: %s). Update at https://github.com/settings/tokens",
+		strings.Join(scopes, ", "), strings.Join(features, "; "))
+}
+
+// accountLabel returns the active account's name when there is more than
+// one account, or "".
+func (m *Model) accountLabel() string {
+	if len(m.accounts) < 2 {
+		return ""
+	}
+	return "@" + m.accounts[m.account].Name
+}
@@ -0,0 +1,179 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// maxArchived is how many read or done notifications the archive keeps.
+const maxArchived = 200
+
+// archivedNotification is a notification marked read or done, kept across
+// sessions in the store.
+type archivedNotification struct {
+	notification *github.Notification
+	readAt       time.Time
+	done         bool
+}
+
+// archiveNotification moves a notification that was just marked read (or
+// done) into the archive, newest first.
+func (m *Model) archiveNotification(threadID string, done bool) {
+	n, ok := m.allNotifications[threadID]
+	if !ok {
+		return
+	}
+	delete(m.allNotifications, threadID)
+	m.archive = append([]archivedNotification{{notification: n, readAt: time.Now(), done: done}}, m.archive...)
+	if len(m.archive) > maxArchived {
+		m.archive = m.archive[:maxArchived]
+	}
+	m.saveArchive()
+}
+
+// loadArchive reads username's archive from the store.
+func loadArchive(username string) []archivedNotification {
+	var archive []archivedNotification
+	for _, a := range config.LoadArchive(username) {
+		n := a.Notification
+		archive = append(archive, archivedNotification{notification: &n, readAt: a.ReadAt, done: a.Done})
+	}
+	return archive
+}
+
+// saveArchive writes the active account's archive to the store.
+func (m *Model) saveArchive() {
+	saved := make([]config.ArchivedNotification, len(m.archive))
+	for i, a := range m.archive {
+		saved[i] = config.ArchivedNotification{Notification: *a.notification, ReadAt: a.readAt, Done: a.done}
+	}
+	_ = config.SaveArchive(m.username, saved)
+}
+
+// restoreArchived puts the selected archived notification back in the
+// inbox as unread. GitHub's API has no endpoint to mark a thread unread, so
+// it stays read on github.com; hubell keeps it in the inbox for the rest of
+// the session.
+func (m *Model) restoreArchived() {
+	if m.archiveIndex >= len(m.archive) {
+		return
+	}
+	n := m.archive[m.archiveIndex].notification
+	m.archive = append(m.archive[:m.archiveIndex:m.archiveIndex], m.archive[m.archiveIndex+1:]...)
+	m.archiveIndex = min(m.archiveIndex, max(len(m.archive)-1, 0))
+	m.saveArchive()
+	n.Unread = true
+	m.allNotifications[n.ID] = n
+	// A restored notification isn't new activity: count it in the unread
+	// baseline so it isn't notified or counted as new
+	m.lastNotifyCount = unreadCount(m.applyFilter())
+	m.updateNotifications(nil)
+}
+
+// handleArchiveKey handles key events in the read notification archive.
+func (m *Model) handleArchiveKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, archiveKeys.Close):
+		m.showArchive = false
+		return m, nil
+
+	case key.Matches(msg, archiveKeys.Up):
+		if m.archiveIndex > 0 {
+			m.archiveIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, archiveKeys.Down):
+		if m.archiveIndex < len(m.archive)-1 {
+			m.archiveIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, archiveKeys.Open):
+		if m.archiveIndex < len(m.archive) {
+			n := m.archive[m.archiveIndex].notification
+			if err := browser.Open(m.notificationURL(n)); err != nil {
+				m.err = err
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, archiveKeys.MarkUnread):
+		m.restoreArchived()
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// renderArchive renders the archived notifications read or done, most
+// recently cleared first.
+func (m *Model) renderArchive() string {
+	maxWidth := max(min(90, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+
+	innerWidth := maxWidth - 6
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Read and done recently"))
+	b.WriteString("\n\n")
+
+	if len(m.archive) == 0 {
+		b.WriteString(subtleStyle.Render("  Nothing marked read or done yet."))
+		b.WriteString("\n")
+	}
+
+	// Each notification takes two lines: subject, then repo, reason and when cleared
+	visibleRows := max((maxHeight-10)/2, 3)
+	scrollOffset := 0
+	if m.archiveIndex >= visibleRows {
+		scrollOffset = m.archiveIndex - visibleRows + 1
+	}
+	endIdx := min(scrollOffset+visibleRows, len(m.archive))
+	for i := scrollOffset; i < endIdx; i++ {
+		a := m.archive[i]
+		n := a.notification
+		cleared := "read"
+		if a.done {
+			cleared = "done"
+		}
+		line := truncateOrgLoadingText(fmt.Sprintf("%s: %s", n.Subject.Type, n.Subject.Title), innerWidth-2)
+		if i == m.archiveIndex {
+			b.WriteString(selectedStyle.Render("▸ " + line))
+		} else {
+			b.WriteString(normalStyle.Render("  " + line))
+		}
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render(truncateOrgLoadingText(fmt.Sprintf("    %s · %s · %s %s ago", n.Repository.FullName, formatReason(n.Reason), cleared, formatDuration(time.Since(a.readAt))), innerWidth)))
+		b.WriteString("\n")
+	}
+	if len(m.archive) > visibleRows {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(m.archive))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑↓: navigate  enter: open  u: back to inbox as unread  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jpoz/hubell/internal/github"
+)
+
+func TestRestoreArchivedDoesNotNotify(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	var sent int
+	send := sendDesktopNotification
+	sendDesktopNotification = func(title, body string) { sent++ }
+	t.Cleanup(func() { sendDesktopNotification = send })
+
+	m := New(context.Background(), nil, nil, nil, Options{Filter: "all", Username: "me"})
+	t.Cleanup(m.cancel)
+
+	notification := func(id string, unread bool) *github.Notification {
+		return &github.Notification{
+			ID:         id,
+			Unread:     unread,
+			Subject:    github.Subject{Title: "notification " + id, Type: "Issue"},
+			Repository: github.Repository{FullName: "o/r"},
+			UpdatedAt:  time.Now(),
+		}
+	}
+
+	m.updateNotifications([]*github.Notification{notification("1", true)})
+	if sent != 1 {
+		t.Fatalf("%d desktop notifications for a new notification, want 1", sent)
+	}
+	counted := len(m.dashboardStats.NotificationTimestamps)
+
+	m.archive = []archivedNotification{{notification: notification("2", false), readAt: time.Now()}}
+	m.archiveIndex = 0
+	m.restoreArchived()
+
+	if sent != 1 {
+		t.Errorf("restoring sent %d desktop notifications, want none", sent-1)
+	}
+	if got := len(m.dashboardStats.NotificationTimestamps); got != counted {
+		t.Errorf("restoring counted %d new notifications, want none", got-counted)
+	}
+	if n := m.notificationMap["2"]; n == nil || !n.Unread {
+		t.Errorf("restored notification = %+v, want it unread in the inbox", n)
+	}
+	if len(m.archive) != 0 {
+		t.Errorf("archive has %d items after restoring its only one", len(m.archive))
+	}
+
+	// Later activity is still notified against the new baseline
+	m.updateNotifications([]*github.Notification{notification("3", true)})
+	if sent != 2 {
+		t.Errorf("%d desktop notifications after new activity, want 2", sent)
+	}
+}
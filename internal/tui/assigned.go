@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/list"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// AssignedIssueItem implements list.Item for an open issue assigned to the user
+type AssignedIssueItem struct {
+	issue github.SearchItem
+}
+
+// FilterValue implements list.Item
+func (i AssignedIssueItem) FilterValue() string {
+	return i.issue.Title
+}
+
+// Title implements list.DefaultItem
+func (i AssignedIssueItem) Title() string {
+	return fmt.Sprintf("  [%s] %s", i.issue.RepoFullName(), i.issue.Title)
+}
+
+// Description implements list.DefaultItem
+func (i AssignedIssueItem) Description() string {
+	desc := fmt.Sprintf("#%d by @%s · updated %s", i.issue.Number, i.issue.User.Login, formatDuration(time.Since(i.issue.UpdatedAt)))
+	if i.issue.Comments > 0 {
+		desc += fmt.Sprintf(" · %d comments", i.issue.Comments)
+	}
+	if ms := i.issue.Milestone; ms != nil {
+		desc += " · ◷ " + milestoneLabel(ms)
+	}
+	if len(i.issue.Labels) > 0 {
+		desc += " · " + labelNames(i.issue.Labels)
+	}
+	return desc
+}
+
+// assignedIssueItems converts the assigned issues into list items, honoring
+// the releases-only repo filter the notification list also uses.
+func (m *Model) assignedIssueItems() []list.Item {
+	items := make([]list.Item, 0, len(m.assignedIssues))
+	for _, issue := range m.assignedIssues {
+		if m.releasesOnlyRepos[issue.RepoFullName()] || !m.matchesLabelFilter(issue.Labels) {
+			continue
+		}
+		items = append(items, AssignedIssueItem{issue: issue})
+	}
+	return items
+}
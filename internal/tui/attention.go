@@ -0,0 +1,186 @@
+package tui
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// attentionIdleCap bounds how long one resting selection is credited, so
+// walking away from the terminal doesn't count as review time.
+const attentionIdleCap = 5 * time.Minute
+
+// attentionTarget returns the repo ("owner/repo") of the focused pane's
+// selected item and, when it is a PR, its key; both are "" when there is
+// nothing to attribute.
+func (m *Model) attentionTarget() (repo, pr string) {
+	switch m.focusedPane {
+	case LeftPane:
+		switch item := m.list.SelectedItem().(type) {
+		case NotificationItem:
+			n := item.notification
+			if owner, name, number, ok := github.ParseSubjectURL(n.Subject.URL); ok && n.Subject.Type == "PullRequest" {
+				return owner + "/" + name, github.PRKey(owner, name, number)
+			}
+			return n.Repository.FullName, ""
+		case SecretAlertItem:
+			return item.alert.Owner + "/" + item.alert.Repo, ""
+		}
+	case RightPane:
+		if item, ok := m.prList.SelectedItem().(PRItem); ok {
+			return item.info.Owner + "/" + item.info.Repo, github.PRKey(item.info.Owner, item.info.Repo, item.info.Number)
+		}
+	case TimelinePane:
+		if item, ok := m.timelineList.SelectedItem().(TimelineEvent); ok {
+			return item.Owner + "/" + item.Repo, github.PRKey(item.Owner, item.Repo, item.Number)
+		}
+	}
+	return "", ""
+}
+
+// trackAttention credits the time since the last key press to the repo
+// that was selected during it, then starts timing the current selection.
+func (m *Model) trackAttention(now time.Time) {
+	if !m.attentionSettings.Enabled {
+		return
+	}
+	if m.attentionRepo != "" && !m.attentionSince.IsZero() {
+		rested := min(now.Sub(m.attentionSince), attentionIdleCap)
+		if secs := int(rested.Seconds()); secs > 0 {
+			m.attention.Week(m.attentionSince).Seconds[m.attentionRepo] += secs
+			m.attentionDirty = true
+		}
+	}
+	m.attentionRepo, _ = m.attentionTarget()
+	m.attentionSince = now
+}
+
+// recordOpen counts the selected PR as opened this week.
+func (m *Model) recordOpen() {
+	if !m.attentionSettings.Enabled {
+		return
+	}
+	if _, pr := m.attentionTarget(); pr != "" {
+		m.attention.Week(time.Now()).Opened[pr]++
+		m.attentionDirty = true
+	}
+}
+
+// saveAttention persists the attention stats when they have changed.
+func (m *Model) saveAttention() {
+	if !m.attentionDirty {
+		return
+	}
+	if err := config.SaveAttention(m.attention); err == nil {
+		m.attentionDirty = false
+	}
+}
+
+// repoAttention is one repo's share of a week's attention.
+type repoAttention struct {
+	repo   string
+	time   time.Duration
+	opened int
+}
+
+// weekAttention returns this week's attention per repo, most time first.
+func (m *Model) weekAttention(now time.Time) []repoAttention {
+	week := m.attention.Weeks[config.WeekKey(now)]
+	byRepo := make(map[string]*repoAttention)
+	get := func(repo string) *repoAttention {
+		r, ok := byRepo[repo]
+		if !ok {
+			r = &repoAttention{repo: repo}
+			byRepo[repo] = r
+		}
+		return r
+	}
+	for repo, secs := range week.Seconds {
+		get(repo).time += time.Duration(secs) * time.Second
+	}
+	for pr, n := range week.Opened {
+		repo, _, _ := strings.Cut(pr, "#")
+		get(repo).opened += n
+	}
+	repos := make([]repoAttention, 0, len(byRepo))
+	for _, k := range slices.Sorted(maps.Keys(byRepo)) {
+		repos = append(repos, *byRepo[k])
+	}
+	slices.SortStableFunc(repos, func(a, b repoAttention) int {
+		return int(b.time - a.time)
+	})
+	return repos
+}
+
+// renderAttentionReport draws the dashboard's weekly attention section:
+// the repos my selection rested on longest, with the PRs opened in each.
+func (m *Model) renderAttentionReport(width int) string {
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	barStyle := lipgloss.NewStyle().Foreground(m.theme.Accent)
+
+	var b strings.Builder
+	b.WriteString(accentStyle.Render("Attention This Week"))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(strings.Repeat("─", width)))
+	b.WriteString("\n")
+
+	repos := m.weekAttention(time.Now())
+	if len(repos) == 0 {
+		b.WriteString(subtleStyle.Render("  Nothing tracked yet this week"))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	const maxRepos = 8
+	var total time.Duration
+	for _, r := range repos {
+		total += r.time
+	}
+	top := repos[0].time
+	nameWidth := min(28, width/2)
+	barWidth := max(width-nameWidth-22, 4)
+	for _, r := range repos[:min(len(repos), maxRepos)] {
+		bar := 0
+		if top > 0 {
+			bar = int(int64(barWidth) * int64(r.time) / int64(top))
+		}
+		pct := 0
+		if total > 0 {
+			pct = int(100 * r.time / total)
+		}
+		name := truncateLeft(r.repo, nameWidth)
+		fmt.Fprintf(&b, "  %-*s %s%s %3d%% %s",
+			nameWidth, name,
+			barStyle.Render(strings.Repeat("█", bar)),
+			strings.Repeat(" ", barWidth-bar),
+			pct,
+			subtleStyle.Render(formatAttention(r.time)))
+		if r.opened > 0 {
+			fmt.Fprintf(&b, " %s", subtleStyle.Render(fmt.Sprintf("· %d opened", r.opened)))
+		}
+		b.WriteString("\n")
+	}
+	if len(repos) > maxRepos {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("  +%d more repos", len(repos)-maxRepos)))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// formatAttention formats tracked time compactly, e.g. "1h05m" or "12m".
+func formatAttention(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+	if d < time.Hour {
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+}
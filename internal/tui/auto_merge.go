@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// autoMergeRequest tracks arming or disarming auto-merge on a PR.
+type autoMergeRequest struct {
+	method string // "" when disarming
+	err    error
+}
+
+// autoMergeLabel returns the auto-merge status shown in the PR pane: the
+// request in flight or failed, else whether auto-merge is armed.
+func (m *Model) autoMergeLabel(key string) string {
+	if r, ok := m.autoMerges[key]; ok {
+		switch {
+		case r.err != nil:
+			return "✗ auto-merge failed"
+		case r.method != "":
+			return "⟳ arming auto-merge"
+		default:
+			return "⟳ disarming auto-merge"
+		}
+	}
+	if method := m.prInfos[key].AutoMergeMethod; method != "" {
+		return "⇉ auto-merge armed (" + strings.ToLower(method) + ")"
+	}
+	return ""
+}
+
+// toggleAutoMerge disarms auto-merge on a PR that has it armed, or opens
+// the merge method picker to arm it.
+func (m *Model) toggleAutoMerge(info github.PRInfo) tea.Cmd {
+	key := github.PRKey(info.Owner, info.Repo, info.Number)
+	if r, ok := m.autoMerges[key]; ok && r.err == nil {
+		return nil
+	}
+	if info.NodeID == "" {
+		m.notice = "PR details not loaded yet, try again after the next poll"
+		m.noticeLink = ""
+		return nil
+	}
+	if info.AutoMergeMethod != "" {
+		m.autoMerges[key] = autoMergeRequest{}
+		m.updatePRList()
+		return requestAutoMerge(m.ctx, m.githubClient, key, info.NodeID, "")
+	}
+	m.showAutoMerge = true
+	m.autoMergePR = info
+	m.autoMergeIndex = max(slices.Index(config.MergeMethods, m.autoMergeSettings.MethodFor(info.Owner, info.Repo)), 0)
+	return nil
+}
+
+// requestAutoMerge creates a command that arms auto-merge with method, or
+// disarms it when method is empty.
+func requestAutoMerge(ctx context.Context, client *github.Client, key, nodeID, method string) tea.Cmd {
+	return func() tea.Msg {
+		if method == "" {
+			return AutoMergeMsg{Key: key, Err: client.PullRequests.DisablePullRequestAutoMerge(ctx, nodeID)}
+		}
+		return AutoMergeMsg{Key: key, Method: method, Err: client.PullRequests.EnablePullRequestAutoMerge(ctx, nodeID, method)}
+	}
+}
+
+// handleAutoMerge records the outcome of an auto-merge request, updating
+// the PR right away rather than waiting for the next poll.
+func (m *Model) handleAutoMerge(msg AutoMergeMsg) {
+	if msg.Err != nil {
+		m.autoMerges[msg.Key] = autoMergeRequest{method: msg.Method, err: msg.Err}
+		m.err = fmt.Errorf("%s: %w", msg.Key, msg.Err)
+	} else {
+		delete(m.autoMerges, msg.Key)
+		if info, ok := m.prInfos[msg.Key]; ok {
+			info.AutoMergeMethod = msg.Method
+			m.prInfos[msg.Key] = info
+		}
+	}
+	m.updatePRList()
+}
+
+// pruneAutoMerges forgets failed requests once the next poll has the PR's
+// real state.
+func (m *Model) pruneAutoMerges() {
+	for key, r := range m.autoMerges {
+		if r.err != nil {
+			delete(m.autoMerges, key)
+		}
+	}
+}
+
+// handleAutoMergeKey handles key events in the merge method picker.
+func (m *Model) handleAutoMergeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, autoMergeKeys.Close):
+		m.showAutoMerge = false
+	case key.Matches(msg, autoMergeKeys.Up):
+		m.autoMergeIndex = max(m.autoMergeIndex-1, 0)
+	case key.Matches(msg, autoMergeKeys.Down):
+		m.autoMergeIndex = min(m.autoMergeIndex+1, len(config.MergeMethods)-1)
+	case key.Matches(msg, autoMergeKeys.Arm):
+		m.showAutoMerge = false
+		info := m.autoMergePR
+		key := github.PRKey(info.Owner, info.Repo, info.Number)
+		method := config.MergeMethods[m.autoMergeIndex]
+		m.autoMerges[key] = autoMergeRequest{method: method}
+		m.updatePRList()
+		return m, requestAutoMerge(m.ctx, m.githubClient, key, info.NodeID, method)
+	}
+	return m, nil
+}
+
+// renderAutoMerge renders the merge method picker.
+func (m *Model) renderAutoMerge() string {
+	maxWidth := max(min(56, m.width-4), 30)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+
+	info := m.autoMergePR
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Enable Auto-merge"))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(truncateOrgLoadingText(fmt.Sprintf("%s/%s#%d %s", info.Owner, info.Repo, info.Number, info.Title), maxWidth-6)))
+	b.WriteString("\n\n")
+	for i, method := range config.MergeMethods {
+		if i == m.autoMergeIndex {
+			b.WriteString(selectedStyle.Render("▸ " + method))
+		} else {
+			b.WriteString(normalStyle.Render("  " + method))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("merges once required checks and reviews pass"))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑↓: method  enter: enable  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,231 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// openChecks shows the checks and artifacts overlay for a PR and starts
+// loading the artifacts of its completed workflow runs.
+func (m *Model) openChecks(info github.PRInfo) tea.Cmd {
+	m.showChecks = true
+	m.checksInfo = info
+	m.checksArtifacts = nil
+	m.checksError = nil
+	m.checksIndex = 0
+	m.checksStatus = ""
+	if info.HeadSHA == "" {
+		m.checksLoading = false
+		return nil
+	}
+	m.checksLoading = true
+	key := github.PRKey(info.Owner, info.Repo, info.Number)
+	return tea.Batch(bannerTick(), fetchArtifacts(m.ctx, m.githubClient, key, info))
+}
+
+// fetchArtifacts creates a command that lists the artifacts for a PR's head.
+func fetchArtifacts(ctx context.Context, client *github.Client, key string, info github.PRInfo) tea.Cmd {
+	return func() tea.Msg {
+		artifacts, err := client.Checks.ListPRArtifacts(ctx, info.Owner, info.Repo, info.HeadSHA)
+		return ArtifactsMsg{Key: key, Artifacts: artifacts, Err: err}
+	}
+}
+
+// downloadArtifact creates a command that saves an artifact into dir.
+func downloadArtifact(ctx context.Context, client *github.Client, a github.Artifact, dir string) tea.Cmd {
+	return func() tea.Msg {
+		path, err := client.Checks.DownloadArtifact(ctx, a, dir)
+		return ArtifactDownloadMsg{Name: a.Name, Path: path, Err: err}
+	}
+}
+
+// checksKey returns the key of the PR shown in the checks overlay.
+func (m *Model) checksKey() string {
+	return github.PRKey(m.checksInfo.Owner, m.checksInfo.Repo, m.checksInfo.Number)
+}
+
+// handleChecksKey handles key events in the checks and artifacts overlay.
+func (m *Model) handleChecksKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, checksKeys.Close):
+		m.showChecks = false
+		return m, nil
+
+	case key.Matches(msg, checksKeys.Up):
+		if m.checksIndex > 0 {
+			m.checksIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, checksKeys.Down):
+		if m.checksIndex < len(m.checksArtifacts)-1 {
+			m.checksIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, checksKeys.Download):
+		if m.checksDownloading || m.checksIndex >= len(m.checksArtifacts) {
+			return m, nil
+		}
+		a := m.checksArtifacts[m.checksIndex]
+		m.checksDownloading = true
+		m.checksError = nil
+		m.checksStatus = ""
+		return m, tea.Batch(bannerTick(), downloadArtifact(m.ctx, m.githubClient, a, m.artifactSettings.DownloadDir))
+
+	case key.Matches(msg, checksKeys.Open):
+		if err := browser.Open(m.checksInfo.URL + "/checks"); err != nil {
+			m.checksError = err
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// formatBytes renders a byte count with a binary unit, e.g. "1.5 MB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// renderChecks renders the checks and artifacts overlay for a PR.
+func (m *Model) renderChecks() string {
+	maxWidth := max(min(90, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+	successStyle := lipgloss.NewStyle().Foreground(m.theme.StatusSuccess)
+
+	innerWidth := maxWidth - 6
+	info := m.checksInfo
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(truncateOrgLoadingText(fmt.Sprintf("%s/%s#%d %s", info.Owner, info.Repo, info.Number, info.Title), innerWidth)))
+	b.WriteString("\n\n")
+
+	if m.checksError != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.checksError)))
+		b.WriteString("\n\n")
+	}
+
+	// Checks, most important first
+	b.WriteString(accentStyle.Render("Checks"))
+	b.WriteString("\n")
+	runs := make([]github.CheckRun, len(info.CheckRuns))
+	copy(runs, info.CheckRuns)
+	sort.SliceStable(runs, func(i, j int) bool {
+		return checkRunSortKey(runs[i]) < checkRunSortKey(runs[j])
+	})
+	if len(runs) == 0 {
+		b.WriteString(subtleStyle.Render("  (none)"))
+		b.WriteString("\n")
+	}
+	maxChecks := max((maxHeight-16)/2, 3)
+	for i, cr := range runs {
+		if i == maxChecks {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf("  … %d more", len(runs)-maxChecks)))
+			b.WriteString("\n")
+			break
+		}
+		var dotColor color.Color
+		dot := icons.CheckOther
+		state := cr.Conclusion
+		switch checkRunSortKey(cr) {
+		case 0:
+			dotColor, dot = m.theme.StatusPending, icons.CheckPending
+			state = cr.Status
+		case 1:
+			dotColor, dot = m.theme.StatusFailure, icons.CheckFailure
+		case 2:
+			dotColor, dot = m.theme.StatusSuccess, icons.CheckSuccess
+		default:
+			dotColor = m.theme.Subtle
+		}
+		b.WriteString("  ")
+		b.WriteString(lipgloss.NewStyle().Foreground(dotColor).Render(dot))
+		b.WriteString(normalStyle.Render(" " + truncateOrgLoadingText(cr.Name, innerWidth-20)))
+		b.WriteString(subtleStyle.Render("  " + state))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	// Artifacts of completed workflow runs
+	b.WriteString(accentStyle.Render("Artifacts"))
+	b.WriteString("\n")
+	switch {
+	case m.checksLoading:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Loading artifacts...", spinner)))
+		b.WriteString("\n")
+	case len(m.checksArtifacts) == 0:
+		b.WriteString(subtleStyle.Render("  (none from completed runs)"))
+		b.WriteString("\n")
+	default:
+		visibleRows := max(maxHeight-16-min(len(runs), maxChecks), 3)
+		scrollOffset := 0
+		if m.checksIndex >= visibleRows {
+			scrollOffset = m.checksIndex - visibleRows + 1
+		}
+		endIdx := min(scrollOffset+visibleRows, len(m.checksArtifacts))
+		for i := scrollOffset; i < endIdx; i++ {
+			a := m.checksArtifacts[i]
+			size := formatBytes(a.SizeInBytes)
+			name := truncateOrgLoadingText(a.Name, max(innerWidth-len(size)-len(a.RunName)-8, 10))
+			if i == m.checksIndex {
+				b.WriteString(selectedStyle.Render("▸ " + name))
+			} else {
+				b.WriteString(normalStyle.Render("  " + name))
+			}
+			b.WriteString(subtleStyle.Render(fmt.Sprintf("  %s · %s", size, a.RunName)))
+			b.WriteString("\n")
+		}
+		if len(m.checksArtifacts) > visibleRows {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(m.checksArtifacts))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	switch {
+	case m.checksDownloading:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Downloading...", spinner)))
+		b.WriteString("\n")
+	case m.checksStatus != "":
+		b.WriteString(successStyle.Render(truncateOrgLoadingText(m.checksStatus, innerWidth)))
+		b.WriteString("\n")
+	}
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("↑↓: navigate  enter: download to %s  o: checks tab  esc: close", m.artifactSettings.DownloadDir)))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
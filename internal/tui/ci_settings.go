@@ -0,0 +1,174 @@
+package tui
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
+)
+
+// ciSettingsRowCount returns the number of selectable rows in the CI settings
+// overlay: the required-only toggle followed by one row per ignore pattern.
+func (m *Model) ciSettingsRowCount() int {
+	return 1 + len(m.ciSettings.IgnoreChecks)
+}
+
+// saveCISettings persists the CI settings and hands them to the poller so the
+// next poll uses them.
+func (m *Model) saveCISettings() {
+	m.ciSettingsError = config.SaveCISettings(m.ciSettings)
+	if m.poller != nil {
+		m.poller.SetCIOptions(m.ciSettings.CIOptions())
+	}
+}
+
+// handleCISettingsKey handles key events in the CI settings overlay.
+func (m *Model) handleCISettingsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	// Text input mode for a new ignore pattern
+	if m.ciInputActive {
+		switch {
+		case key.Matches(msg, inputKeys.Cancel):
+			m.ciInputActive = false
+			m.ciPatternInput.Blur()
+			return m, nil
+		case key.Matches(msg, inputKeys.Confirm):
+			pattern := strings.TrimSpace(m.ciPatternInput.Value())
+			if pattern == "" {
+				return m, nil
+			}
+			if _, err := path.Match(pattern, ""); err != nil {
+				m.ciSettingsError = fmt.Errorf("invalid pattern %q: %w", pattern, err)
+				return m, nil
+			}
+			m.ciInputActive = false
+			m.ciPatternInput.Blur()
+			if !slices.Contains(m.ciSettings.IgnoreChecks, pattern) {
+				m.ciSettings.IgnoreChecks = append(m.ciSettings.IgnoreChecks, pattern)
+				m.ciSettingsIndex = len(m.ciSettings.IgnoreChecks)
+				m.saveCISettings()
+			}
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.ciPatternInput, cmd = m.ciPatternInput.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, ciSettingsKeys.Close):
+		m.showCISettings = false
+		m.ciSettingsError = nil
+		return m, nil
+
+	case key.Matches(msg, ciSettingsKeys.Up):
+		if m.ciSettingsIndex > 0 {
+			m.ciSettingsIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, ciSettingsKeys.Down):
+		if m.ciSettingsIndex < m.ciSettingsRowCount()-1 {
+			m.ciSettingsIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, ciSettingsKeys.Toggle):
+		if m.ciSettingsIndex == 0 {
+			m.ciSettings.RequiredOnly = !m.ciSettings.RequiredOnly
+			m.saveCISettings()
+		}
+		return m, nil
+
+	case key.Matches(msg, ciSettingsKeys.Add):
+		m.ciInputActive = true
+		m.ciSettingsError = nil
+		m.ciPatternInput.SetValue("")
+		return m, m.ciPatternInput.Focus()
+
+	case key.Matches(msg, ciSettingsKeys.Remove):
+		if m.ciSettingsIndex == 0 {
+			return m, nil
+		}
+		m.ciSettings.IgnoreChecks = slices.Delete(m.ciSettings.IgnoreChecks, m.ciSettingsIndex-1, m.ciSettingsIndex)
+		m.ciSettingsIndex = min(m.ciSettingsIndex, m.ciSettingsRowCount()-1)
+		m.saveCISettings()
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// renderCISettings renders the CI aggregation settings overlay.
+func (m *Model) renderCISettings() string {
+	maxWidth := max(min(70, m.width-2), 40)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+
+	innerWidth := maxWidth - 6
+
+	var b strings.Builder
+	renderRow := func(i int, line string) {
+		line = truncateOrgLoadingText(line, innerWidth-2)
+		if i == m.ciSettingsIndex && !m.ciInputActive {
+			b.WriteString(selectedStyle.Render("▸ " + line))
+		} else {
+			b.WriteString(normalStyle.Render("  " + line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(titleStyle.Render("CI Status Settings"))
+	b.WriteString("\n\n")
+
+	if m.ciSettingsError != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.ciSettingsError)))
+		b.WriteString("\n\n")
+	}
+
+	toggle := "off"
+	if m.ciSettings.RequiredOnly {
+		toggle = "on"
+	}
+	renderRow(0, fmt.Sprintf("Required checks only: %s", toggle))
+	b.WriteString("\n")
+
+	b.WriteString(accentStyle.Render("  Ignored checks"))
+	b.WriteString("\n")
+	if len(m.ciSettings.IgnoreChecks) == 0 {
+		b.WriteString(subtleStyle.Render("  (none)"))
+		b.WriteString("\n")
+	}
+	for i, pattern := range m.ciSettings.IgnoreChecks {
+		renderRow(i+1, pattern)
+	}
+
+	b.WriteString("\n")
+	if m.ciInputActive {
+		b.WriteString(m.ciPatternInput.View())
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("enter: add  esc: cancel"))
+	} else {
+		b.WriteString(subtleStyle.Render("Patterns use shell glob syntax, e.g. codecov/*"))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("↑↓: navigate  enter: toggle  a: add pattern  x: remove  esc: close"))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,104 @@
+package tui
+
+import (
+	"image/color"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+)
+
+// ansiThemes are the 16-color palettes themes fall back to, dark first and
+// light second. Each slot is picked for what it's for rather than by
+// nearest match, which turns most muted hex colors into black or white and
+// makes them unreadable on one background or the other.
+var ansiThemes = [2]Theme{
+	{
+		Error:              lipgloss.Color("9"),
+		HelpText:           lipgloss.Color("8"),
+		FocusedBorder:      lipgloss.Color("12"),
+		UnfocusedBorder:    lipgloss.Color("8"),
+		StatusSuccess:      lipgloss.Color("10"),
+		StatusFailure:      lipgloss.Color("9"),
+		StatusPending:      lipgloss.Color("11"),
+		Title:              lipgloss.Color("12"),
+		TitleBar:           lipgloss.Color("0"),
+		SelectedForeground: lipgloss.Color("12"),
+		SelectedDesc:       lipgloss.Color("7"),
+		NormalForeground:   lipgloss.Color("15"),
+		NormalDesc:         lipgloss.Color("8"),
+		TimelineCreated:    lipgloss.Color("12"),
+		TimelineApproved:   lipgloss.Color("10"),
+		TimelineMerged:     lipgloss.Color("13"),
+		TimelineChanges:    lipgloss.Color("3"),
+		TimelineRequested:  lipgloss.Color("11"),
+		TimelineClosed:     lipgloss.Color("9"),
+		TimelineReopened:   lipgloss.Color("14"),
+		Accent:             lipgloss.Color("12"),
+		Subtle:             lipgloss.Color("8"),
+	},
+	{
+		Error:              lipgloss.Color("1"),
+		HelpText:           lipgloss.Color("8"),
+		FocusedBorder:      lipgloss.Color("4"),
+		UnfocusedBorder:    lipgloss.Color("8"),
+		StatusSuccess:      lipgloss.Color("2"),
+		StatusFailure:      lipgloss.Color("1"),
+		StatusPending:      lipgloss.Color("3"),
+		Title:              lipgloss.Color("4"),
+		TitleBar:           lipgloss.Color("7"),
+		SelectedForeground: lipgloss.Color("4"),
+		SelectedDesc:       lipgloss.Color("0"),
+		NormalForeground:   lipgloss.Color("0"),
+		NormalDesc:         lipgloss.Color("8"),
+		TimelineCreated:    lipgloss.Color("4"),
+		TimelineApproved:   lipgloss.Color("2"),
+		TimelineMerged:     lipgloss.Color("5"),
+		TimelineChanges:    lipgloss.Color("3"),
+		TimelineRequested:  lipgloss.Color("3"),
+		TimelineClosed:     lipgloss.Color("1"),
+		TimelineReopened:   lipgloss.Color("6"),
+		Accent:             lipgloss.Color("4"),
+		Subtle:             lipgloss.Color("8"),
+	},
+}
+
+// colors returns pointers to every color slot of the theme.
+func (t *Theme) colors() []*color.Color {
+	return []*color.Color{
+		&t.Error, &t.HelpText, &t.FocusedBorder, &t.UnfocusedBorder,
+		&t.StatusSuccess, &t.StatusFailure, &t.StatusPending,
+		&t.Title, &t.TitleBar, &t.SelectedForeground, &t.SelectedDesc, &t.NormalForeground, &t.NormalDesc,
+		&t.TimelineCreated, &t.TimelineApproved, &t.TimelineMerged, &t.TimelineChanges,
+		&t.TimelineRequested, &t.TimelineClosed, &t.TimelineReopened,
+		&t.Accent, &t.Subtle,
+	}
+}
+
+// isLight reports whether the theme is meant for a light background, going
+// by how dark its normal text is.
+func (t Theme) isLight() bool {
+	r, g, b, _ := t.NormalForeground.RGBA()
+	return 0.299*float64(r)+0.587*float64(g)+0.114*float64(b) < 0x8000
+}
+
+// degradeTheme fits a theme to the terminal's color profile: 256-color
+// terminals get each slot's nearest palette color and 16-color ones the
+// explicit ansiThemes palette for the theme's background. Anything less is
+// left to the renderer, which drops colors altogether.
+func degradeTheme(t Theme, p colorprofile.Profile) Theme {
+	switch p {
+	case colorprofile.ANSI256:
+		for _, c := range t.colors() {
+			*c = p.Convert(*c)
+		}
+	case colorprofile.ANSI:
+		fallback := ansiThemes[0]
+		if t.isLight() {
+			fallback = ansiThemes[1]
+		}
+		fallback.Name = t.Name
+		fallback.BannerDark, fallback.BannerBright = t.BannerDark, t.BannerBright
+		t = fallback
+	}
+	return t
+}
@@ -1,11 +1,16 @@
 package tui
 
 import (
+	"context"
 	"fmt"
+	"image/color"
+	"slices"
 	"strings"
 	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/jpoz/hubell/internal/config"
 	"github.com/jpoz/hubell/internal/github"
 )
@@ -19,12 +24,37 @@ type DashboardStats struct {
 	ChecksSuccess          int
 	ChecksFailure          int
 	NotificationTimestamps []time.Time
+
+	// ReviewTurnaround is how fast I answer others' review requests,
+	// fetched when the dashboard opens and older than
+	// reviewTurnaroundMaxAge
+	ReviewTurnaround    *github.ReviewTurnaround
+	TurnaroundFetchedAt time.Time
+
+	// CIHistory tallies check outcomes by the day they were first seen,
+	// persisted for the pass rate trend
+	CIHistory config.CIHistory
+
+	// FlakyChecks tracks checks whose reruns disagree on the same commit
+	FlakyChecks config.FlakyChecks
 }
 
+// flakyChecksShown is how many flaky checks the dashboard lists.
+const flakyChecksShown = 5
+
+// reviewTurnaroundMaxAge is how long a fetched review turnaround is shown
+// before the dashboard refetches it.
+const reviewTurnaroundMaxAge = 15 * time.Minute
+
+// reviewTurnaroundWindow is how far back reviewed PRs are searched.
+const reviewTurnaroundWindow = 30 * 24 * time.Hour
+
 func newDashboardStats() DashboardStats {
 	return DashboardStats{
 		WeeklyMergedCounts: make(map[string]int),
 		ReviewLatencies:    make(map[string]time.Duration),
+		CIHistory:          config.CIHistory{Days: make(map[string]config.CIDay), Seen: make(map[int]string)},
+		FlakyChecks:        config.FlakyChecks{Checks: make(map[string]*config.FlakyCheck), Latest: make(map[string]config.CheckOutcome)},
 	}
 }
 
@@ -51,28 +81,56 @@ func (d *DashboardStats) updateFromPollResult(mergedPRs []github.MergedPRInfo, w
 		_ = config.SaveWeeklyStats(stats)
 	}
 
-	// Recompute CI tallies from open PR check runs
+	// Recompute CI tallies from open PR check runs, adding runs not seen
+	// before to today's history
 	d.ChecksTotal = 0
 	d.ChecksSuccess = 0
 	d.ChecksFailure = 0
+	today := time.Now().Format(time.DateOnly)
+	historyChanged := false
 	for _, info := range prInfos {
+		if info.Watched {
+			continue
+		}
 		for _, cr := range info.CheckRuns {
 			if cr.Status != "completed" {
 				continue
 			}
-			d.ChecksTotal++
+			run := config.CIDay{Total: 1}
 			switch cr.Conclusion {
 			case "success":
-				d.ChecksSuccess++
+				run.Success = 1
 			case "failure", "cancelled", "timed_out":
-				d.ChecksFailure++
+				run.Failure = 1
+			}
+			d.ChecksTotal += run.Total
+			d.ChecksSuccess += run.Success
+			d.ChecksFailure += run.Failure
+
+			if _, seen := d.CIHistory.Seen[cr.ID]; !seen && cr.ID != 0 {
+				d.CIHistory.Seen[cr.ID] = today
+				tally := d.CIHistory.Days[today]
+				tally.Total += run.Total
+				tally.Success += run.Success
+				tally.Failure += run.Failure
+				d.CIHistory.Days[today] = tally
+				historyChanged = true
 			}
 		}
 	}
+	if historyChanged {
+		_ = config.SaveCIHistory(d.CIHistory)
+	}
+	if d.recordCheckOutcomes(prInfos, time.Now()) {
+		_ = config.SaveFlakyChecks(d.FlakyChecks)
+	}
 
 	// Compute review latencies: earliest non-author review per PR
 	d.ReviewLatencies = make(map[string]time.Duration)
 	for key, info := range prInfos {
+		if info.Watched {
+			continue
+		}
 		var earliest time.Time
 		for _, r := range info.Reviews {
 			if r.SubmittedAt.IsZero() {
@@ -88,6 +146,69 @@ func (d *DashboardStats) updateFromPollResult(mergedPRs []github.MergedPRInfo, w
 	}
 }
 
+// recordCheckOutcomes notes each new check run's conclusion, counting a
+// flip when it differs from the check's previous run on the same commit,
+// e.g. a retry that passed. Watched PRs count too, as flakiness is the
+// CI job's, not the PR's. Returns whether anything changed.
+func (d *DashboardStats) recordCheckOutcomes(prInfos map[string]github.PRInfo, now time.Time) bool {
+	changed := false
+	for _, info := range prInfos {
+		if info.HeadSHA == "" {
+			continue
+		}
+		repo := info.Owner + "/" + info.Repo
+		for _, cr := range info.CheckRuns {
+			var conclusion string
+			switch cr.Conclusion {
+			case "success":
+				conclusion = "success"
+			case "failure", "timed_out":
+				conclusion = "failure"
+			default:
+				continue
+			}
+			runKey := repo + "@" + info.HeadSHA + ":" + cr.Name
+			prev, seen := d.FlakyChecks.Latest[runKey]
+			if seen && prev.RunID == cr.ID {
+				continue
+			}
+
+			checkKey := repo + ":" + cr.Name
+			c := d.FlakyChecks.Checks[checkKey]
+			if c == nil {
+				c = &config.FlakyCheck{Repo: repo, Name: cr.Name}
+				d.FlakyChecks.Checks[checkKey] = c
+			}
+			c.Runs++
+			c.LastSeenAt = now
+			if seen && prev.Conclusion != conclusion {
+				c.Flips++
+				c.LastFlipAt = now
+			}
+			d.FlakyChecks.Latest[runKey] = config.CheckOutcome{RunID: cr.ID, Conclusion: conclusion, SeenAt: now}
+			changed = true
+		}
+	}
+	return changed
+}
+
+// flakyChecks returns the checks that have flipped, most flips first.
+func (d *DashboardStats) flakyChecks() []*config.FlakyCheck {
+	var flaky []*config.FlakyCheck
+	for _, c := range d.FlakyChecks.Checks {
+		if c.Flips > 0 {
+			flaky = append(flaky, c)
+		}
+	}
+	slices.SortFunc(flaky, func(a, b *config.FlakyCheck) int {
+		if a.Flips != b.Flips {
+			return b.Flips - a.Flips
+		}
+		return b.LastFlipAt.Compare(a.LastFlipAt)
+	})
+	return flaky
+}
+
 // recordNotifications appends current timestamps for notification volume tracking.
 func (d *DashboardStats) recordNotifications(count int) {
 	now := time.Now()
@@ -116,6 +237,43 @@ func (d *DashboardStats) ciPassRate() float64 {
 	return float64(d.ChecksSuccess) / float64(d.ChecksTotal)
 }
 
+// ciPassRateTrend returns the CI pass rate of each of the last numWeeks
+// weeks (Monday to Sunday, oldest first) from the CI history, as a
+// percentage, or -1 for weeks without completed checks. overall is the
+// pass rate across them all, -1 likewise.
+func (d *DashboardStats) ciPassRateTrend(numWeeks int, now time.Time) (weekly []int, overall int) {
+	weekday := (int(now.Weekday()) + 6) % 7
+	monday := time.Date(now.Year(), now.Month(), now.Day()-weekday, 0, 0, 0, 0, now.Location())
+	start := monday.AddDate(0, 0, -7*(numWeeks-1))
+
+	weeks := make([]config.CIDay, numWeeks)
+	var total config.CIDay
+	for date, day := range d.CIHistory.Days {
+		t, err := time.ParseInLocation(time.DateOnly, date, now.Location())
+		if err != nil || t.Before(start) {
+			continue
+		}
+		// Rounded to whole days, as DST makes some 23 or 25 hours long
+		i := min(int(t.Sub(start).Hours()+12)/24/7, numWeeks-1)
+		weeks[i].Total += day.Total
+		weeks[i].Success += day.Success
+		total.Total += day.Total
+		total.Success += day.Success
+	}
+
+	rate := func(day config.CIDay) int {
+		if day.Total == 0 {
+			return -1
+		}
+		return day.Success * 100 / day.Total
+	}
+	weekly = make([]int, numWeeks)
+	for i, w := range weeks {
+		weekly[i] = rate(w)
+	}
+	return weekly, rate(total)
+}
+
 // notificationBuckets returns notification counts bucketed by age.
 func (d *DashboardStats) notificationBuckets() (lastHour, oneToThree, threeToSix, sixPlus int) {
 	now := time.Now()
@@ -214,6 +372,10 @@ func (m *Model) renderDashboard() string {
 	} else {
 		b.WriteString(ciLabel + subtleStyle.Render(ciStr))
 	}
+	b.WriteString("\n")
+	b.WriteString(m.renderCITrend())
+	b.WriteString("\n")
+	b.WriteString(m.renderReviewTurnaround())
 	b.WriteString("\n\n")
 
 	// Notification volume
@@ -232,7 +394,22 @@ func (m *Model) renderDashboard() string {
 	))
 	b.WriteString("\n\n")
 
-	b.WriteString(subtleStyle.Render("esc to close"))
+	b.WriteString(m.renderPRAges(maxWidth - 6))
+	b.WriteString("\n")
+
+	b.WriteString(m.renderFlakyChecks(maxWidth - 6))
+	b.WriteString("\n")
+
+	if m.attentionSettings.Enabled {
+		b.WriteString(m.renderAttentionReport(maxWidth - 4))
+		b.WriteString("\n")
+	}
+
+	if m.notice != "" {
+		b.WriteString(accentStyle.Render(m.notice))
+		b.WriteString("\n")
+	}
+	b.WriteString(subtleStyle.Render("h: heatmap  e: export report  esc to close"))
 
 	box := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -244,6 +421,216 @@ func (m *Model) renderDashboard() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
 }
 
+// prAgeBuckets are the open PR age ranges of the dashboard histogram.
+var prAgeBuckets = []struct {
+	label string
+	upTo  time.Duration
+}{
+	{"0-1d", 24 * time.Hour},
+	{"1-3d", 3 * 24 * time.Hour},
+	{"3-7d", 7 * 24 * time.Hour},
+	{"7d+", 0},
+}
+
+// prAgeStalestShown is how many of the oldest open PRs are listed.
+const prAgeStalestShown = 3
+
+// openPRAges buckets my open PRs by age into prAgeBuckets and returns them
+// oldest first. Watched PRs by others are left out.
+func openPRAges(prInfos map[string]github.PRInfo, now time.Time) (counts []int, oldest []github.PRInfo) {
+	counts = make([]int, len(prAgeBuckets))
+	for _, info := range prInfos {
+		if info.Watched || info.CreatedAt.IsZero() {
+			continue
+		}
+		age := now.Sub(info.CreatedAt)
+		for i, bucket := range prAgeBuckets {
+			if bucket.upTo == 0 || age < bucket.upTo {
+				counts[i]++
+				break
+			}
+		}
+		oldest = append(oldest, info)
+	}
+	slices.SortFunc(oldest, func(a, b github.PRInfo) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return counts, oldest
+}
+
+// renderPRAges renders a histogram of my open PRs by age, with the stalest
+// ones listed below it.
+func (m *Model) renderPRAges(width int) string {
+	now := time.Now()
+	counts, oldest := openPRAges(m.prInfos, now)
+
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	barColors := []color.Color{m.theme.StatusSuccess, m.theme.Accent, m.theme.StatusPending, m.theme.StatusFailure}
+
+	var b strings.Builder
+	b.WriteString(accentStyle.Render(fmt.Sprintf("Open PR Age (%d)", len(oldest))))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(strings.Repeat("─", width)))
+	b.WriteString("\n")
+
+	busiest := 1
+	for _, n := range counts {
+		busiest = max(busiest, n)
+	}
+	barWidth := max(width-12, 1)
+	for i, bucket := range prAgeBuckets {
+		bar := strings.Repeat("█", counts[i]*barWidth/busiest)
+		if counts[i] > 0 && bar == "" {
+			bar = "▏"
+		}
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("  %-5s ", bucket.label)))
+		b.WriteString(lipgloss.NewStyle().Foreground(barColors[i]).Render(bar))
+		b.WriteString(normalStyle.Render(fmt.Sprintf(" %d", counts[i])))
+		b.WriteString("\n")
+	}
+
+	// PRs younger than the first bucket aren't stale yet
+	stale := slices.DeleteFunc(oldest, func(info github.PRInfo) bool {
+		return now.Sub(info.CreatedAt) < prAgeBuckets[0].upTo
+	})
+	if len(stale) > 0 {
+		b.WriteString(subtleStyle.Render("  Stalest:"))
+		b.WriteString("\n")
+		for _, info := range stale[:min(prAgeStalestShown, len(stale))] {
+			ref := fmt.Sprintf("%s/%s#%d", info.Owner, info.Repo, info.Number)
+			age := formatMergeDuration(now.Sub(info.CreatedAt))
+			line := fmt.Sprintf("    %s %s %s", ref, subtleStyle.Render(age), info.Title)
+			b.WriteString(ansi.Truncate(normalStyle.Render(line), width, "…"))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// renderFlakyChecks lists the checks whose reruns disagreed on the same
+// commit, so the team knows which CI jobs to distrust.
+func (m *Model) renderFlakyChecks(width int) string {
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	pendingStyle := lipgloss.NewStyle().Foreground(m.theme.StatusPending)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+
+	flaky := m.dashboardStats.flakyChecks()
+	var b strings.Builder
+	b.WriteString(accentStyle.Render(fmt.Sprintf("Flaky Checks (%d)", len(flaky))))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(strings.Repeat("─", width)))
+	b.WriteString("\n")
+	if len(flaky) == 0 {
+		b.WriteString(subtleStyle.Render("  None seen passing and failing on the same commit"))
+		b.WriteString("\n")
+		return b.String()
+	}
+	for _, c := range flaky[:min(flakyChecksShown, len(flaky))] {
+		stats := fmt.Sprintf(" flipped %d× in %d runs, last %s", c.Flips, c.Runs, formatDuration(time.Since(c.LastFlipAt)))
+		name := truncateOrgLoadingText(c.Repo+" · "+c.Name, max(width-lipgloss.Width(stats)-4, 10))
+		b.WriteString(pendingStyle.Render("  ⚠ ") + normalStyle.Render(name) + subtleStyle.Render(stats))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// openDashboard shows the activity dashboard, fetching my review
+// turnaround when it's missing or stale.
+func (m *Model) openDashboard() tea.Cmd {
+	m.showDashboard = true
+	if m.reviewTurnaroundLoading || time.Since(m.dashboardStats.TurnaroundFetchedAt) < reviewTurnaroundMaxAge {
+		return nil
+	}
+	m.reviewTurnaroundLoading = true
+	return tea.Batch(bannerTick(), fetchReviewTurnaround(m.ctx, m.githubClient, m.username))
+}
+
+// fetchReviewTurnaround creates a command that measures username's review
+// turnaround.
+func fetchReviewTurnaround(ctx context.Context, client *github.Client, username string) tea.Cmd {
+	return func() tea.Msg {
+		t, err := client.Search.FetchReviewTurnaround(ctx, username, time.Now().Add(-reviewTurnaroundWindow))
+		return ReviewTurnaroundMsg{Username: username, Turnaround: t, Err: err}
+	}
+}
+
+// handleReviewTurnaround stores a measured review turnaround, unless the
+// account was switched while it loaded.
+func (m *Model) handleReviewTurnaround(msg ReviewTurnaroundMsg) {
+	if msg.Username != m.username {
+		return
+	}
+	m.reviewTurnaroundLoading = false
+	if msg.Err != nil {
+		m.err = msg.Err
+		return
+	}
+	m.dashboardStats.ReviewTurnaround = msg.Turnaround
+	m.dashboardStats.TurnaroundFetchedAt = time.Now()
+}
+
+// ciTrendWeeks is how many weeks the CI pass rate trend spans.
+const ciTrendWeeks = 8
+
+// renderCITrend renders the weekly CI pass rate as a sparkline from 0 to
+// 100%, with the first and latest weeks' rates.
+func (m *Model) renderCITrend() string {
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	weekly, overall := m.dashboardStats.ciPassRateTrend(ciTrendWeeks, time.Now())
+
+	line := fmt.Sprintf("CI Pass Rate Trend (%d weeks): ", ciTrendWeeks)
+	if overall < 0 {
+		return line + subtleStyle.Render("N/A")
+	}
+	first, last := -1, -1
+	for _, pct := range weekly {
+		if pct < 0 {
+			line += subtleStyle.Render("·")
+			continue
+		}
+		if first < 0 {
+			first = pct
+		}
+		last = pct
+		style := lipgloss.NewStyle().Foreground(m.theme.StatusFailure)
+		if pct >= 80 {
+			style = lipgloss.NewStyle().Foreground(m.theme.StatusSuccess)
+		}
+		line += style.Render(string(sparkBlocks[pct*(len(sparkBlocks)-1)/100]))
+	}
+	return line + subtleStyle.Render(fmt.Sprintf("  %d%% → %d%%, %d%% overall", first, last, overall))
+}
+
+// renderReviewTurnaround renders the line on how fast I review others' PRs
+// and how many wait on me.
+func (m *Model) renderReviewTurnaround() string {
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	pendingStyle := lipgloss.NewStyle().Foreground(m.theme.StatusPending)
+
+	line := "My Review Turnaround: "
+	t := m.dashboardStats.ReviewTurnaround
+	switch {
+	case t == nil && m.reviewTurnaroundLoading:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		return line + subtleStyle.Render(spinner+" measuring...")
+	case t == nil:
+		return line + subtleStyle.Render("N/A")
+	case len(t.Turnarounds) == 0:
+		line += subtleStyle.Render("N/A")
+	default:
+		line += accentStyle.Render(formatMergeDuration(t.Median())) +
+			subtleStyle.Render(fmt.Sprintf(" median (%d reviews)", len(t.Turnarounds)))
+	}
+	if len(t.Pending) > 0 {
+		line += subtleStyle.Render(" · ") +
+			pendingStyle.Render(fmt.Sprintf("%d waiting on me", len(t.Pending))) +
+			subtleStyle.Render(", oldest "+formatMergeDuration(time.Since(t.Pending[0])))
+	}
+	return line
+}
+
 // formatReviewDuration formats a review latency duration in a human-readable way.
 func formatReviewDuration(d time.Duration) string {
 	if d < time.Minute {
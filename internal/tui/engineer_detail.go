@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/github"
 )
 
 // renderEngineerDetail renders the engineer drill-down overlay.
@@ -23,7 +24,7 @@ func (m *Model) renderEngineerDetail() string {
 
 	var lines []string
 
-	if m.engineerLoading {
+	if m.engineerLoading && m.engineerDetail == nil {
 		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
 		lines = append(lines, accentStyle.Render(fmt.Sprintf(" %s Loading engineer details...", spinner)))
 
@@ -47,6 +48,10 @@ func (m *Model) renderEngineerDetail() string {
 
 	// Title
 	lines = append(lines, titleStyle.Render(fmt.Sprintf("@%s - Last 7 Days", d.Login)))
+	if m.engineerLoading {
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		lines = append(lines, accentStyle.Render(fmt.Sprintf(" %s Fetching PR details %d/%d...", spinner, d.EnrichDone, d.EnrichTotal)))
+	}
 	lines = append(lines, "")
 
 	// Merged PRs section
@@ -196,6 +201,17 @@ func (m *Model) renderEngineerDetail() string {
 			formatMergeDuration(d.LongestPR.TimeToMerge))))
 	}
 
+	// After-hours activity
+	afterHoursMerges := countAfterHours(m.workingHours, d.MergedPRs, func(pr github.DetailedMergedPR) time.Time { return pr.MergedAt })
+	afterHoursReviews := m.afterHoursReviews(d.ReviewTimes)
+	afterHoursStyle := accentStyle
+	if afterHoursMerges+afterHoursReviews > 0 {
+		afterHoursStyle = lipgloss.NewStyle().Foreground(m.theme.StatusPending).Bold(true)
+	}
+	lines = append(lines, normalStyle.Render(fmt.Sprintf("  After Hours:        %s merges  ·  %s reviews",
+		afterHoursStyle.Render(formatAfterHours(afterHoursMerges, len(d.MergedPRs))),
+		afterHoursStyle.Render(formatAfterHours(afterHoursReviews, len(d.ReviewTimes))))))
+
 	// Comments
 	lines = append(lines, normalStyle.Render(fmt.Sprintf("  Comments Given:     %s",
 		accentStyle.Render(fmt.Sprintf("%d", d.CommentsGiven)))))
@@ -225,7 +241,7 @@ func (m *Model) renderEngineerDetail() string {
 	}
 
 	// Help
-	lines = append(lines, subtleStyle.Render("↑↓: select PR  enter: open in browser  esc: back"))
+	lines = append(lines, subtleStyle.Render("↑↓: select PR  enter: open in browser  h: heatmap  esc: back"))
 
 	// Apply scroll viewport
 	contentHeight := max(maxHeight-4, 5) // account for box border + padding
@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"context"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// forkSync tracks a request to sync a PR's fork with upstream.
+type forkSync struct {
+	behindBy int // ForkBehindBy when the sync was requested
+	pending  bool
+	result   string
+	err      error
+}
+
+// label returns the short status shown in the PR pane.
+func (s forkSync) label() string {
+	switch {
+	case s.pending:
+		return "⟳ syncing fork"
+	case s.err != nil:
+		return "✗ fork sync failed"
+	default:
+		return "↻ fork synced"
+	}
+}
+
+// startForkSync syncs the base branch of the fork a PR comes from with
+// upstream via the merge-upstream API.
+func (m *Model) startForkSync(info github.PRInfo) tea.Cmd {
+	if info.HeadRepo == "" {
+		return nil
+	}
+	key := github.PRKey(info.Owner, info.Repo, info.Number)
+	if s, ok := m.forkSyncs[key]; ok && s.pending {
+		return nil
+	}
+	m.forkSyncs[key] = forkSync{behindBy: info.ForkBehindBy, pending: true}
+	m.updatePRList()
+	return requestForkSync(m.ctx, m.githubClient, key, info)
+}
+
+// requestForkSync creates a command that merges upstream into the fork.
+func requestForkSync(ctx context.Context, client *github.Client, key string, info github.PRInfo) tea.Cmd {
+	return func() tea.Msg {
+		result, err := client.Repos.SyncFork(ctx, info.HeadRepo, info.BaseBranch)
+		return ForkSyncMsg{Key: key, Result: result, Err: err}
+	}
+}
+
+// forkSyncLabel returns the fork sync status for a PR, or "".
+func (m *Model) forkSyncLabel(key string) string {
+	if s, ok := m.forkSyncs[key]; ok {
+		return s.label()
+	}
+	return ""
+}
+
+// pruneForkSyncs forgets syncs for PRs whose fork status was refreshed since
+// the sync, or that are no longer open.
+func (m *Model) pruneForkSyncs() {
+	for key, s := range m.forkSyncs {
+		info, ok := m.prInfos[key]
+		if !ok || (!s.pending && info.ForkBehindBy != s.behindBy) {
+			delete(m.forkSyncs, key)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// threadKey identifies the issue or PR a notification is about, so
+// notifications on the same one can be grouped. Subjects without a number
+// (releases, check suites, ...) are never grouped.
+func threadKey(n *github.Notification) string {
+	if owner, repo, number, ok := github.ParseSubjectURL(n.Subject.URL); ok {
+		return github.PRKey(owner, repo, number)
+	}
+	return n.ID
+}
+
+// notificationItems converts notifications, newest first, into list items.
+// In grouping mode notifications on the same issue or PR collapse into the
+// newest one.
+func (m *Model) notificationItems(notifications []*github.Notification) []list.Item {
+	items := make([]list.Item, 0, len(notifications))
+	index := make(map[string]int)
+	for _, n := range notifications {
+		if m.groupThreads {
+			k := threadKey(n)
+			if i, ok := index[k]; ok {
+				item := items[i].(NotificationItem)
+				item.grouped = append(item.grouped, n)
+				items[i] = item
+				continue
+			}
+			index[k] = len(items)
+		}
+		items = append(items, NotificationItem{
+			notification:  n,
+			ciStatus:      m.prStatusForNotification(n),
+			commentDetail: m.commentDetails[n.ID],
+			mention:       m.mentionFor(n),
+			subject:       m.subjectDetails[n.ID],
+			fading:        !m.readAt[n.ID].IsZero(),
+			refreshing:    m.notificationRefreshLabel(n),
+		})
+	}
+	return items
+}
+
+// thread returns the item's notification followed by the older ones grouped
+// under it.
+func (i NotificationItem) thread() []*github.Notification {
+	return append([]*github.Notification{i.notification}, i.grouped...)
+}
+
+// markThread creates commands marking every notification of an item read,
+// or done.
+func (m *Model) markThread(item NotificationItem, done bool) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, n := range item.thread() {
+		if done {
+			cmds = append(cmds, markAsDone(m.ctx, m.githubClient, n.ID))
+		} else {
+			cmds = append(cmds, markAsRead(m.ctx, m.githubClient, n.ID))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// openGroup shows every notification grouped under an item.
+func (m *Model) openGroup(item NotificationItem) {
+	m.showGroup = true
+	m.groupNotifications = item.thread()
+	m.groupIndex = 0
+}
+
+// handleGroupKey handles key events in the grouped thread overlay.
+func (m *Model) handleGroupKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, groupKeys.Close):
+		m.showGroup = false
+		return m, nil
+
+	case key.Matches(msg, groupKeys.Up):
+		if m.groupIndex > 0 {
+			m.groupIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, groupKeys.Down):
+		if m.groupIndex < len(m.groupNotifications)-1 {
+			m.groupIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, groupKeys.Open):
+		if m.groupIndex < len(m.groupNotifications) {
+			n := m.groupNotifications[m.groupIndex]
+			if err := browser.Open(m.notificationURL(n)); err != nil {
+				m.err = err
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, groupKeys.MarkRead), key.Matches(msg, groupKeys.MarkDone):
+		if m.groupIndex < len(m.groupNotifications) {
+			n := m.groupNotifications[m.groupIndex]
+			m.groupNotifications = append(m.groupNotifications[:m.groupIndex:m.groupIndex], m.groupNotifications[m.groupIndex+1:]...)
+			m.groupIndex = min(m.groupIndex, max(len(m.groupNotifications)-1, 0))
+			if len(m.groupNotifications) == 0 {
+				m.showGroup = false
+			}
+			if key.Matches(msg, groupKeys.MarkDone) {
+				return m, markAsDone(m.ctx, m.githubClient, n.ID)
+			}
+			return m, markAsRead(m.ctx, m.githubClient, n.ID)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// renderGroup renders every notification on a grouped thread, newest first.
+func (m *Model) renderGroup() string {
+	maxWidth := max(min(90, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+
+	innerWidth := maxWidth - 6
+
+	var b strings.Builder
+	if len(m.groupNotifications) > 0 {
+		n := m.groupNotifications[0]
+		title := n.Subject.Title
+		if owner, repo, number, ok := github.ParseSubjectURL(n.Subject.URL); ok {
+			title = fmt.Sprintf("%s/%s#%d %s", owner, repo, number, title)
+		}
+		b.WriteString(titleStyle.Render(truncateOrgLoadingText(title, innerWidth)))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("%d notifications on this thread", len(m.groupNotifications))))
+		b.WriteString("\n\n")
+	}
+
+	// Each notification takes two lines: subject, then reason and age
+	visibleRows := max((maxHeight-10)/2, 3)
+	scrollOffset := 0
+	if m.groupIndex >= visibleRows {
+		scrollOffset = m.groupIndex - visibleRows + 1
+	}
+	endIdx := min(scrollOffset+visibleRows, len(m.groupNotifications))
+	for i := scrollOffset; i < endIdx; i++ {
+		n := m.groupNotifications[i]
+		unread := " "
+		if n.Unread {
+			unread = icons.Unread
+		}
+		line := truncateOrgLoadingText(fmt.Sprintf("%s %s: %s", unread, n.Subject.Type, n.Subject.Title), innerWidth-2)
+		if i == m.groupIndex {
+			b.WriteString(selectedStyle.Render("▸ " + line))
+		} else {
+			b.WriteString(normalStyle.Render("  " + line))
+		}
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("    %s · %s", formatReason(n.Reason), formatDuration(time.Since(n.UpdatedAt)))))
+		b.WriteString("\n")
+	}
+	if len(m.groupNotifications) > visibleRows {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(m.groupNotifications))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑↓: navigate  enter: open  r: mark read  D: mark done  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
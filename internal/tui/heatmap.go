@@ -0,0 +1,226 @@
+package tui
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// heatmapMaxWeeks caps the heatmap's columns; the timeline history keeps
+// about 13 weeks.
+const heatmapMaxWeeks = 26
+
+// heatmapDay counts one day's merges and reviews.
+type heatmapDay struct {
+	merges  int
+	reviews int
+}
+
+// openHeatmap shows the activity heatmap for login, or for me when login
+// is "".
+func (m *Model) openHeatmap(login string) {
+	m.showHeatmap = true
+	m.heatmapLogin = login
+}
+
+// handleHeatmapKey handles key events in the activity heatmap overlay.
+func (m *Model) handleHeatmapKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, heatmapKeys.Close) {
+		m.showHeatmap = false
+		m.heatmapLogin = ""
+	}
+	return m, nil
+}
+
+// heatmapActivity counts merges and reviews by local day for login (me when
+// ""), from the timeline history plus whatever the current poll, org
+// dashboard and engineer detail hold. Merges are deduplicated by PR and
+// reviews by submission time.
+func (m *Model) heatmapActivity(login string) map[string]*heatmapDay {
+	me := login == ""
+	if me {
+		login = m.username
+	}
+	days := make(map[string]*heatmapDay)
+	day := func(t time.Time) *heatmapDay {
+		k := t.Local().Format(time.DateOnly)
+		if days[k] == nil {
+			days[k] = &heatmapDay{}
+		}
+		return days[k]
+	}
+	mergedPRs := make(map[string]bool)
+	addMerge := func(owner, repo string, number int, at time.Time) {
+		k := github.PRKey(owner, repo, number)
+		if !mergedPRs[k] && !at.IsZero() {
+			mergedPRs[k] = true
+			day(at).merges++
+		}
+	}
+	reviewed := make(map[int64]bool)
+	addReview := func(at time.Time) {
+		if !reviewed[at.Unix()] && !at.IsZero() {
+			reviewed[at.Unix()] = true
+			day(at).reviews++
+		}
+	}
+
+	for _, r := range m.timelineHistory {
+		if r.Account != m.username {
+			continue
+		}
+		// The user-scoped timeline records my merges without an actor
+		byLogin := strings.EqualFold(r.Actor, login) || (me && r.Actor == "")
+		switch {
+		case r.Kind == "merged" && byLogin:
+			addMerge(r.Owner, r.Repo, r.Number, r.At)
+		case (r.Kind == "approved" || r.Kind == "changes_requested") && strings.EqualFold(r.Actor, login):
+			addReview(r.At)
+		}
+	}
+
+	if me {
+		for _, pr := range m.dashboardStats.MergedPRs {
+			addMerge(pr.Owner, pr.Repo, pr.Number, pr.MergedAt)
+		}
+	}
+	for _, member := range m.orgMembers {
+		if strings.EqualFold(member.Login, login) {
+			for _, pr := range member.MergedPRs {
+				addMerge(pr.Owner, pr.Repo, pr.Number, pr.MergedAt)
+			}
+		}
+	}
+	if d := m.engineerDetail; d != nil && strings.EqualFold(d.Login, login) {
+		for _, pr := range d.MergedPRs {
+			addMerge(pr.Owner, pr.Repo, pr.Number, pr.MergedAt)
+		}
+		for _, at := range d.ReviewTimes {
+			addReview(at)
+		}
+	}
+	return days
+}
+
+// blendColor mixes a and b, t of the way from a to b.
+func blendColor(a, b color.Color, t float64) color.Color {
+	ar, ag, ab, _ := a.RGBA()
+	br, bg, bb, _ := b.RGBA()
+	mix := func(x, y uint32) int {
+		return int((float64(x) + t*(float64(y)-float64(x))) / 257)
+	}
+	return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", mix(ar, br), mix(ag, bg), mix(ab, bb)))
+}
+
+// renderHeatmap renders a contribution-style grid of days by weeks, Monday
+// at the top and the current week on the right, each day shaded by its
+// merges plus reviews relative to the busiest day.
+func (m *Model) renderHeatmap() string {
+	maxWidth := max(min(72, m.width-4), 30)
+	now := time.Now()
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+
+	const labelWidth = 4
+	weeks := min(heatmapMaxWeeks, (maxWidth-6-labelWidth)/2)
+
+	who := "@" + m.heatmapLogin
+	if m.heatmapLogin == "" {
+		who = "My"
+	}
+	days := m.heatmapActivity(m.heatmapLogin)
+
+	// The grid starts on the Monday weeks-1 weeks before this one
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	start := monday.AddDate(0, 0, -7*(weeks-1))
+
+	busiest, merges, reviews := 1, 0, 0
+	for k, d := range days {
+		if k >= start.Format(time.DateOnly) {
+			busiest = max(busiest, d.merges+d.reviews)
+			merges += d.merges
+			reviews += d.reviews
+		}
+	}
+
+	levels := make([]color.Color, 5)
+	levels[0] = m.theme.Subtle
+	for i := 1; i < len(levels); i++ {
+		levels[i] = blendColor(m.theme.TitleBar, m.theme.StatusSuccess, float64(i)/float64(len(levels)-1))
+	}
+	swatch := func(level int) string {
+		if level == 0 {
+			return lipgloss.NewStyle().Foreground(levels[0]).Render("·")
+		}
+		return lipgloss.NewStyle().Foreground(levels[level]).Render("■")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s Activity - Last %d Weeks", who, weeks)))
+	b.WriteString("\n\n")
+
+	// Month labels above the first week of each month, skipped where the
+	// previous label would run into them
+	months := []byte(strings.Repeat(" ", labelWidth+2*weeks))
+	next := 0
+	for w := 0; w < weeks; w++ {
+		first := start.AddDate(0, 0, 7*w)
+		col := labelWidth + 2*w
+		if (w == 0 || first.Day() <= 7) && col >= next {
+			copy(months[col:], first.Format("Jan"))
+			next = col + 4
+		}
+	}
+	b.WriteString(subtleStyle.Render(strings.TrimRight(string(months), " ")))
+	b.WriteString("\n")
+
+	for row := 0; row < 7; row++ {
+		label := ""
+		if row%2 == 0 && row < 6 {
+			label = start.AddDate(0, 0, row).Format("Mon")
+		}
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("%-*s", labelWidth, label)))
+		for w := 0; w < weeks; w++ {
+			date := start.AddDate(0, 0, 7*w+row)
+			if date.After(today) {
+				break
+			}
+			n := 0
+			if d := days[date.Format(time.DateOnly)]; d != nil {
+				n = d.merges + d.reviews
+			}
+			b.WriteString(swatch((n*(len(levels)-1)+busiest-1)/busiest) + " ")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	var legend strings.Builder
+	for i := range levels {
+		legend.WriteString(swatch(i) + " ")
+	}
+	b.WriteString(subtleStyle.Render("less ") + legend.String() + subtleStyle.Render("more"))
+	b.WriteString("   ")
+	b.WriteString(accentStyle.Render(fmt.Sprintf("%d", merges)) + subtleStyle.Render(" merges · "))
+	b.WriteString(accentStyle.Render(fmt.Sprintf("%d", reviews)) + subtleStyle.Render(" reviews"))
+	b.WriteString("\n\n")
+	b.WriteString(subtleStyle.Render("from timeline history kept since first run  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
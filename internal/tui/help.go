@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// handleHelpKey handles key events in the help overlay.
+func (m *Model) handleHelpKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, helpKeys.Close):
+		m.showHelp = false
+		return m, nil
+
+	case key.Matches(msg, helpKeys.Up):
+		if m.helpScroll > 0 {
+			m.helpScroll--
+		}
+		return m, nil
+
+	case key.Matches(msg, helpKeys.Down):
+		m.helpScroll++ // clamped when rendering
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// renderHelp renders the keybinding reference generated from the keymaps.
+func (m *Model) renderHelp() string {
+	maxWidth := max(min(70, m.width-2), 40)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+
+	innerWidth := maxWidth - 6
+	groups := keyGroups(m.list.KeyMap)
+
+	keyWidth := 0
+	for _, g := range groups {
+		for _, b := range g.bindings {
+			keyWidth = max(keyWidth, ansi.StringWidth(b.Help().Key))
+		}
+	}
+
+	var lines []string
+	for i, g := range groups {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, accentStyle.Render(g.title))
+		for _, b := range g.bindings {
+			h := b.Help()
+			line := fmt.Sprintf("  %-*s  %s", keyWidth, h.Key, h.Desc)
+			lines = append(lines, normalStyle.Render(ansi.Truncate(line, innerWidth, "…")))
+		}
+	}
+
+	visible := max(maxHeight-8, 3)
+	maxScroll := max(len(lines)-visible, 0)
+	m.helpScroll = min(m.helpScroll, maxScroll)
+	end := min(m.helpScroll+visible, len(lines))
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Keybindings"))
+	b.WriteString("\n\n")
+	b.WriteString(strings.Join(lines[m.helpScroll:end], "\n"))
+	b.WriteString("\n")
+	if len(lines) > visible {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf(" (lines %d-%d of %d)", m.helpScroll+1, end, len(lines))))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑↓: scroll  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,133 @@
+package tui
+
+// iconSet holds the glyphs drawn for PR, issue, CI and review states.
+type iconSet struct {
+	Unread string
+
+	// Notification subjects; empty ones draw nothing
+	PullRequest string
+	Issue       string
+	Release     string
+	Discussion  string
+
+	// Timeline events and review states
+	Created          string
+	Approved         string
+	Merged           string
+	ChangesRequested string
+	ReviewRequested  string
+	Closed           string
+	Reopened         string
+	FirstMerge       string
+	Reviewed         string // commented review
+	ReviewPending    string
+
+	// PR history
+	Commit    string
+	ForcePush string
+	Review    string
+	Comment   string
+	Deployed  string
+	Event     string
+
+	// CI check runs
+	CheckPending string
+	CheckSuccess string
+	CheckFailure string
+	CheckOther   string
+}
+
+// iconSets are the icon sets config.toml's icons setting picks from:
+// "unicode" (the default) draws symbols any UTF-8 font has, "nerd" draws
+// Nerd Font (Octicons and Font Awesome) glyphs, and "ascii" sticks to plain
+// characters for limited terminals.
+var iconSets = map[string]iconSet{
+	"unicode": {
+		Unread:           "•",
+		Release:          "🏷",
+		Discussion:       "💬",
+		Created:          "+",
+		Approved:         "✓",
+		Merged:           "⊕",
+		ChangesRequested: "✗",
+		ReviewRequested:  "◎",
+		Closed:           "⊘",
+		Reopened:         "↺",
+		FirstMerge:       "★",
+		Reviewed:         "●",
+		ReviewPending:    "⋯",
+		Commit:           "●",
+		ForcePush:        "⇡",
+		Review:           "◆",
+		Comment:          "💬",
+		Deployed:         "⇪",
+		Event:            "○",
+		CheckPending:     "○",
+		CheckSuccess:     "●",
+		CheckFailure:     "●",
+		CheckOther:       "●",
+	},
+	"nerd": {
+		Unread:           "\uf444", // oct-dot_fill
+		PullRequest:      "\uf407", // oct-git_pull_request
+		Issue:            "\uf41b", // oct-issue_opened
+		Release:          "\uf412", // oct-tag
+		Discussion:       "\uf442", // oct-comment_discussion
+		Created:          "\uf44d", // oct-plus
+		Approved:         "\uf42e", // oct-check
+		Merged:           "\uf419", // oct-git_merge
+		ChangesRequested: "\uf467", // oct-x
+		ReviewRequested:  "\uf441", // oct-eye
+		Closed:           "\uf468", // oct-circle_slash
+		Reopened:         "\uf46a", // oct-sync
+		FirstMerge:       "\uf41e", // oct-star
+		Reviewed:         "\uf41f", // oct-comment
+		ReviewPending:    "\uf43a", // oct-clock
+		Commit:           "\uf417", // oct-git_commit
+		ForcePush:        "\uf431", // oct-repo_push
+		Review:           "\uf441", // oct-eye
+		Comment:          "\uf41f", // oct-comment
+		Deployed:         "\uf427", // oct-rocket
+		Event:            "\uf444", // oct-dot_fill
+		CheckPending:     "\uf017", // fa-clock_o
+		CheckSuccess:     "\uf058", // fa-check_circle
+		CheckFailure:     "\uf057", // fa-times_circle
+		CheckOther:       "\uf056", // fa-minus_circle
+	},
+	"ascii": {
+		Unread:           "*",
+		Release:          "rel",
+		Discussion:       "disc",
+		Created:          "+",
+		Approved:         "v",
+		Merged:           "M",
+		ChangesRequested: "x",
+		ReviewRequested:  "?",
+		Closed:           "-",
+		Reopened:         "^",
+		FirstMerge:       "*",
+		Reviewed:         "c",
+		ReviewPending:    ".",
+		Commit:           "o",
+		ForcePush:        "^",
+		Review:           "r",
+		Comment:          "c",
+		Deployed:         "D",
+		Event:            ".",
+		CheckPending:     "~",
+		CheckSuccess:     "+",
+		CheckFailure:     "x",
+		CheckOther:       "-",
+	},
+}
+
+// icons is the active icon set.
+var icons = iconSets["unicode"]
+
+// setIcons switches to the named icon set, keeping the current one for an
+// unknown name.
+func setIcons(name string) {
+	if set, ok := iconSets[name]; ok {
+		icons = set
+	}
+}
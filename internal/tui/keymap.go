@@ -0,0 +1,666 @@
+package tui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+)
+
+// Key bindings for every context. Handlers dispatch with key.Matches against
+// these and the help overlay is generated from them, so the two stay in sync.
+
+func newBinding(help, desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(help, desc))
+}
+
+var (
+	upKey   = newBinding("↑/k", "up", "up", "k")
+	downKey = newBinding("↓/j", "down", "down", "j")
+	openKey = newBinding("enter", "open in browser", "enter")
+)
+
+// inputKeyMap applies while a text input has focus.
+type inputKeyMap struct {
+	Confirm key.Binding
+	Cancel  key.Binding
+}
+
+var inputKeys = inputKeyMap{
+	Confirm: newBinding("enter", "confirm", "enter"),
+	Cancel:  newBinding("esc", "cancel", "esc"),
+}
+
+// orgInputKeyMap applies in the org name input on top of inputKeys. Only
+// the arrows move, so j and k can still be typed.
+type orgInputKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	Complete key.Binding
+	Forget   key.Binding
+}
+
+var orgInputKeys = orgInputKeyMap{
+	Up:       newBinding("↑", "previous org", "up"),
+	Down:     newBinding("↓", "next org", "down"),
+	Complete: newBinding("tab", "complete org", "tab"),
+	Forget:   newBinding("ctrl+x", "forget saved dashboard", "ctrl+x"),
+}
+
+// mainKeyMap applies to the three-pane main view.
+type mainKeyMap struct {
+	Quit          key.Binding
+	Help          key.Binding
+	NextPane      key.Binding
+	PrevPane      key.Binding
+	GrowPane      key.Binding
+	ShrinkPane    key.Binding
+	TogglePane1   key.Binding
+	TogglePane2   key.Binding
+	TogglePane3   key.Binding
+	Open          key.Binding
+	PRFiles       key.Binding
+	Checks        key.Binding
+	Security      key.Binding
+	Yank          key.Binding
+	YankRef       key.Binding
+	Ticket        key.Binding
+	UpdateBranch  key.Binding
+	SyncFork      key.Binding
+	AutoMerge     key.Binding
+	Checkout      key.Binding
+	Editor        key.Binding
+	RefreshPR     key.Binding
+	Refresh       key.Binding
+	PRTimeline    key.Binding
+	Watch         key.Binding
+	WatchRef      key.Binding
+	Thread        key.Binding
+	MarkRead      key.Binding
+	MarkDone      key.Binding
+	GroupThreads  key.Binding
+	ExpandThread  key.Binding
+	Archive       key.Binding
+	Filter        key.Binding
+	Sort          key.Binding
+	UnreadOnly    key.Binding
+	LabelFilter   key.Binding
+	Dashboard     key.Binding
+	Timezones     key.Binding
+	MainBoard     key.Binding
+	Org           key.Binding
+	Subscriptions key.Binding
+	Panels        key.Binding
+	CISettings    key.Binding
+	Theme         key.Binding
+	Snapshot      key.Binding
+	SwitchAccount key.Binding
+	Inject        key.Binding
+	DebugLog      key.Binding
+}
+
+var mainKeys = mainKeyMap{
+	Quit:          newBinding("q", "quit", "ctrl+c", "q"),
+	Help:          newBinding("?", "help", "?"),
+	NextPane:      newBinding("tab", "next pane", "tab"),
+	PrevPane:      newBinding("shift+tab", "previous pane", "shift+tab"),
+	GrowPane:      newBinding("ctrl+→", "widen focused pane", "ctrl+right"),
+	ShrinkPane:    newBinding("ctrl+←", "narrow focused pane", "ctrl+left"),
+	TogglePane1:   newBinding("1", "show/hide first pane", "1"),
+	TogglePane2:   newBinding("2", "show/hide second pane", "2"),
+	TogglePane3:   newBinding("3", "show/hide third pane", "3"),
+	Open:          newBinding("enter", "open in browser", "enter"),
+	PRFiles:       newBinding("space", "PR changed files", "space"),
+	Checks:        newBinding("a", "PR checks & artifacts", "a"),
+	Security:      newBinding("!", "PR code scanning alerts", "!"),
+	Yank:          newBinding("y", "copy URL", "y"),
+	YankRef:       newBinding("Y", "copy owner/repo#number", "Y"),
+	Ticket:        newBinding("T", "open linked tickets", "T"),
+	UpdateBranch:  newBinding("ctrl+u", "update PR branch from base", "ctrl+u"),
+	SyncFork:      newBinding("F", "sync PR's fork with upstream", "F"),
+	AutoMerge:     newBinding("M", "arm/disarm PR auto-merge", "M"),
+	Checkout:      newBinding("C", "check out PR in its local clone", "C"),
+	Editor:        newBinding("E", "open PR's local clone in editor", "E"),
+	RefreshPR:     newBinding("R", "refresh selected PR now", "R"),
+	Refresh:       newBinding("ctrl+r", "poll GitHub now", "ctrl+r"),
+	PRTimeline:    newBinding("H", "PR activity history (PR pane)", "H"),
+	Watch:         newBinding("w", "watch/unwatch selected PR", "w"),
+	WatchRef:      newBinding("W", "watch a PR by owner/repo#number", "W"),
+	Thread:        newBinding("v", "notification comment thread", "v"),
+	MarkRead:      newBinding("r/m", "mark notification read", "r", "m"),
+	MarkDone:      newBinding("D", "mark notification done", "D"),
+	GroupThreads:  newBinding("X", "group notifications by thread", "X"),
+	ExpandThread:  newBinding("x", "expand grouped thread", "x"),
+	Archive:       newBinding("A", "recently read notifications", "A"),
+	Filter:        newBinding("f", "cycle notification filter", "f"),
+	Sort:          newBinding("S", "cycle pane sort order", "S"),
+	UnreadOnly:    newBinding("U", "show unread notifications only", "U"),
+	LabelFilter:   newBinding("l", "filter PRs and issues by label", "l"),
+	Dashboard:     newBinding("d", "activity dashboard", "d"),
+	Timezones:     newBinding("z", "timeline activity by timezone", "z"),
+	MainBoard:     newBinding("B", "failing-main board", "B"),
+	Org:           newBinding("o", "org dashboard", "o"),
+	Subscriptions: newBinding("s", "repo subscriptions", "s"),
+	Panels:        newBinding("p", "custom panels", "p"),
+	CISettings:    newBinding("c", "CI status settings", "c"),
+	Theme:         newBinding("t", "theme selector", "t"),
+	Snapshot:      newBinding("ctrl+d", "save redacted state snapshot for bug reports", "ctrl+d"),
+	SwitchAccount: newBinding("@", "switch GitHub account", "@"),
+	Inject:        newBinding("ctrl+t", "inject synthetic events (--debug)", "ctrl+t"),
+	DebugLog:      newBinding("L", "debug log viewer (--debug)", "L"),
+}
+
+// actionName converts a mainKeyMap field name to the snake_case action name
+// used in config.toml, e.g. MarkRead to mark_read and CISettings to
+// ci_settings.
+func actionName(field string) string {
+	runes := []rune(field)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// rebindMainKeys replaces the keys of main view actions, keyed by action
+// name (see actionName). The footer keeps showing the default keys; the
+// help overlay shows the new ones.
+func rebindMainKeys(bindings map[string][]string) error {
+	v := reflect.ValueOf(&mainKeys).Elem()
+	actions := make(map[string]*key.Binding, v.NumField())
+	for i := range v.NumField() {
+		actions[actionName(v.Type().Field(i).Name)] = v.Field(i).Addr().Interface().(*key.Binding)
+	}
+	for action, keys := range bindings {
+		b, ok := actions[action]
+		if !ok {
+			return fmt.Errorf("keys: unknown action %q", action)
+		}
+		if len(keys) == 0 {
+			return fmt.Errorf("keys: %s has no keys", action)
+		}
+		b.SetKeys(keys...)
+		b.SetHelp(strings.Join(keys, "/"), b.Help().Desc)
+	}
+	return nil
+}
+
+// popupKeyMap applies to the compact --popup mode.
+type popupKeyMap struct {
+	Quit       key.Binding
+	SwitchPane key.Binding
+	Open       key.Binding
+	MarkRead   key.Binding
+	MarkDone   key.Binding
+	Thread     key.Binding
+	Filter     key.Binding
+}
+
+var popupKeys = popupKeyMap{
+	Quit:       newBinding("q/esc", "quit", "ctrl+c", "q", "esc"),
+	SwitchPane: newBinding("tab", "notifications / PRs", "tab", "shift+tab"),
+	Open:       newBinding("enter/o", "open and quit", "enter", "o"),
+	MarkRead:   newBinding("r/m/x", "mark read", "r", "m", "x"),
+	MarkDone:   newBinding("D", "mark done", "D"),
+	Thread:     newBinding("v", "comment thread", "v"),
+	Filter:     newBinding("f", "cycle filter", "f"),
+}
+
+// orgKeyMap applies to the org dashboard overlay.
+type orgKeyMap struct {
+	Close        key.Binding
+	Up           key.Binding
+	Down         key.Binding
+	NextSort     key.Binding
+	PrevSort     key.Binding
+	GroupByRepo  key.Binding
+	Open         key.Binding
+	Refresh      key.Binding
+	ReviewMatrix key.Binding
+	Heatmap      key.Binding
+	Team         key.Binding
+	SwitchOrg    key.Binding
+	Inactive     key.Binding
+	Bots         key.Binding
+	Summary      key.Binding
+}
+
+var orgKeys = orgKeyMap{
+	Close:        newBinding("esc", "close", "esc", "q"),
+	Up:           upKey,
+	Down:         downKey,
+	NextSort:     newBinding("s/→", "next sort column", "s", "right", "l"),
+	PrevSort:     newBinding("←", "previous sort column", "left", "h"),
+	GroupByRepo:  newBinding("g", "group by repo", "g"),
+	Open:         newBinding("enter", "engineer detail / open repo", "enter"),
+	Refresh:      newBinding("r", "refresh", "r"),
+	ReviewMatrix: newBinding("M", "review matrix", "M"),
+	Heatmap:      newBinding("H", "engineer heatmap", "H"),
+	Team:         newBinding("T", "set team", "T"),
+	SwitchOrg:    newBinding("O", "switch org dashboard", "O"),
+	Inactive:     newBinding("i", "show inactive members", "i"),
+	Bots:         newBinding("b", "show bot activity", "b"),
+	Summary:      newBinding("S", "org summary", "S"),
+}
+
+// engineerKeyMap applies to the engineer detail overlay.
+type engineerKeyMap struct {
+	Close   key.Binding
+	Up      key.Binding
+	Down    key.Binding
+	Open    key.Binding
+	Heatmap key.Binding
+}
+
+var engineerKeys = engineerKeyMap{
+	Close:   newBinding("esc", "back", "esc", "q"),
+	Up:      upKey,
+	Down:    downKey,
+	Open:    newBinding("enter", "open merged PR", "enter"),
+	Heatmap: newBinding("h", "activity heatmap", "h"),
+}
+
+// reviewMatrixKeyMap applies to the review reciprocity overlay.
+type reviewMatrixKeyMap struct {
+	Close   key.Binding
+	Up      key.Binding
+	Down    key.Binding
+	Refresh key.Binding
+}
+
+var reviewMatrixKeys = reviewMatrixKeyMap{
+	Close:   newBinding("esc/M", "back", "esc", "q", "M"),
+	Up:      upKey,
+	Down:    downKey,
+	Refresh: newBinding("r", "refresh", "r"),
+}
+
+// orgSummaryKeyMap applies to the org summary overlay.
+type orgSummaryKeyMap struct {
+	Close key.Binding
+}
+
+var orgSummaryKeys = orgSummaryKeyMap{
+	Close: newBinding("esc/S", "back", "esc", "q", "S"),
+}
+
+// panelsKeyMap applies to the custom query panels overlay.
+type panelsKeyMap struct {
+	Close   key.Binding
+	Next    key.Binding
+	Prev    key.Binding
+	Up      key.Binding
+	Down    key.Binding
+	Open    key.Binding
+	Refresh key.Binding
+}
+
+var panelsKeys = panelsKeyMap{
+	Close:   newBinding("esc/p", "close", "esc", "q", "p"),
+	Next:    newBinding("tab/→", "next panel", "tab", "right", "l"),
+	Prev:    newBinding("shift+tab/←", "previous panel", "shift+tab", "left", "h"),
+	Up:      upKey,
+	Down:    downKey,
+	Open:    openKey,
+	Refresh: newBinding("r", "refresh panel", "r"),
+}
+
+// subscriptionsKeyMap applies to the repo subscriptions overlay.
+type subscriptionsKeyMap struct {
+	Close         key.Binding
+	Up            key.Binding
+	Down          key.Binding
+	ToggleStarred key.Binding
+	Refresh       key.Binding
+	Open          key.Binding
+	Watch         key.Binding
+	Participating key.Binding
+	Ignore        key.Binding
+	ReleasesOnly  key.Binding
+}
+
+var subscriptionsKeys = subscriptionsKeyMap{
+	Close:         newBinding("esc", "close", "esc", "q"),
+	Up:            upKey,
+	Down:          downKey,
+	ToggleStarred: newBinding("tab", "watched / starred", "tab"),
+	Refresh:       newBinding("r", "refresh", "r"),
+	Open:          openKey,
+	Watch:         newBinding("w", "watch all activity", "w"),
+	Participating: newBinding("p/u", "unwatch (participating only)", "p", "u"),
+	Ignore:        newBinding("i", "ignore", "i"),
+	ReleasesOnly:  newBinding("R", "releases only", "R"),
+}
+
+// threadKeyMap applies to the comment thread overlay.
+type threadKeyMap struct {
+	Close    key.Binding
+	Up       key.Binding
+	Down     key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Latest   key.Binding
+	Open     key.Binding
+	Pager    key.Binding
+}
+
+var threadKeys = threadKeyMap{
+	Close:    newBinding("esc/v", "close", "esc", "q", "v"),
+	Up:       upKey,
+	Down:     downKey,
+	PageUp:   newBinding("pgup", "page up", "pgup"),
+	PageDown: newBinding("pgdown", "page down", "pgdown"),
+	Latest:   newBinding("G", "latest comment", "G", "end"),
+	Open:     openKey,
+	Pager:    newBinding("|", "read thread in $PAGER", "|"),
+}
+
+// prFilesKeyMap applies to the PR changed files overlay.
+type prFilesKeyMap struct {
+	Close key.Binding
+	Up    key.Binding
+	Down  key.Binding
+	Open  key.Binding
+	Pager key.Binding
+}
+
+var prFilesKeys = prFilesKeyMap{
+	Close: newBinding("esc/space", "close", "esc", "q", "space"),
+	Up:    upKey,
+	Down:  downKey,
+	Open:  newBinding("enter", "open files tab", "enter"),
+	Pager: newBinding("|", "read diff in $PAGER", "|"),
+}
+
+// checksKeyMap applies to the checks and artifacts overlay.
+type checksKeyMap struct {
+	Close    key.Binding
+	Up       key.Binding
+	Down     key.Binding
+	Download key.Binding
+	Open     key.Binding
+}
+
+var checksKeys = checksKeyMap{
+	Close:    newBinding("esc/a", "close", "esc", "q", "a"),
+	Up:       upKey,
+	Down:     downKey,
+	Download: newBinding("enter", "download artifact", "enter"),
+	Open:     newBinding("o", "open checks tab", "o"),
+}
+
+// groupKeyMap applies to the grouped thread overlay.
+type groupKeyMap struct {
+	Close    key.Binding
+	Up       key.Binding
+	Down     key.Binding
+	Open     key.Binding
+	MarkRead key.Binding
+	MarkDone key.Binding
+}
+
+var groupKeys = groupKeyMap{
+	Close:    newBinding("esc/x", "close", "esc", "q", "x"),
+	Up:       upKey,
+	Down:     downKey,
+	Open:     openKey,
+	MarkRead: newBinding("r/m", "mark read", "r", "m"),
+	MarkDone: newBinding("D", "mark done", "D"),
+}
+
+// archiveKeyMap applies to the read notification archive overlay.
+type archiveKeyMap struct {
+	Close      key.Binding
+	Up         key.Binding
+	Down       key.Binding
+	Open       key.Binding
+	MarkUnread key.Binding
+}
+
+var archiveKeys = archiveKeyMap{
+	Close:      newBinding("esc/A", "close", "esc", "q", "A"),
+	Up:         upKey,
+	Down:       downKey,
+	Open:       openKey,
+	MarkUnread: newBinding("u", "back to inbox as unread", "u"),
+}
+
+// mainBoardKeyMap applies to the failing-main board overlay.
+type mainBoardKeyMap struct {
+	Close key.Binding
+	Up    key.Binding
+	Down  key.Binding
+	Open  key.Binding
+}
+
+var mainBoardKeys = mainBoardKeyMap{
+	Close: newBinding("esc/B", "close", "esc", "q", "B"),
+	Up:    upKey,
+	Down:  downKey,
+	Open:  newBinding("enter", "open breaking commit", "enter"),
+}
+
+// securityKeyMap applies to the code scanning alerts overlay.
+type securityKeyMap struct {
+	Close key.Binding
+	Up    key.Binding
+	Down  key.Binding
+	Open  key.Binding
+}
+
+var securityKeys = securityKeyMap{
+	Close: newBinding("esc/!", "close", "esc", "q", "!"),
+	Up:    upKey,
+	Down:  downKey,
+	Open:  newBinding("enter", "open alert", "enter"),
+}
+
+// ciSettingsKeyMap applies to the CI status settings overlay.
+type ciSettingsKeyMap struct {
+	Close  key.Binding
+	Up     key.Binding
+	Down   key.Binding
+	Toggle key.Binding
+	Add    key.Binding
+	Remove key.Binding
+}
+
+var ciSettingsKeys = ciSettingsKeyMap{
+	Close:  newBinding("esc/c", "close", "esc", "q", "c"),
+	Up:     upKey,
+	Down:   downKey,
+	Toggle: newBinding("enter", "toggle required only", "enter", "space"),
+	Add:    newBinding("a", "add ignore pattern", "a"),
+	Remove: newBinding("x", "remove pattern", "x", "d", "delete", "backspace"),
+}
+
+// dashboardKeyMap applies to the activity dashboard overlay.
+type dashboardKeyMap struct {
+	Close   key.Binding
+	Heatmap key.Binding
+	Export  key.Binding
+}
+
+var dashboardKeys = dashboardKeyMap{
+	Close:   newBinding("esc/d", "close", "esc", "q", "d"),
+	Heatmap: newBinding("h", "activity heatmap", "h"),
+	Export:  newBinding("e", "export Markdown report", "e"),
+}
+
+// heatmapKeyMap applies to the activity heatmap overlay.
+type heatmapKeyMap struct {
+	Close key.Binding
+}
+
+var heatmapKeys = heatmapKeyMap{
+	Close: newBinding("esc/h", "back", "esc", "q", "h", "H"),
+}
+
+// timezoneKeyMap applies to the timezone activity overlay.
+type timezoneKeyMap struct {
+	Close key.Binding
+}
+
+var timezoneKeys = timezoneKeyMap{
+	Close: newBinding("esc/z", "close", "esc", "q", "z"),
+}
+
+// themeKeyMap applies to the theme selector overlay.
+type themeKeyMap struct {
+	Close key.Binding
+	Apply key.Binding
+}
+
+var themeKeys = themeKeyMap{
+	Close: newBinding("esc", "close", "esc", "q"),
+	Apply: newBinding("enter", "apply theme", "enter"),
+}
+
+// prTimelineKeyMap applies to the per-PR activity timeline overlay.
+type prTimelineKeyMap struct {
+	Close key.Binding
+	Up    key.Binding
+	Down  key.Binding
+	Open  key.Binding
+}
+
+var prTimelineKeys = prTimelineKeyMap{
+	Close: newBinding("esc/H", "close", "esc", "q", "H"),
+	Up:    upKey,
+	Down:  downKey,
+	Open:  newBinding("enter", "open event", "enter"),
+}
+
+// autoMergeKeyMap applies to the auto-merge method picker.
+type autoMergeKeyMap struct {
+	Close key.Binding
+	Up    key.Binding
+	Down  key.Binding
+	Arm   key.Binding
+}
+
+var autoMergeKeys = autoMergeKeyMap{
+	Close: newBinding("esc", "close", "esc", "q"),
+	Up:    upKey,
+	Down:  downKey,
+	Arm:   newBinding("enter", "enable auto-merge", "enter"),
+}
+
+// logKeyMap applies to the debug log viewer.
+type logKeyMap struct {
+	Close  key.Binding
+	Up     key.Binding
+	Down   key.Binding
+	Reload key.Binding
+}
+
+var logKeys = logKeyMap{
+	Close:  newBinding("esc/L", "close", "esc", "q", "L"),
+	Up:     upKey,
+	Down:   downKey,
+	Reload: newBinding("r", "reload", "r"),
+}
+
+// helpKeyMap applies to the help overlay itself.
+type helpKeyMap struct {
+	Close key.Binding
+	Up    key.Binding
+	Down  key.Binding
+}
+
+var helpKeys = helpKeyMap{
+	Close: newBinding("esc/?", "close", "esc", "q", "?"),
+	Up:    upKey,
+	Down:  downKey,
+}
+
+// keyGroup is a titled set of bindings shown together in the help overlay.
+type keyGroup struct {
+	title    string
+	bindings []key.Binding
+}
+
+// keyGroups lists every context's bindings in the order shown in the help
+// overlay. listKeys supplies the list navigation and search bindings.
+func keyGroups(listKeys list.KeyMap) []keyGroup {
+	return []keyGroup{
+		{"Main", []key.Binding{
+			mainKeys.NextPane, mainKeys.PrevPane, mainKeys.GrowPane, mainKeys.ShrinkPane,
+			mainKeys.TogglePane1, mainKeys.TogglePane2, mainKeys.TogglePane3, listKeys.CursorUp, listKeys.CursorDown, listKeys.Filter,
+			mainKeys.Open, mainKeys.Yank, mainKeys.YankRef, mainKeys.PRFiles, mainKeys.Checks, mainKeys.Security, mainKeys.Ticket, mainKeys.UpdateBranch, mainKeys.SyncFork, mainKeys.AutoMerge, mainKeys.Checkout, mainKeys.Editor, mainKeys.RefreshPR, mainKeys.Refresh, mainKeys.PRTimeline, mainKeys.Watch, mainKeys.WatchRef, mainKeys.Thread,
+			mainKeys.MarkRead, mainKeys.MarkDone, mainKeys.GroupThreads, mainKeys.ExpandThread, mainKeys.Archive, mainKeys.Filter, mainKeys.UnreadOnly, mainKeys.LabelFilter, mainKeys.Sort, mainKeys.Dashboard, mainKeys.Timezones, mainKeys.MainBoard, mainKeys.Org,
+			mainKeys.Subscriptions, mainKeys.Panels, mainKeys.CISettings, mainKeys.SwitchAccount,
+			mainKeys.Theme, mainKeys.Snapshot, mainKeys.Inject, mainKeys.DebugLog, mainKeys.Help, mainKeys.Quit,
+		}},
+		{"Popup mode", []key.Binding{
+			popupKeys.SwitchPane, popupKeys.Open, popupKeys.MarkRead, popupKeys.MarkDone,
+			popupKeys.Thread, popupKeys.Filter, mainKeys.Yank, mainKeys.YankRef, popupKeys.Quit,
+		}},
+		{"Org dashboard", []key.Binding{
+			orgKeys.Up, orgKeys.Down, orgKeys.NextSort, orgKeys.PrevSort,
+			orgKeys.GroupByRepo, orgKeys.Open, orgKeys.Refresh,
+			orgKeys.ReviewMatrix, orgKeys.Heatmap, orgKeys.Team, orgKeys.SwitchOrg, orgKeys.Inactive, orgKeys.Bots, orgKeys.Summary, orgKeys.Close,
+		}},
+		{"Engineer detail", []key.Binding{
+			engineerKeys.Up, engineerKeys.Down, engineerKeys.Open, engineerKeys.Heatmap, engineerKeys.Close,
+		}},
+		{"Org summary", []key.Binding{orgSummaryKeys.Close}},
+		{"Review matrix", []key.Binding{
+			reviewMatrixKeys.Up, reviewMatrixKeys.Down, reviewMatrixKeys.Refresh, reviewMatrixKeys.Close,
+		}},
+		{"Custom panels", []key.Binding{
+			panelsKeys.Next, panelsKeys.Prev, panelsKeys.Up, panelsKeys.Down,
+			panelsKeys.Open, panelsKeys.Refresh, panelsKeys.Close,
+		}},
+		{"Subscriptions", []key.Binding{
+			subscriptionsKeys.Up, subscriptionsKeys.Down, subscriptionsKeys.ToggleStarred,
+			subscriptionsKeys.Watch, subscriptionsKeys.Participating, subscriptionsKeys.Ignore,
+			subscriptionsKeys.ReleasesOnly,
+			subscriptionsKeys.Open, subscriptionsKeys.Refresh, subscriptionsKeys.Close,
+		}},
+		{"Comment thread", []key.Binding{
+			threadKeys.Up, threadKeys.Down, threadKeys.PageUp, threadKeys.PageDown,
+			threadKeys.Latest, threadKeys.Open, threadKeys.Pager, threadKeys.Close,
+		}},
+		{"PR files", []key.Binding{
+			prFilesKeys.Up, prFilesKeys.Down, prFilesKeys.Open, prFilesKeys.Pager, prFilesKeys.Close,
+		}},
+		{"Checks & artifacts", []key.Binding{
+			checksKeys.Up, checksKeys.Down, checksKeys.Download, checksKeys.Open, checksKeys.Close,
+		}},
+		{"Grouped thread", []key.Binding{
+			groupKeys.Up, groupKeys.Down, groupKeys.Open, groupKeys.MarkRead, groupKeys.MarkDone, groupKeys.Close,
+		}},
+		{"Read archive", []key.Binding{
+			archiveKeys.Up, archiveKeys.Down, archiveKeys.Open, archiveKeys.MarkUnread, archiveKeys.Close,
+		}},
+		{"Failing-main board", []key.Binding{
+			mainBoardKeys.Up, mainBoardKeys.Down, mainBoardKeys.Open, mainBoardKeys.Close,
+		}},
+		{"Code scanning alerts", []key.Binding{
+			securityKeys.Up, securityKeys.Down, securityKeys.Open, securityKeys.Close,
+		}},
+		{"CI settings", []key.Binding{
+			ciSettingsKeys.Up, ciSettingsKeys.Down, ciSettingsKeys.Toggle,
+			ciSettingsKeys.Add, ciSettingsKeys.Remove, ciSettingsKeys.Close,
+		}},
+		{"Dashboard", []key.Binding{dashboardKeys.Heatmap, dashboardKeys.Export, dashboardKeys.Close}},
+		{"Activity heatmap", []key.Binding{heatmapKeys.Close}},
+		{"Timezone activity", []key.Binding{timezoneKeys.Close}},
+		{"PR timeline", []key.Binding{prTimelineKeys.Up, prTimelineKeys.Down, prTimelineKeys.Open, prTimelineKeys.Close}},
+		{"Auto-merge", []key.Binding{autoMergeKeys.Up, autoMergeKeys.Down, autoMergeKeys.Arm, autoMergeKeys.Close}},
+		{"Debug log", []key.Binding{logKeys.Up, logKeys.Down, logKeys.Reload, logKeys.Close}},
+		{"Theme selector", []key.Binding{
+			listKeys.CursorUp, listKeys.CursorDown, themeKeys.Apply, themeKeys.Close,
+		}},
+		{"Text input", []key.Binding{inputKeys.Confirm, inputKeys.Cancel}},
+		{"Org input", []key.Binding{orgInputKeys.Up, orgInputKeys.Down, orgInputKeys.Complete, orgInputKeys.Forget}},
+	}
+}
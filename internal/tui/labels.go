@@ -0,0 +1,171 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// labelChip renders a label as a chip in its GitHub color, with black or
+// white text depending on which reads better on it.
+func labelChip(l github.Label) string {
+	style := lipgloss.NewStyle().Bold(true)
+	if r, g, b, ok := parseHexColor(l.Color); ok {
+		text := lipgloss.Color("#ffffff")
+		// Perceived brightness, as GitHub uses to pick label text color
+		if (r*299+g*587+b*114)/1000 > 150 {
+			text = lipgloss.Color("#000000")
+		}
+		style = style.Background(lipgloss.Color("#" + l.Color)).Foreground(text)
+	}
+	return style.Render(" " + l.Name + " ")
+}
+
+// labelChips renders labels as chips separated by spaces.
+func labelChips(labels []github.Label) string {
+	chips := make([]string, len(labels))
+	for i, l := range labels {
+		chips[i] = labelChip(l)
+	}
+	return strings.Join(chips, " ")
+}
+
+// parseHexColor parses a six-digit hex color without the leading #.
+func parseHexColor(hex string) (r, g, b int, ok bool) {
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return int(v >> 16), int(v >> 8 & 0xff), int(v & 0xff), true
+}
+
+// milestoneLabel describes a milestone with its due date, if any.
+func milestoneLabel(ms *github.Milestone) string {
+	if ms.DueOn == nil {
+		return ms.Title
+	}
+	return fmt.Sprintf("%s (due %s)", ms.Title, ms.DueOn.Local().Format("Jan 2"))
+}
+
+// labelNames joins label names for plain-text descriptions.
+func labelNames(labels []github.Label) string {
+	names := make([]string, len(labels))
+	for i, l := range labels {
+		names[i] = l.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// matchesLabelFilter reports whether labels pass the label filter: it is
+// off, or one of them has the filtered name (case-insensitively).
+func (m *Model) matchesLabelFilter(labels []github.Label) bool {
+	if m.labelFilter == "" {
+		return true
+	}
+	for _, l := range labels {
+		if strings.EqualFold(l.Name, m.labelFilter) {
+			return true
+		}
+	}
+	return false
+}
+
+// labelFilterBadge returns the list title suffix naming the active label
+// filter, or "".
+func (m *Model) labelFilterBadge() string {
+	if m.labelFilter == "" {
+		return ""
+	}
+	return " · label:" + m.labelFilter
+}
+
+// newLabelInput creates the input for the label filter.
+func newLabelInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "label name, empty to clear"
+	ti.CharLimit = 100
+	ti.SetWidth(40)
+	return ti
+}
+
+// openLabelFilter shows the prompt for filtering PRs and assigned issues by
+// label.
+func (m *Model) openLabelFilter() tea.Cmd {
+	m.showLabelInput = true
+	m.labelInput.SetValue(m.labelFilter)
+	m.labelInput.CursorEnd()
+	return m.labelInput.Focus()
+}
+
+// handleLabelInputKey handles key events in the label filter prompt.
+func (m *Model) handleLabelInputKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, inputKeys.Cancel):
+		m.showLabelInput = false
+		return m, nil
+	case key.Matches(msg, inputKeys.Confirm):
+		m.showLabelInput = false
+		m.labelFilter = strings.TrimSpace(m.labelInput.Value())
+		m.updateNotifications(nil)
+		m.updatePRList()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.labelInput, cmd = m.labelInput.Update(msg)
+	return m, cmd
+}
+
+// renderLabelInput renders the label filter prompt, listing the labels on
+// my PRs and assigned issues to pick from.
+func (m *Model) renderLabelInput() string {
+	maxWidth := max(min(64, m.width-4), 30)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Filter by Label"))
+	b.WriteString("\n\n")
+	b.WriteString(m.labelInput.View())
+	b.WriteString("\n\n")
+
+	seen := make(map[string]bool)
+	var known []github.Label
+	add := func(labels []github.Label) {
+		for _, l := range labels {
+			if !seen[strings.ToLower(l.Name)] {
+				seen[strings.ToLower(l.Name)] = true
+				known = append(known, l)
+			}
+		}
+	}
+	for _, info := range m.prInfos {
+		add(info.Labels)
+	}
+	for _, issue := range m.assignedIssues {
+		add(issue.Labels)
+	}
+	if len(known) > 0 {
+		b.WriteString(lipgloss.NewStyle().Width(maxWidth - 6).Render(labelChips(known)))
+		b.WriteString("\n\n")
+	}
+	b.WriteString(subtleStyle.Render("enter: apply  esc: cancel"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,248 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/jpoz/hubell/internal/config"
+)
+
+// resizeStep is how much relative width ctrl+left/right moves between panes.
+const resizeStep = 5
+
+// minPaneWidth is the smallest relative width a pane can be resized to.
+const minPaneWidth = 10
+
+// Below singlePaneBelow columns side-by-side panes get too narrow to read,
+// so the main view shows only the focused pane under a tab bar. Below
+// minimalBelow columns or minimalBelowHeight rows it also drops the borders
+// and most of the help line.
+const (
+	singlePaneBelow    = 100
+	minimalBelow       = 60
+	minimalBelowHeight = 16
+)
+
+// paneLabels are the panes' names in the tab bar.
+var paneLabels = map[Pane]string{
+	TimelinePane: "Timeline",
+	LeftPane:     "Notifications",
+	RightPane:    "PRs",
+}
+
+// paneNames maps layout.json pane names to panes.
+var paneNames = map[string]Pane{
+	config.PaneTimeline:      TimelinePane,
+	config.PaneNotifications: LeftPane,
+	config.PanePRs:           RightPane,
+}
+
+// shownLayout returns the indices into the layout of the panes that are not
+// hidden, in layout order.
+func (m *Model) shownLayout() []int {
+	var shown []int
+	for i, lp := range m.layout.Panes {
+		if !lp.Hidden {
+			shown = append(shown, i)
+		}
+	}
+	return shown
+}
+
+// visiblePanes returns the panes shown in the main view in layout order.
+func (m *Model) visiblePanes() []Pane {
+	shown := m.shownLayout()
+	panes := make([]Pane, 0, len(shown))
+	for _, i := range shown {
+		panes = append(panes, paneNames[m.layout.Panes[i].Name])
+	}
+	return panes
+}
+
+// visibleIndex returns the position of p among the visible panes, or -1 if
+// it is hidden.
+func (m *Model) visibleIndex(p Pane) int {
+	for i, vp := range m.visiblePanes() {
+		if vp == p {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextPane moves focus to the next visible pane in layout order.
+func (m *Model) nextPane() {
+	panes := m.visiblePanes()
+	i := m.visibleIndex(m.focusedPane)
+	m.focusedPane = panes[(i+1)%len(panes)]
+}
+
+// prevPane moves focus to the previous visible pane in layout order.
+func (m *Model) prevPane() {
+	panes := m.visiblePanes()
+	i := m.visibleIndex(m.focusedPane)
+	m.focusedPane = panes[(i-1+len(panes))%len(panes)]
+}
+
+// togglePane hides or shows the n-th pane of the layout (0-based) and
+// persists the change. The last visible pane can't be hidden.
+func (m *Model) togglePane(n int) {
+	if n >= len(m.layout.Panes) {
+		return
+	}
+	lp := &m.layout.Panes[n]
+	if !lp.Hidden && len(m.shownLayout()) == 1 {
+		return
+	}
+	lp.Hidden = !lp.Hidden
+	if lp.Hidden && paneNames[lp.Name] == m.focusedPane {
+		m.focusedPane = m.visiblePanes()[0]
+	}
+	if err := config.SaveLayout(m.layout); err != nil {
+		m.err = err
+	}
+}
+
+// resizeFocusedPane grows (delta > 0) or shrinks the focused pane, trading
+// width with its right neighbour (or left, for the last pane), and persists
+// the new layout.
+func (m *Model) resizeFocusedPane(delta int) {
+	shown := m.shownLayout()
+	v := m.visibleIndex(m.focusedPane)
+	if v < 0 || len(shown) < 2 {
+		return
+	}
+	w := v + 1
+	if w == len(shown) {
+		w = v - 1
+	}
+	a, b := &m.layout.Panes[shown[v]], &m.layout.Panes[shown[w]]
+	if a.Width+delta < minPaneWidth || b.Width-delta < minPaneWidth {
+		return
+	}
+	a.Width += delta
+	b.Width -= delta
+	if err := config.SaveLayout(m.layout); err != nil {
+		m.err = err
+	}
+}
+
+// stacked reports whether panes are stacked vertically at the current width.
+func (m *Model) stacked() bool {
+	return m.layout.StackBelow > 0 && m.width < m.layout.StackBelow
+}
+
+// singlePane reports whether the main view shows only the focused pane at
+// the current width. Stacking, when configured, takes precedence.
+func (m *Model) singlePane() bool {
+	return !m.stacked() && m.width < singlePaneBelow
+}
+
+// minimal reports whether the terminal is too small for borders and tabs.
+func (m *Model) minimal() bool {
+	return m.width < minimalBelow || m.height < minimalBelowHeight
+}
+
+// splitSizes divides total between the visible panes by their relative
+// widths; the last pane absorbs rounding.
+func (m *Model) splitSizes(total int) []int {
+	shown := m.shownLayout()
+	sum := 0
+	for _, i := range shown {
+		sum += m.layout.Panes[i].Width
+	}
+	sizes := make([]int, len(shown))
+	used := 0
+	for v, i := range shown {
+		if v == len(sizes)-1 {
+			sizes[v] = total - used
+			break
+		}
+		sizes[v] = total * m.layout.Panes[i].Width / sum
+		used += sizes[v]
+	}
+	return sizes
+}
+
+// renderPanes lays out the visible panes side by side, or stacked or one at
+// a time on narrow terminals, within the given outer height.
+func (m *Model) renderPanes(height int) string {
+	switch {
+	case m.minimal():
+		return m.renderMinimalPane(height)
+	case m.singlePane():
+		return m.renderTabBar() + "\n" + m.renderPane(m.focusedPane, m.width, max(height-1, 0))
+	}
+	panes := m.visiblePanes()
+	rendered := make([]string, len(panes))
+	if m.stacked() {
+		for i, h := range m.splitSizes(height) {
+			rendered[i] = m.renderPane(panes[i], m.width, h)
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, rendered...)
+	}
+	for i, w := range m.splitSizes(m.width) {
+		rendered[i] = m.renderPane(panes[i], w, height)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+}
+
+// renderPane renders one bordered pane at the given outer size.
+func (m *Model) renderPane(p Pane, width, height int) string {
+	contentWidth := max(width-2, 0)
+	contentHeight := max(height-2, 0)
+
+	style := m.unfocusedPaneStyle()
+	if m.focusedPane == p {
+		style = m.focusedPaneStyle()
+	}
+	style = style.Width(contentWidth).Height(contentHeight)
+	return style.Render(m.renderPaneContent(p, contentWidth, contentHeight))
+}
+
+// renderPaneContent renders a pane's list sized to the given inner size.
+func (m *Model) renderPaneContent(p Pane, width, height int) string {
+	switch p {
+	case TimelinePane:
+		m.timelineList.SetSize(width, max(height-1, 0))
+		return m.renderTimelineVelocity(width) + "\n" + m.timelineList.View()
+	case LeftPane:
+		m.list.SetSize(width, height)
+		return m.list.View()
+	default:
+		m.prList.SetSize(width, height)
+		return m.prList.View()
+	}
+}
+
+// renderTabBar renders the visible panes' names, the focused one
+// highlighted, for the single-pane view.
+func (m *Model) renderTabBar() string {
+	activeStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true).Underline(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+
+	var tabs []string
+	for _, p := range m.visiblePanes() {
+		if p == m.focusedPane {
+			tabs = append(tabs, activeStyle.Render(paneLabels[p]))
+		} else {
+			tabs = append(tabs, subtleStyle.Render(paneLabels[p]))
+		}
+	}
+	bar := " " + strings.Join(tabs, subtleStyle.Render(" │ "))
+	return ansi.Truncate(bar, m.width, "…")
+}
+
+// renderMinimalPane renders only the focused pane's list, without a border,
+// under a one-line header naming it.
+func (m *Model) renderMinimalPane(height int) string {
+	header := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true).Render(paneLabels[m.focusedPane])
+	if n := len(m.visiblePanes()); n > 1 {
+		header += lipgloss.NewStyle().Foreground(m.theme.Subtle).Render(
+			fmt.Sprintf(" %d/%d", m.visibleIndex(m.focusedPane)+1, n))
+	}
+	content := m.renderPaneContent(m.focusedPane, m.width, max(height-1, 0))
+	return ansi.Truncate(header, m.width, "…") + "\n" + content
+}
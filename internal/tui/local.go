@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// localPR is a PR to act on in its local clone.
+type localPR struct {
+	owner, repo string
+	number      int
+	branch      string // empty when only the number is known
+}
+
+// selectedLocalPR returns the PR selected in the focused pane: a PR, a
+// pull request notification or a timeline event.
+func (m *Model) selectedLocalPR() (localPR, bool) {
+	switch m.focusedPane {
+	case RightPane:
+		if item, ok := m.prList.SelectedItem().(PRItem); ok {
+			return localPR{item.info.Owner, item.info.Repo, item.info.Number, item.info.Branch}, true
+		}
+	case LeftPane:
+		if item, ok := m.list.SelectedItem().(NotificationItem); ok && item.notification.Subject.Type == "PullRequest" {
+			if owner, repo, number, ok := github.ParseSubjectURL(item.notification.Subject.URL); ok {
+				return localPR{owner: owner, repo: repo, number: number}, true
+			}
+		}
+	case TimelinePane:
+		if item, ok := m.timelineList.SelectedItem().(TimelineEvent); ok && item.Number > 0 {
+			return localPR{owner: item.Owner, repo: item.Repo, number: item.Number}, true
+		}
+	}
+	return localPR{}, false
+}
+
+// localPath returns the local clone of a PR's repo, setting a notice saying
+// how to map it when there is none.
+func (m *Model) localPath(pr localPR) string {
+	path := m.repoPaths.PathFor(pr.owner, pr.repo)
+	if path == "" {
+		m.notice = fmt.Sprintf("no local clone of %s/%s; map it under [repo_paths] repos", pr.owner, pr.repo)
+		m.noticeLink = ""
+	}
+	return path
+}
+
+// checkoutCommand returns the configured checkout command, or the default:
+// gh when it's installed, else a plain git fetch of the PR's head.
+func (m *Model) checkoutCommand() string {
+	if m.repoPaths.Checkout != "" {
+		return m.repoPaths.Checkout
+	}
+	if _, err := exec.LookPath("gh"); err == nil {
+		return "gh pr checkout {number}"
+	}
+	return "git fetch origin pull/{number}/head:pr-{number} && git checkout pr-{number}"
+}
+
+// checkoutSelected checks out the selected PR in its repo's local clone.
+func (m *Model) checkoutSelected() tea.Cmd {
+	pr, ok := m.selectedLocalPR()
+	if !ok {
+		return nil
+	}
+	path := m.localPath(pr)
+	if path == "" {
+		return nil
+	}
+	ref := fmt.Sprintf("%s/%s#%d", pr.owner, pr.repo, pr.number)
+	m.notice = "checking out " + ref + "…"
+	m.noticeLink = ""
+	return runLocalCommand(m.ctx, path, expandLocalCommand(m.checkoutCommand(), pr, path), "checked out "+ref)
+}
+
+// editorCommand returns the configured editor command, or $VISUAL or
+// $EDITOR, with {path} appended when the command doesn't place it.
+func (m *Model) editorCommand() string {
+	command := m.repoPaths.Editor
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if command == "" {
+			command = os.Getenv(env)
+		}
+	}
+	if command == "" {
+		command = "vi"
+	}
+	if !strings.Contains(command, "{path}") {
+		command += " {path}"
+	}
+	return command
+}
+
+// openInEditor opens the selected PR's local clone in the editor. It runs
+// in the foreground so terminal editors get the screen; GUI editors return
+// right away.
+func (m *Model) openInEditor() tea.Cmd {
+	pr, ok := m.selectedLocalPR()
+	if !ok {
+		return nil
+	}
+	path := m.localPath(pr)
+	if path == "" {
+		return nil
+	}
+	command := expandLocalCommand(m.editorCommand(), pr, path)
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Dir = path
+	done := fmt.Sprintf("opened %s/%s in the editor", pr.owner, pr.repo)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		if err != nil {
+			return LocalCommandMsg{Err: fmt.Errorf("editor %q: %w", command, err)}
+		}
+		return LocalCommandMsg{Done: done}
+	})
+}
+
+// expandLocalCommand fills a command template's placeholders with the PR's
+// details, shell-quoted.
+func expandLocalCommand(command string, pr localPR, path string) string {
+	return strings.NewReplacer(
+		"{number}", strconv.Itoa(pr.number),
+		"{branch}", shellQuote(pr.branch),
+		"{owner}", shellQuote(pr.owner),
+		"{repo}", shellQuote(pr.repo),
+		"{path}", shellQuote(path),
+	).Replace(command)
+}
+
+// shellQuote quotes s for sh.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// runLocalCommand creates a command that runs a shell command in dir and
+// reports done on success, or the error with the command's last output line.
+func runLocalCommand(ctx context.Context, dir, command, done string) tea.Cmd {
+	return func() tea.Msg {
+		cmd := exec.CommandContext(ctx, "sh", "-c", command)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+			if last := lines[len(lines)-1]; last != "" {
+				err = fmt.Errorf("%w: %s", err, last)
+			}
+			return LocalCommandMsg{Err: fmt.Errorf("%s in %s: %w", command, dir, err)}
+		}
+		return LocalCommandMsg{Done: done}
+	}
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/jpoz/hubell/internal/debuglog"
+)
+
+// logViewLines is how many of the debug log's last lines the viewer loads.
+const logViewLines = 500
+
+// openLogView loads the end of the debug log and shows it, scrolled to the
+// newest line.
+func (m *Model) openLogView() {
+	m.logLines, m.logErr = debuglog.Tail(logViewLines)
+	m.logScroll = len(m.logLines) // clamped when rendering
+	m.showLog = true
+}
+
+// handleLogKey handles key events in the debug log viewer.
+func (m *Model) handleLogKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, logKeys.Close):
+		m.showLog = false
+	case key.Matches(msg, logKeys.Up):
+		if m.logScroll > 0 {
+			m.logScroll--
+		}
+	case key.Matches(msg, logKeys.Down):
+		m.logScroll++ // clamped when rendering
+	case key.Matches(msg, logKeys.Reload):
+		m.openLogView()
+	}
+	return m, nil
+}
+
+// renderLogView renders the tail of the debug log: API requests, poll
+// timings and rate limits.
+func (m *Model) renderLogView() string {
+	maxWidth := max(m.width-2, 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.StatusFailure)
+
+	innerWidth := maxWidth - 6
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Debug log"))
+	b.WriteString(subtleStyle.Render("  " + debuglog.Path()))
+	b.WriteString("\n\n")
+
+	visible := max(maxHeight-8, 3)
+	switch {
+	case m.logErr != nil:
+		b.WriteString(errorStyle.Render(m.logErr.Error()))
+		b.WriteString("\n")
+	case len(m.logLines) == 0:
+		b.WriteString(subtleStyle.Render("  Nothing logged yet."))
+		b.WriteString("\n")
+	default:
+		maxScroll := max(len(m.logLines)-visible, 0)
+		m.logScroll = min(m.logScroll, maxScroll)
+		end := min(m.logScroll+visible, len(m.logLines))
+		for _, line := range m.logLines[m.logScroll:end] {
+			style := normalStyle
+			if strings.Contains(line, "err=") && !strings.Contains(line, "err=<nil>") {
+				style = errorStyle
+			}
+			b.WriteString(style.Render(ansi.Truncate(line, innerWidth, "…")))
+			b.WriteString("\n")
+		}
+		if len(m.logLines) > visible {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (lines %d-%d of %d)", m.logScroll+1, end, len(m.logLines))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑/↓: scroll  r: reload  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
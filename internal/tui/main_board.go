@@ -0,0 +1,129 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+)
+
+// mainBoardLoading reports whether the open board is waiting for its first
+// refresh.
+func (m *Model) mainBoardLoading() bool {
+	return m.showMainBoard && m.mainBoardEnabled && m.failingMains == nil
+}
+
+// handleMainBoardKey handles key events in the failing-main board overlay.
+func (m *Model) handleMainBoardKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, mainBoardKeys.Close):
+		m.showMainBoard = false
+		return m, nil
+
+	case key.Matches(msg, mainBoardKeys.Up):
+		if m.mainBoardIndex > 0 {
+			m.mainBoardIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, mainBoardKeys.Down):
+		if m.mainBoardIndex < len(m.failingMains)-1 {
+			m.mainBoardIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, mainBoardKeys.Open):
+		if m.mainBoardIndex < len(m.failingMains) {
+			if err := browser.Open(m.failingMains[m.mainBoardIndex].URL()); err != nil {
+				m.err = err
+			}
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// renderMainBoard renders the failing-main board: every watched default
+// branch that is red, longest red first.
+func (m *Model) renderMainBoard() string {
+	maxWidth := max(min(100, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	failStyle := lipgloss.NewStyle().Foreground(m.theme.StatusFailure).Bold(true)
+	successStyle := lipgloss.NewStyle().Foreground(m.theme.StatusSuccess)
+
+	innerWidth := maxWidth - 6
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Failing main branches"))
+	if onCall := m.rotation.OnCall(time.Now()); onCall != "" {
+		b.WriteString(subtleStyle.Render("  on call: @" + onCall))
+	}
+	b.WriteString("\n\n")
+
+	switch {
+	case !m.mainBoardEnabled:
+		b.WriteString(subtleStyle.Render("The board is off. Enable it in main_board.json:"))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render(`  {"enabled": true, "repos": ["owner/repo"]}`))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("Without repos it watches every repo in the configured org."))
+		b.WriteString("\n")
+	case m.failingMains == nil:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true).Render(fmt.Sprintf(" %s Checking default branches...", spinner)))
+		b.WriteString("\n")
+	case len(m.failingMains) == 0:
+		b.WriteString(successStyle.Render("✓ All watched default branches are green"))
+		b.WriteString("\n")
+	default:
+		// Each branch takes two lines: repo and duration, then the breaking commit
+		visibleRows := max((maxHeight-8)/2, 3)
+		scrollOffset := 0
+		if m.mainBoardIndex >= visibleRows {
+			scrollOffset = m.mainBoardIndex - visibleRows + 1
+		}
+		endIdx := min(scrollOffset+visibleRows, len(m.failingMains))
+		for i := scrollOffset; i < endIdx; i++ {
+			h := m.failingMains[i]
+			name := fmt.Sprintf("%s %s", h.FullName(), h.Branch)
+			if i == m.mainBoardIndex {
+				b.WriteString(selectedStyle.Render("▸ " + name))
+			} else {
+				b.WriteString(normalStyle.Render("  " + name))
+			}
+			if !h.RedSince.IsZero() {
+				b.WriteString(failStyle.Render("  ✗ red for " + formatMergeDuration(time.Since(h.RedSince))))
+			}
+			b.WriteString("\n")
+			commit := fmt.Sprintf("    %.7s @%s: %s", h.BreakingSHA, h.BreakingAuthor, h.BreakingMessage)
+			b.WriteString(subtleStyle.Render(truncateOrgLoadingText(commit, innerWidth)))
+			b.WriteString("\n")
+		}
+		if len(m.failingMains) > visibleRows {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(m.failingMains))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑↓: navigate  enter: open breaking commit  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/calendar"
+	"github.com/jpoz/hubell/internal/notify"
+)
+
+// meetingCheckInterval is how often the calendar is consulted.
+const meetingCheckInterval = time.Minute
+
+// checkMeeting asks the tracker whether a meeting is in progress.
+func checkMeeting(ctx context.Context, tracker *calendar.Tracker) tea.Cmd {
+	return func() tea.Msg {
+		meeting, active, ended, err := tracker.Check(ctx, time.Now())
+		return MeetingStateMsg{Meeting: meeting.Summary, Active: active, Ended: ended, Err: err}
+	}
+}
+
+// meetingTick schedules the next calendar check.
+func (m *Model) meetingTick() tea.Cmd {
+	return tea.Tick(meetingCheckInterval, func(time.Time) tea.Msg {
+		return checkMeeting(m.ctx, m.meetingTracker)()
+	})
+}
+
+// setMeetingState applies a calendar check, delivering the digest of held
+// back notifications when a meeting ends.
+func (m *Model) setMeetingState(msg MeetingStateMsg) tea.Cmd {
+	if msg.Ended {
+		m.meetingDigest.Flush(fmt.Sprintf("While you were in %q", m.meetingName))
+	}
+	m.inMeeting = msg.Active
+	m.meetingName = ""
+	if msg.Active {
+		m.meetingName = msg.Meeting
+	}
+	if msg.Err != nil {
+		m.err = fmt.Errorf("calendar: %w", msg.Err)
+	}
+	return m.meetingTick()
+}
+
+// desktopNotify sends a desktop notification, or queues it for the digest
+// while a meeting is in progress.
+func (m *Model) desktopNotify(title, body string) {
+	if m.inMeeting {
+		m.meetingDigest.Add(title, body)
+		return
+	}
+	sendDesktopNotification(title, body)
+}
+
+// sendDesktopNotification is replaced in tests.
+var sendDesktopNotification = notify.SendDesktopNotification
+
+// statusIndicators returns the low-power and meeting badges and any pending
+// notice for the help line, or "" when none applies.
+func (m *Model) statusIndicators() string {
+	s := m.powerIndicator()
+	if label := m.accountLabel(); label != "" {
+		s += lipgloss.NewStyle().Foreground(m.theme.Accent).Render(label) + " | "
+	}
+	if m.inMeeting {
+		label := fmt.Sprintf("⏸ in meeting, %d held", m.meetingDigest.Len())
+		s += lipgloss.NewStyle().Foreground(m.theme.StatusPending).Render(label) + " | "
+	}
+	if m.notice != "" {
+		style := lipgloss.NewStyle().Foreground(m.theme.StatusSuccess)
+		if m.noticeLink != "" {
+			// OSC 8, so the link can be clicked in the local terminal
+			style = style.Hyperlink(m.noticeLink)
+		}
+		s += style.Render("✓ "+m.notice) + " | "
+	}
+	return s
+}
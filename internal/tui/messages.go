@@ -1,18 +1,44 @@
 package tui
 
 import (
+	"time"
+
 	"github.com/jpoz/hubell/internal/github"
+	"github.com/jpoz/hubell/internal/power"
 )
 
-// PollResultMsg is sent when new poll results are received
+// PollResultMsg is sent when a poll completes with new results
 type PollResultMsg struct {
 	Notifications      []*github.Notification
 	PRStatuses         map[string]github.PRStatus
 	PRInfos            map[string]github.PRInfo
-	PRChanges          []github.PRStatusChange
 	MergedPRs          []github.MergedPRInfo
 	WeeklyMergedCounts map[string]int
 	CommentDetails     map[string]*github.CommentDetail
+	AssignedIssues     []github.SearchItem
+	// SecretScanningAlerts and FailingMains mirror github.PollResult.
+	SecretScanningAlerts []github.SecretScanningAlert
+	FailingMains         []github.BranchHealth
+	// Errors are the sources that failed this poll; their panes keep the
+	// previous data, marked stale.
+	Errors map[github.PollSource]error
+	// ClosedWatched are watchlist PRs that were closed or merged.
+	ClosedWatched []string
+	// Mentions are the comments behind mention notifications.
+	Mentions map[string]*github.Mention
+	// SubjectDetails are the releases and discussions behind notifications.
+	SubjectDetails map[string]*github.SubjectDetail
+	// PolledAt, Interval and RateLimit time the next poll and show the
+	// API budget left in the status bar.
+	PolledAt  time.Time
+	Interval  time.Duration
+	RateLimit github.RateLimit
+}
+
+// PollEventMsg carries a change event published by the poller ahead of the
+// poll's PollResultMsg, or the PollCompleted of a poll with nothing to show.
+type PollEventMsg struct {
+	Event github.Event
 }
 
 // ErrorMsg is sent when an error occurs
@@ -30,7 +56,12 @@ type MarkAsReadSuccessMsg struct {
 	ThreadID string
 }
 
-// MarkAsReadErrorMsg is sent when marking as read fails
+// MarkAsDoneSuccessMsg is sent when marking as done succeeds
+type MarkAsDoneSuccessMsg struct {
+	ThreadID string
+}
+
+// MarkAsReadErrorMsg is sent when marking as read or done fails
 type MarkAsReadErrorMsg struct {
 	Err error
 }
@@ -46,12 +77,14 @@ type LoadingProgressMsg struct {
 // OrgLoadingProgressMsg relays org activity loading progress updates.
 type OrgLoadingProgressMsg struct {
 	github.OrgLoadingProgress
+	load <-chan github.OrgLoadingProgress // identifies the load
 }
 
 // OrgDataMsg delivers org overview data to the TUI
 type OrgDataMsg struct {
 	Members []github.OrgMemberActivity
 	Summary github.OrgActivitySummary
+	load    <-chan github.OrgLoadingProgress
 }
 
 // EngineerDetailMsg delivers drill-down data for a single engineer
@@ -59,7 +92,198 @@ type EngineerDetailMsg struct {
 	Detail *github.EngineerDetail
 }
 
+// EngineerDetailPartialMsg delivers a snapshot of engineer data that is
+// still being enriched
+type EngineerDetailPartialMsg struct {
+	Detail *github.EngineerDetail
+}
+
 // OrgErrorMsg reports an error from org data fetching
 type OrgErrorMsg struct {
+	Err  error
+	load <-chan github.OrgLoadingProgress // nil for engineer detail errors
+}
+
+// SubscriptionsMsg delivers the repositories the user is watching or has starred
+type SubscriptionsMsg struct {
+	Repos   []github.Repository
+	Starred bool
+	// States are the watched repos' subscription states by full name;
+	// repos whose state couldn't be fetched are missing
+	States map[string]github.SubscriptionState
+}
+
+// SubscriptionUpdatedMsg is sent when a repo subscription change succeeds
+type SubscriptionUpdatedMsg struct {
+	FullName string
+	State    github.SubscriptionState
+}
+
+// SubscriptionErrorMsg reports an error from fetching or updating subscriptions
+type SubscriptionErrorMsg struct {
+	Err error
+}
+
+// ReviewMatrixMsg delivers the who-reviews-whom matrix for the org overlay
+type ReviewMatrixMsg struct {
+	Matrix *github.ReviewMatrix
+}
+
+// UserOrgsMsg delivers the organizations username belongs to
+type UserOrgsMsg struct {
+	Username string
+	Orgs     []string
+	Err      error
+}
+
+// ReviewTurnaroundMsg delivers my review turnaround for the dashboard
+type ReviewTurnaroundMsg struct {
+	Username   string
+	Turnaround *github.ReviewTurnaround
+	Err        error
+}
+
+// ReviewMatrixErrorMsg reports an error from building the review matrix
+type ReviewMatrixErrorMsg struct {
+	Err error
+}
+
+// PanelResultMsg delivers the result of a custom panel query
+type PanelResultMsg struct {
+	Index int
+	Rows  []map[string]any
+	Err   error
+}
+
+// PowerStateMsg delivers a fresh battery / metered connection reading
+type PowerStateMsg struct {
+	State power.State
+}
+
+// MeetingStateMsg reports whether a calendar meeting is in progress
+type MeetingStateMsg struct {
+	Meeting string
+	Active  bool
+	Ended   bool
+	Err     error
+}
+
+// PRFilesMsg delivers the changed files of a PR. For conflicted PRs it also
+// carries the files that changed on the base branch too.
+type PRFilesMsg struct {
+	Key         string
+	Files       []github.PullRequestFile
+	Conflicts   []string
+	ConflictErr error
+}
+
+// PRFilesErrorMsg reports an error listing a PR's changed files
+type PRFilesErrorMsg struct {
+	Key string
+	Err error
+}
+
+// ClipboardErrorMsg reports a failure of the platform clipboard tool
+type ClipboardErrorMsg struct {
+	Err error
+}
+
+// ArtifactsMsg delivers the CI artifacts available for a PR
+type ArtifactsMsg struct {
+	Key       string
+	Artifacts []github.Artifact
+	Err       error
+}
+
+// ArtifactDownloadMsg reports the outcome of an artifact download
+type ArtifactDownloadMsg struct {
+	Name string
+	Path string
+	Err  error
+}
+
+// ThreadCommentsMsg delivers the latest comments of a notification's thread
+// along with its labels and milestone
+type ThreadCommentsMsg struct {
+	ThreadID  string
+	Comments  []github.ThreadComment
+	Labels    []github.Label
+	Milestone *github.Milestone
+	Err       error
+}
+
+// PRRefreshMsg carries a PR re-fetched on demand and, when it was refreshed
+// from a notification, the notification's latest comment
+type PRRefreshMsg struct {
+	Key            string
+	Status         github.PRStatus
+	Info           github.PRInfo
+	NotificationID string
+	Comment        *github.CommentDetail
+	Err            error
+}
+
+// BranchUpdateMsg reports the outcome of an update-branch request
+type BranchUpdateMsg struct {
+	Key    string
+	Result string
+	Err    error
+}
+
+// OpenURLMsg carries a URL that was not opened on this machine (e.g. when
+// running over SSH) so it can be shown as a link and copied instead
+type OpenURLMsg struct {
+	URL string
+}
+
+// PRTimelineMsg delivers a PR's activity timeline
+type PRTimelineMsg struct {
+	Key    string
+	Events []github.PRTimelineEvent
+	Err    error
+}
+
+// LocalCommandMsg reports the outcome of a command run in a local clone
+type LocalCommandMsg struct {
+	Done string
+	Err  error
+}
+
+// AutoMergeMsg reports the outcome of arming (Method set) or disarming
+// auto-merge on a PR
+type AutoMergeMsg struct {
+	Key    string
+	Method string
+	Err    error
+}
+
+// ForkSyncMsg reports the outcome of a fork sync request
+type ForkSyncMsg struct {
+	Key    string
+	Result string
+	Err    error
+}
+
+// TicketStatusMsg delivers the status of an external ticket
+type TicketStatusMsg struct {
+	Key    string
+	Status string
+	Err    error
+}
+
+// StatusTickMsg redraws the status bar's poll countdown
+type StatusTickMsg struct{}
+
+// ThemeFilesMsg reports the state of the theme files, to reload them when
+// one changed
+type ThemeFilesMsg struct {
+	Stamp string
+}
+
+// PanelPollMsg triggers a refresh of all custom panels
+type PanelPollMsg struct{}
+
+// PagerFinishedMsg is sent when $PAGER exits and the TUI resumes
+type PagerFinishedMsg struct {
 	Err error
 }
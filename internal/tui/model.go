@@ -3,18 +3,25 @@ package tui
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/list"
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/jpoz/hubell/internal/calendar"
 	"github.com/jpoz/hubell/internal/config"
 	"github.com/jpoz/hubell/internal/github"
 	"github.com/jpoz/hubell/internal/notify"
+	"github.com/jpoz/hubell/internal/power"
+	"github.com/jpoz/hubell/internal/tickets"
 )
 
 //go:embed banner.txt
@@ -25,6 +32,22 @@ type NotificationItem struct {
 	notification  *github.Notification
 	ciStatus      github.PRStatus
 	commentDetail *github.CommentDetail
+	// mention is the comment that mentioned the user, shown instead of
+	// the latest comment in the mentions filter
+	mention *github.Mention
+	// subject is the release or discussion the notification is about
+	subject *github.SubjectDetail
+	// grouped are older notifications on the same issue or PR, collapsed
+	// into this item in grouping mode
+	grouped []*github.Notification
+	// wrapWidth and descLines are set by the delegate when it shows more
+	// than one description line
+	wrapWidth int
+	descLines int
+	// fading is set for read notifications about to be hidden
+	fading bool
+	// refreshing is a spinner frame while the PR is re-fetched on demand
+	refreshing string
 }
 
 // FilterValue implements list.Item
@@ -35,8 +58,10 @@ func (i NotificationItem) FilterValue() string {
 // Title implements list.DefaultItem
 func (i NotificationItem) Title() string {
 	unreadIndicator := " "
-	if i.notification.Unread {
-		unreadIndicator = "•"
+	for _, n := range i.thread() {
+		if n.Unread {
+			unreadIndicator = icons.Unread
+		}
 	}
 
 	ciIndicator := ""
@@ -49,17 +74,58 @@ func (i NotificationItem) Title() string {
 		ciIndicator = " [...]"
 	}
 
-	return fmt.Sprintf("%s [%s] %s%s",
+	more := ""
+	if len(i.grouped) > 0 {
+		more = fmt.Sprintf(" +%d more", len(i.grouped))
+	}
+
+	refreshing := ""
+	if i.refreshing != "" {
+		refreshing = " " + i.refreshing
+	}
+
+	return fmt.Sprintf("%s %s[%s] %s%s%s%s",
 		unreadIndicator,
+		subjectIcon(i.notification.Subject.Type),
 		i.notification.Repository.FullName,
 		i.notification.Subject.Title,
-		ciIndicator)
+		ciIndicator,
+		refreshing,
+		more)
 }
 
-// Description implements list.DefaultItem
+// Description implements list.DefaultItem. When the delegate shows more than
+// one description line it is soft-wrapped to fit them.
 func (i NotificationItem) Description() string {
+	desc := i.description()
+	if i.descLines < 2 || i.wrapWidth <= 0 {
+		return desc
+	}
+	lines := strings.Split(ansi.Wordwrap(desc, i.wrapWidth, ""), "\n")
+	if len(lines) > i.descLines {
+		// The delegate truncates the last line with an ellipsis
+		lines = append(lines[:i.descLines-1], strings.Join(lines[i.descLines-1:], " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// description returns the one-line description: the latest comment or
+// review when known, otherwise the notification reason.
+func (i NotificationItem) description() string {
 	timeStr := formatDuration(time.Since(i.notification.UpdatedAt))
 
+	if mention := i.mention; mention != nil {
+		return fmt.Sprintf("@%s: \"%s\" · %s", mention.Author, mention.Excerpt, timeStr)
+	}
+	if s := i.subject; s != nil {
+		switch i.notification.Subject.Type {
+		case "Release":
+			return releaseDescription(s, timeStr)
+		case "Discussion":
+			return discussionDescription(s, i.commentDetail, timeStr)
+		}
+	}
+
 	d := i.commentDetail
 	if d == nil {
 		return fmt.Sprintf("%s · %s", formatReason(i.notification.Reason), timeStr)
@@ -91,6 +157,62 @@ func (i NotificationItem) Description() string {
 	return fmt.Sprintf("%s · %s", formatReason(i.notification.Reason), timeStr)
 }
 
+// subjectIcon marks notification types that aren't issues or pull
+// requests, or returns "".
+func subjectIcon(subjectType string) string {
+	var icon string
+	switch subjectType {
+	case "PullRequest":
+		icon = icons.PullRequest
+	case "Issue":
+		icon = icons.Issue
+	case "Release":
+		icon = icons.Release
+	case "Discussion":
+		icon = icons.Discussion
+	}
+	if icon == "" {
+		return ""
+	}
+	return icon + " "
+}
+
+// releaseDescription describes a release: its tag, its name when that says
+// more, and a summary of its notes.
+func releaseDescription(s *github.SubjectDetail, timeStr string) string {
+	desc := s.Tag
+	if s.Prerelease {
+		desc += " (pre-release)"
+	}
+	if s.Name != "" && s.Name != s.Tag {
+		desc += " \"" + s.Name + "\""
+	}
+	if s.Notes != "" {
+		desc += ": " + s.Notes
+	}
+	return fmt.Sprintf("%s · %s", desc, timeStr)
+}
+
+// discussionDescription describes a discussion: its category, whether it's
+// answered when the category takes answers, and the latest comment.
+func discussionDescription(s *github.SubjectDetail, d *github.CommentDetail, timeStr string) string {
+	desc := s.Category
+	if s.Answerable {
+		switch {
+		case s.Answered && s.AnsweredBy != "":
+			desc += " · ✓ answered by @" + s.AnsweredBy
+		case s.Answered:
+			desc += " · ✓ answered"
+		default:
+			desc += " · unanswered"
+		}
+	}
+	if d != nil && d.Author != "" && d.Body != "" {
+		desc += fmt.Sprintf(" · @%s: \"%s\"", d.Author, d.Body)
+	}
+	return fmt.Sprintf("%s · %s", desc, timeStr)
+}
+
 // formatReason maps raw notification reason strings to human-readable labels.
 func formatReason(reason string) string {
 	switch reason {
@@ -125,8 +247,21 @@ func formatReason(reason string) string {
 
 // PRItem implements list.Item for the PR list pane
 type PRItem struct {
-	info   github.PRInfo
-	status github.PRStatus
+	info    github.PRInfo
+	status  github.PRStatus
+	tickets []prTicket
+	// update is the status of an update-branch request, if any
+	update string
+	// forkSync is the status of a fork sync request, if any
+	forkSync string
+	// autoMerge is whether auto-merge is armed or being toggled, if either
+	autoMerge string
+	// outdated is set when the base has moved on by OutdatedAfter commits
+	outdated bool
+	// badTitle is set when the title doesn't match the configured lint pattern
+	badTitle bool
+	// refreshing is a spinner frame while the PR is re-fetched on demand
+	refreshing string
 }
 
 // FilterValue implements list.Item
@@ -151,6 +286,10 @@ const (
 	TimelineEventCreated TimelineEventType = iota
 	TimelineEventApproved
 	TimelineEventMerged
+	TimelineEventChangesRequested
+	TimelineEventReviewRequested
+	TimelineEventClosed // closed without merging
+	TimelineEventReopened
 )
 
 // TimelineEvent represents a single chronological event for the timeline pane.
@@ -163,6 +302,9 @@ type TimelineEvent struct {
 	Title     string
 	URL       string
 	Actor     string
+
+	// FirstContribution marks the actor's first ever merged PR in the org.
+	FirstContribution bool
 }
 
 // FilterValue implements list.Item.
@@ -177,7 +319,6 @@ const (
 	TimelinePane Pane = iota
 	LeftPane
 	RightPane
-	paneCount // used for modular tab cycling
 )
 
 // FilterMode controls which notifications are displayed
@@ -188,6 +329,12 @@ const (
 	FilterMyPRs FilterMode = iota
 	// FilterAll shows all notifications
 	FilterAll
+	// FilterMentions shows notifications where the user or their team was
+	// @-mentioned, with the sentence that mentioned them
+	FilterMentions
+	// FilterAssigned replaces the notification list with open issues assigned to the user
+	FilterAssigned
+	filterModeCount // used for modular filter cycling
 )
 
 func (f FilterMode) String() string {
@@ -196,6 +343,10 @@ func (f FilterMode) String() string {
 		return "My PRs"
 	case FilterAll:
 		return "All"
+	case FilterMentions:
+		return "Mentions"
+	case FilterAssigned:
+		return "Assigned"
 	default:
 		return "Unknown"
 	}
@@ -207,7 +358,7 @@ type Model struct {
 	prList           list.Model
 	timelineList     list.Model
 	githubClient     *github.Client
-	pollCh           <-chan github.PollResult
+	events           <-chan github.Event
 	ctx              context.Context
 	cancel           context.CancelFunc
 	notifications    []*github.Notification
@@ -216,6 +367,10 @@ type Model struct {
 	prStatuses       map[string]github.PRStatus
 	prInfos          map[string]github.PRInfo
 	commentDetails   map[string]*github.CommentDetail
+	mentions         map[string]*github.Mention
+	subjectDetails   map[string]*github.SubjectDetail
+	assignedIssues   []github.SearchItem
+	secretAlerts     []github.SecretScanningAlert
 	lastNotifyCount  int
 	filterMode       FilterMode
 	focusedPane      Pane
@@ -229,43 +384,380 @@ type Model struct {
 	height           int
 
 	theme             Theme
+	themeKey          string
+	darkBackground    bool
+	colorProfile      colorprofile.Profile
+	themeFilesStamp   string
 	showThemeSelector bool
 	themeList         list.Model
 
-	showDashboard  bool
-	dashboardStats DashboardStats
+	showDashboard           bool
+	dashboardStats          DashboardStats
+	reviewTurnaroundLoading bool
+
+	// Main view pane layout (from layout.json)
+	layout config.LayoutSettings
+
+	// notice is a short confirmation shown in the help line until the next
+	// key press; noticeLink, if set, makes it a clickable hyperlink
+	notice     string
+	noticeLink string
+
+	// Keybinding help overlay
+	showHelp   bool
+	helpScroll int
+
+	// popup is the compact single-pane mode started with --popup
+	popup bool
+
+	// Battery / metered connection awareness
+	poller        *github.Poller
+	powerSettings config.PowerSettings
+	powerState    power.State
+	lowPower      bool
+
+	// CI aggregation settings overlay
+	showCISettings  bool
+	ciSettings      config.CISettings
+	ciSettingsIndex int
+	ciSettingsError error
+	ciPatternInput  textinput.Model
+	ciInputActive   bool
+
+	// Changed files overlay for a PR
+	showPRFiles bool
+	prFilesInfo github.PRInfo
+	prFiles     []github.PullRequestFile
+	// prFilesConflicts marks files also changed on the base branch of a
+	// conflicted PR
+	prFilesConflicts map[string]bool
+	prFilesLoading   bool
+	prFilesError     error
+	prFilesScroll    int
+
+	// Checks and CI artifacts overlay for a PR
+	showChecks        bool
+	checksInfo        github.PRInfo
+	checksArtifacts   []github.Artifact
+	checksLoading     bool
+	checksDownloading bool
+	checksError       error
+	checksIndex       int
+	checksStatus      string
+	artifactSettings  config.ArtifactSettings
+
+	// Code scanning alerts overlay state
+	showSecurity   bool
+	securityInfo   github.PRInfo
+	securityAlerts []github.CodeScanningAlert
+	securityIndex  int
+	securityError  error
+
+	// Per-PR activity timeline overlay
+	showPRTimeline    bool
+	prTimelineInfo    github.PRInfo
+	prTimelineEvents  []github.PRTimelineEvent
+	prTimelineLoading bool
+	prTimelineError   error
+	prTimelineIndex   int
+
+	// Comment thread overlay for a notification
+	showThread         bool
+	threadNotification *github.Notification
+	threadComments     []github.ThreadComment
+	threadLabels       []github.Label
+	threadMilestone    *github.Milestone
+	threadLoading      bool
+	threadError        error
+	threadScroll       int
+
+	// Calendar-aware meeting mode
+	meetingTracker *calendar.Tracker
+	inMeeting      bool
+	meetingName    string
+	meetingDigest  notify.Digest
+
+	// External ticket links (from tickets.json)
+	ticketTrackers  []tickets.Tracker
+	ticketStatuses  map[string]string
+	ticketFetchedAt map[string]time.Time
+
+	// Update-branch requests, keyed by PR
+	updateBranch  config.UpdateBranchSettings
+	branchUpdates map[string]branchUpdate
+
+	// Failing-main board overlay; failingMains is nil until the board has
+	// loaded
+	showMainBoard    bool
+	mainBoardEnabled bool
+	mainBoardIndex   int
+	failingMains     []github.BranchHealth
+
+	// username is the authenticated user; rotation gates some alerts on
+	// them being on call
+	username string
+	rotation config.RotationSettings
+
+	// Pane sort orders (from sort.json)
+	sortSettings config.SortSettings
+
+	// groupThreads collapses notifications on the same issue or PR into one
+	// item; the grouped thread overlay lists them all
+	groupThreads       bool
+	showGroup          bool
+	groupNotifications []*github.Notification
+	groupIndex         int
+
+	// Accounts to switch between; account indexes the active one, whose
+	// poller stopPoller stops
+	accounts    []Account
+	account     int
+	startPoller func(ctx context.Context, a Account) (*github.Poller, <-chan github.Event)
+	stopPoller  context.CancelFunc
+	// accountResolving is set while an account that failed to sign in at
+	// startup is looked up again
+	accountResolving bool
+
+	// debug enables synthetic event injection; syntheticScenario is the
+	// next of github.SyntheticScenarios to inject
+	debug             bool
+	syntheticScenario int
+
+	// Debug log viewer (--debug): the log's last lines and scroll offset
+	showLog   bool
+	logLines  []string
+	logErr    error
+	logScroll int
+
+	// Notifications marked read this session, newest first
+	archive      []archivedNotification
+	showArchive  bool
+	archiveIndex int
+
+	// readSettings controls hiding read notifications; readAt is when each
+	// listed read notification was first seen read, while it fades out
+	readSettings config.ReadSettings
+	readAt       map[string]time.Time
+
+	// zones are team members' timezones by lowercased login (from
+	// timezones.json); showTimezones shows timeline activity bucketed by them
+	zones         map[string]*time.Location
+	showTimezones bool
+
+	// Attention tracking (from attention.json): where the selection rested
+	// this session, credited to attention on each key press
+	attentionSettings config.AttentionSettings
+	attention         config.AttentionStats
+	attentionRepo     string
+	attentionSince    time.Time
+	attentionDirty    bool
+
+	// Re-authentication prompt, shown when GitHub rejects the token;
+	// saveToken persists a replacement for the primary account
+	showReauth      bool
+	reauthDismissed bool
+	reauthChecking  bool
+	reauthInput     textinput.Model
+	reauthErr       error
+	saveToken       func(token string) error
+
+	// sourceErrs are the poll sources that failed on the latest poll;
+	// fetchedAt is when each last succeeded, for the stale badges
+	sourceErrs map[github.PollSource]error
+	fetchedAt  map[github.PollSource]time.Time
+	// nextPollAt and rateLimit feed the status bar; refreshing is set
+	// while a poll asked for with ctrl+r runs
+	nextPollAt time.Time
+	rateLimit  github.RateLimit
+	refreshing bool
+
+	// Fork sync requests, keyed by PR
+	forkSyncs map[string]forkSync
+
+	// autoMerges are auto-merge requests in flight or failed, keyed by PR;
+	// showAutoMerge is the merge method picker for autoMergePR
+	autoMerges        map[string]autoMergeRequest
+	autoMergeSettings config.AutoMergeSettings
+	showAutoMerge     bool
+	autoMergePR       github.PRInfo
+	autoMergeIndex    int
+
+	// repoPaths maps repos to local clones for checkout
+	repoPaths config.RepoPathsSettings
+
+	// prRefreshes are the PRs being re-fetched on demand, keyed by PR
+	prRefreshes map[string]bool
+
+	// watchlist holds PRs by others shown in the PR pane, as PR keys;
+	// showWatchInput prompts for one to add by reference
+	watchlist      []string
+	showWatchInput bool
+	watchInput     textinput.Model
+	watchInputErr  error
+
+	// labelFilter limits the PR pane and assigned issues to a label;
+	// showLabelInput prompts for it
+	labelFilter    string
+	showLabelInput bool
+	labelInput     textinput.Model
+
+	// timelineHistory is every timeline event seen, from the history file
+	// and this session, for all accounts; timelineSeen holds their keys
+	timelineHistory []config.TimelineRecord
+	timelineSeen    map[string]bool
+
+	// showHeatmap shows daily merges and reviews for heatmapLogin, or for
+	// me when it's ""
+	showHeatmap  bool
+	heatmapLogin string
+
+	// titleLint is the pattern my PR titles must match (from title_lint.json)
+	titleLint *regexp.Regexp
 
 	// Org activity overlay
 	showOrgDashboard   bool
 	orgName            string
+	orgTeam            string
+	orgScopes          []string // org dashboards to switch between
+	orgCancel          context.CancelFunc
+	workingHours       config.WorkingHours
 	orgMembers         []github.OrgMemberActivity
 	orgSelectedIndex   int
 	orgSortColumn      OrgSortColumn
+	orgGroupByRepo     bool
+	orgShowInactive    bool // list members without activity too
+	orgLoadedMembers   []github.OrgMemberActivity
+	botSettings        config.BotSettings
 	orgLoading         bool
+	orgStreaming       bool // showing partial results of a load with no cache
 	orgProgressCh      <-chan github.OrgLoadingProgress
 	orgLoadStartedAt   time.Time
 	orgLoadProgress    map[github.OrgLoadingStep]github.OrgLoadingProgress
 	orgLastLoadSummary github.OrgActivitySummary
+	orgUpdatedAt       time.Time
 	orgError           error
 	orgInput           textinput.Model
 	orgInputActive     bool
+	orgPickIndex       int
+	orgPicked          bool // orgPickIndex was moved with the arrows
+	userOrgs           []string
+	userOrgsLoading    bool
+	userOrgsErr        error
+	teamInput          textinput.Model
+	teamInputActive    bool
 	showEngineerDetail bool
+
+	// Repo subscription overlay
+	showSubscriptions bool
+	subscriptions     []watchedRepo
+	starredRepos      []watchedRepo
+	subsShowStarred   bool
+	subsSelectedIndex int
+	subsLoading       bool
+	subsError         error
+	releasesOnlyRepos map[string]bool // repos where only Release notifications are shown
+
+	// Custom query panels (from panels.json)
+	panels        []config.Panel
+	panelStates   []panelState
+	showPanels    bool
+	panelIndex    int
+	panelSelected int
+
+	// Review reciprocity overlay
+	showReviewMatrix    bool
+	showOrgSummary      bool
+	reviewMatrix        *github.ReviewMatrix
+	reviewMatrixLoading bool
+	reviewMatrixError   error
+	reviewMatrixScroll  int
+
 	announcedReadyPRs  map[string]bool // PRs already announced as ready to merge
 	firstPoll          bool            // true until the first poll result is processed
 	engineerDetail     *github.EngineerDetail
 	engineerLoading    bool
+	engineerLogin      string
+	engineerPartialCh  <-chan *github.EngineerDetail
+	engineerCancel     context.CancelFunc // stops the drill-down fetch
 	engineerSelectedPR int
 	engineerScroll     int
 }
 
 // New creates a new TUI model
-func New(ctx context.Context, client *github.Client, pollCh <-chan github.PollResult, progressCh <-chan github.LoadingProgress, orgName string) *Model {
+// Options configures a Model at construction time.
+type Options struct {
+	// OrgName and OrgTeam scope the org dashboard.
+	OrgName string
+	OrgTeam string
+	// Orgs are the org dashboards to switch between, as "org" or
+	// "org/team" scopes.
+	Orgs []string
+	// Popup renders a compact single-pane inbox meant to be launched from a
+	// hotkey (e.g. tmux display-popup) and exits after opening an item.
+	Popup bool
+	// Poller, when set, has its interval stretched in low-power mode and
+	// publishes the events injected in debug mode.
+	Poller *github.Poller
+	// MainBoard is set when the poller watches default branches for the
+	// failing-main board.
+	MainBoard bool
+	// Username is the authenticated user, used to check the on-call
+	// rotation.
+	Username string
+	// Debug enables the debug log viewer and the key that injects synthetic
+	// events through Poller.
+	Debug bool
+	// Accounts are the accounts to switch between, starting with the one
+	// client belongs to. StartPoller starts polling an account when
+	// switching to it; StopPoller stops the initial poller.
+	Accounts    []Account
+	StartPoller func(ctx context.Context, a Account) (*github.Poller, <-chan github.Event)
+	StopPoller  context.CancelFunc
+	// SaveToken, when set, saves a replacement token entered after the
+	// first account's token was rejected.
+	SaveToken func(token string) error
+	// Filter is the notification filter to start with (one of
+	// config.Filters); empty means my PRs.
+	Filter string
+	// Keys rebinds main view actions (from config.toml).
+	Keys map[string][]string
+	// Theme overrides the saved theme for this run.
+	Theme string
+	// Icons names the icon set (one of config.IconSets); empty means
+	// unicode.
+	Icons string
+}
+
+// filterModes maps config.toml filter names to filter modes.
+var filterModes = map[string]FilterMode{
+	"my_prs":   FilterMyPRs,
+	"all":      FilterAll,
+	"mentions": FilterMentions,
+	"assigned": FilterAssigned,
+}
+
+func New(ctx context.Context, client *github.Client, events <-chan github.Event, progressCh <-chan github.LoadingProgress, opts Options) *Model {
 	ctx, cancel := context.WithCancel(ctx)
 
-	theme := GetTheme(config.LoadTheme())
+	setIcons(opts.Icons)
+	themeName := opts.Theme
+	if themeName == "" {
+		themeName = config.LoadTheme()
+	}
+	if themeName == "" {
+		themeName = autoTheme
+	}
+	themeFilesErr := LoadCustomThemes()
+	// Until the terminal reports its background, auto assumes a dark one
+	theme := GetTheme(themeName)
+	if themeName == autoTheme {
+		theme = GetTheme(autoThemeFor(true))
+	}
+
+	layout, layoutErr := config.LoadLayout()
 
 	// Initialize notification list with themed delegate
-	delegate := newThemedDelegate(theme)
+	delegate := newNotificationDelegate(theme, layout.DescriptionLines)
 	l := list.New([]list.Item{}, delegate, 0, 0)
 	l.Title = "Notifications"
 	l.SetShowStatusBar(false)
@@ -281,7 +773,9 @@ func New(ctx context.Context, client *github.Client, pollCh <-chan github.PollRe
 	applyListTheme(&pl, theme)
 
 	// Initialize timeline list with custom delegate
-	tlDelegate := newTimelineDelegate(theme)
+	timezones, timezonesErr := config.LoadTimezoneSettings()
+	zones := timezones.Locations()
+	tlDelegate := newTimelineDelegate(theme, zones)
 	tl := list.New([]list.Item{}, tlDelegate, 0, 0)
 	tl.Title = "Timeline"
 	tl.SetShowStatusBar(false)
@@ -293,18 +787,76 @@ func New(ctx context.Context, client *github.Client, pollCh <-chan github.PollRe
 	for k, v := range cached.Weeks {
 		dashStats.WeeklyMergedCounts[k] = v
 	}
+	dashStats.CIHistory = config.LoadCIHistory()
+	dashStats.FlakyChecks = config.LoadFlakyChecks()
 
 	ti := textinput.New()
 	ti.Placeholder = "organization name (e.g. angellist)"
 	ti.CharLimit = 100
 	ti.SetWidth(40)
 
+	teamTi := textinput.New()
+	teamTi.Placeholder = "team slug (empty for whole org)"
+	teamTi.CharLimit = 100
+	teamTi.SetWidth(40)
+
+	ciTi := textinput.New()
+	ciTi.Placeholder = "check name pattern (e.g. codecov/*)"
+	ciTi.CharLimit = 100
+	ciTi.SetWidth(40)
+
+	panels, panelsErr := config.LoadPanels()
+
+	var meetingTracker *calendar.Tracker
+	calSettings, calErr := config.LoadCalendarSettings()
+	if calSettings.Enabled() {
+		meetingTracker = calendar.NewTracker(calSettings.Source(), calSettings.RefreshInterval())
+	}
+
+	ticketSettings, ticketsErr := config.LoadTicketSettings()
+	titleLint, titleLintErr := config.LoadTitleLintSettings()
+	updateBranch, updateBranchErr := config.LoadUpdateBranchSettings()
+	artifactSettings, artifactsErr := config.LoadArtifactSettings()
+
+	powerSettings := config.LoadPowerSettings()
+	var powerState power.State
+	if powerSettings.Enabled {
+		powerState = power.Detect(ctx)
+	}
+
+	rotation, rotationErr := config.LoadRotationSettings()
+	sortSettings, sortErr := config.LoadSortSettings()
+	readSettings, readErr := config.LoadReadSettings()
+	attentionSettings, attentionErr := config.LoadAttentionSettings()
+	autoMergeSettings, autoMergeErr := config.LoadAutoMergeSettings()
+	repoPaths, repoPathsErr := config.LoadRepoPathsSettings()
+	timelineHistory, timelineHistoryErr := config.LoadTimelineHistory()
+	timelineSeen := make(map[string]bool, len(timelineHistory))
+	for _, r := range timelineHistory {
+		timelineSeen[timelineRecordKey(r)] = true
+	}
+	var attention config.AttentionStats
+	if attentionSettings.Enabled {
+		attention = config.LoadAttention()
+	}
+	keysErr := rebindMainKeys(opts.Keys)
+	focusedPane := TimelinePane
+	for _, lp := range layout.Panes {
+		if !lp.Hidden {
+			focusedPane = paneNames[lp.Name]
+			break
+		}
+	}
+	if opts.Popup {
+		focusedPane = LeftPane
+	}
+
 	return &Model{
 		list:              l,
 		prList:            pl,
 		timelineList:      tl,
 		githubClient:      client,
-		pollCh:            pollCh,
+		events:            events,
 		progressCh:        progressCh,
 		ctx:               ctx,
 		cancel:            cancel,
@@ -313,16 +865,75 @@ func New(ctx context.Context, client *github.Client, pollCh <-chan github.PollRe
 		prStatuses:        make(map[string]github.PRStatus),
 		prInfos:           make(map[string]github.PRInfo),
 		commentDetails:    make(map[string]*github.CommentDetail),
-		filterMode:        FilterMyPRs,
-		focusedPane:       TimelinePane,
+		mentions:          make(map[string]*github.Mention),
+		subjectDetails:    make(map[string]*github.SubjectDetail),
+		filterMode:        filterModes[opts.Filter],
+		focusedPane:       focusedPane,
+		layout:            layout,
 		loading:           true,
 		loadingSteps:      make(map[github.LoadingStep]bool),
 		theme:             theme,
+		themeKey:          themeName,
+		darkBackground:    true,
+		colorProfile:      colorprofile.TrueColor,
+		themeFilesStamp:   config.ThemeFilesStamp(),
 		themeList:         buildThemeList(),
 		dashboardStats:    dashStats,
-		orgName:           orgName,
+		orgName:           opts.OrgName,
+		orgTeam:           opts.OrgTeam,
+		orgScopes:         opts.Orgs,
+		popup:             opts.Popup,
+		poller:            opts.Poller,
+		mainBoardEnabled:  opts.MainBoard,
+		username:          opts.Username,
+		debug:             opts.Debug,
+		accounts:          opts.Accounts,
+		notice:            unavailableAccountsNotice(opts.Accounts),
+		archive:           loadArchive(opts.Username),
+		startPoller:       opts.StartPoller,
+		stopPoller:        opts.StopPoller,
+		rotation:          rotation,
+		sortSettings:      sortSettings,
+		readSettings:      readSettings,
+		readAt:            make(map[string]time.Time),
+		fetchedAt:         make(map[github.PollSource]time.Time),
+		zones:             zones,
+		attentionSettings: attentionSettings,
+		attention:         attention,
+		reauthInput:       newReauthInput(),
+		saveToken:         opts.SaveToken,
+		powerSettings:     powerSettings,
+		powerState:        powerState,
+		lowPower:          isLowPower(powerSettings, powerState),
+		ciSettings:        config.LoadCISettings(),
+		botSettings:       config.LoadBotSettings(),
+		ciPatternInput:    ciTi,
+		workingHours:      config.LoadWorkingHours(),
 		orgLoadProgress:   make(map[github.OrgLoadingStep]github.OrgLoadingProgress),
 		orgInput:          ti,
+		teamInput:         teamTi,
+		releasesOnlyRepos: config.LoadReleasesOnly(),
+		panels:            panels,
+		panelStates:       make([]panelState, len(panels)),
+		meetingTracker:    meetingTracker,
+		ticketTrackers:    ticketSettings.TicketTrackers(),
+		ticketStatuses:    make(map[string]string),
+		ticketFetchedAt:   make(map[string]time.Time),
+		titleLint:         titleLint.Regexp(),
+		updateBranch:      updateBranch,
+		artifactSettings:  artifactSettings,
+		branchUpdates:     make(map[string]branchUpdate),
+		forkSyncs:         make(map[string]forkSync),
+		autoMerges:        make(map[string]autoMergeRequest),
+		autoMergeSettings: autoMergeSettings,
+		repoPaths:         repoPaths,
+		prRefreshes:       make(map[string]bool),
+		watchlist:         config.LoadWatchlist(),
+		watchInput:        newWatchInput(),
+		labelInput:        newLabelInput(),
+		timelineHistory:   timelineHistory,
+		timelineSeen:      timelineSeen,
+		err:               errors.Join(panelsErr, calErr, ticketsErr, titleLintErr, updateBranchErr, artifactsErr, layoutErr, rotationErr, sortErr, readErr, attentionErr, autoMergeErr, repoPathsErr, timezonesErr, keysErr, timelineHistoryErr, themeFilesErr),
 		announcedReadyPRs: make(map[string]bool),
 		firstPoll:         true,
 	}
@@ -331,13 +942,41 @@ func New(ctx context.Context, client *github.Client, pollCh <-chan github.PollRe
 // Init implements tea.Model
 func (m *Model) Init() tea.Cmd {
 	cmds := []tea.Cmd{
-		waitForPollResult(m.pollCh),
+		m.waitForEvent(),
 		waitForLoadingStep(m.progressCh),
 		bannerTick(),
+		themeFilesTick(),
+		tea.RequestBackgroundColor,
+		// Terminals that support true color without advertising it in
+		// COLORTERM answer these
+		tea.RequestCapability("RGB"),
+		tea.RequestCapability("Tc"),
 	}
-	// Auto-fetch org data for the timeline when an org is configured
+	if m.powerSettings.Enabled {
+		cmds = append(cmds, powerTick(m.ctx))
+	}
+	if m.meetingTracker != nil {
+		cmds = append(cmds, checkMeeting(m.ctx, m.meetingTracker))
+	}
+	if m.lowPower && m.poller != nil {
+		m.poller.SetInterval(m.powerSettings.LowPowerPollInterval())
+	}
+	// Popup mode is a transient inbox; skip the background org and panel loads
+	if m.popup {
+		return tea.Batch(cmds...)
+	}
+	cmds = append(cmds, statusTick(m.lowPower))
+	if len(m.panels) > 0 {
+		cmds = append(cmds, m.refreshPanels(), panelPollTick())
+	}
+	// Show cached org data immediately and refresh it in the background
 	if m.orgName != "" {
-		cmds = append(cmds, m.beginOrgLoad(false))
+		m.loadCachedOrgData()
+		m.updateTimelineList()
+		// In low-power mode cached data is good enough until asked otherwise
+		if !m.lowPower || len(m.orgMembers) == 0 {
+			cmds = append(cmds, m.beginOrgLoad(false))
+		}
 	}
 	return tea.Batch(cmds...)
 }
@@ -360,7 +999,19 @@ func waitForOrgLoadingStep(ch <-chan github.OrgLoadingProgress) tea.Cmd {
 		if !ok {
 			return nil
 		}
-		return OrgLoadingProgressMsg{p}
+		return OrgLoadingProgressMsg{p, ch}
+	}
+}
+
+// waitForEngineerDetail returns a command that waits for the next partial
+// engineer detail snapshot.
+func waitForEngineerDetail(ch <-chan *github.EngineerDetail) tea.Cmd {
+	return func() tea.Msg {
+		d, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return EngineerDetailPartialMsg{Detail: d}
 	}
 }
 
@@ -371,27 +1022,43 @@ func bannerTick() tea.Cmd {
 	})
 }
 
-// waitForPollResult waits for the next poll result
-func waitForPollResult(pollCh <-chan github.PollResult) tea.Cmd {
+// waitForEvent waits for the next poller event
+func waitForEvent(events <-chan github.Event) tea.Cmd {
 	return func() tea.Msg {
-		result, ok := <-pollCh
+		e, ok := <-events
 		if !ok {
 			return nil
 		}
-		if result.Error != nil {
-			return ErrorMsg{Err: result.Error}
-		}
-		if result.Notifications == nil && result.PRStatuses == nil {
-			return waitForPollResult(pollCh)()
-		}
-		return PollResultMsg{
-			Notifications:      result.Notifications,
-			PRStatuses:         result.PRStatuses,
-			PRInfos:            result.PRInfos,
-			PRChanges:          result.PRChanges,
-			MergedPRs:          result.MergedPRs,
-			WeeklyMergedCounts: result.WeeklyMergedCounts,
-			CommentDetails:     result.CommentDetails,
+		switch e := e.(type) {
+		case github.PollFailed:
+			return ErrorMsg{Err: e.Err}
+		case github.PollCompleted:
+			result := e.Result
+			if result.Notifications == nil && result.PRStatuses == nil && result.Errors == nil {
+				// Nothing to show; only its timing is kept
+				return PollEventMsg{Event: e}
+			}
+			return PollResultMsg{
+				Notifications:      result.Notifications,
+				PRStatuses:         result.PRStatuses,
+				PRInfos:            result.PRInfos,
+				MergedPRs:          result.MergedPRs,
+				WeeklyMergedCounts: result.WeeklyMergedCounts,
+				CommentDetails:     result.CommentDetails,
+				AssignedIssues:     result.AssignedIssues,
+
+				SecretScanningAlerts: result.SecretScanningAlerts,
+				FailingMains:         result.FailingMains,
+				Errors:               result.Errors,
+				ClosedWatched:        result.ClosedWatched,
+				Mentions:             result.Mentions,
+				SubjectDetails:       result.SubjectDetails,
+				PolledAt:             result.PolledAt,
+				Interval:             result.Interval,
+				RateLimit:            result.RateLimit,
+			}
+		default:
+			return PollEventMsg{Event: e}
 		}
 	}
 }
@@ -412,16 +1079,18 @@ func (m *Model) applyFilter() []*github.Notification {
 		}
 	}
 
-	// Sort by UpdatedAt descending (newest first)
-	sort.Slice(filtered, func(i, j int) bool {
-		return filtered[i].UpdatedAt.After(filtered[j].UpdatedAt)
-	})
-
+	m.sortNotifications(filtered)
 	return filtered
 }
 
 // matchesFilter returns true if a notification matches the current filter
 func (m *Model) matchesFilter(n *github.Notification) bool {
+	if m.readSettings.UnreadOnly && !n.Unread {
+		return false
+	}
+	if m.releasesOnlyRepos[n.Repository.FullName] && n.Subject.Type != "Release" {
+		return false
+	}
 	switch m.filterMode {
 	case FilterMyPRs:
 		if n.Subject.Type != "PullRequest" {
@@ -430,11 +1099,31 @@ func (m *Model) matchesFilter(n *github.Notification) bool {
 		return n.Reason == "author" || n.Reason == "comment"
 	case FilterAll:
 		return true
+	case FilterMentions:
+		return github.IsMention(n)
 	default:
 		return true
 	}
 }
 
+// notificationURL returns a notification's web URL, from its release or
+// discussion when known.
+func (m *Model) notificationURL(n *github.Notification) string {
+	if s := m.subjectDetails[n.ID]; s != nil && s.URL != "" {
+		return s.URL
+	}
+	return github.NotificationWebURL(n)
+}
+
+// mentionFor returns the comment that mentioned the user for a notification
+// when the mentions filter is on, or nil.
+func (m *Model) mentionFor(n *github.Notification) *github.Mention {
+	if m.filterMode != FilterMentions {
+		return nil
+	}
+	return m.mentions[n.ID]
+}
+
 // updateNotifications merges new notifications and refreshes the display
 func (m *Model) updateNotifications(incoming []*github.Notification) {
 	if incoming != nil {
@@ -451,48 +1140,75 @@ func (m *Model) updateNotifications(incoming []*github.Notification) {
 	}
 
 	// Convert to list items with CI status and comment detail
-	items := make([]list.Item, len(m.notifications))
-	for i, n := range m.notifications {
-		items[i] = NotificationItem{
-			notification:  n,
-			ciStatus:      m.prStatusForNotification(n),
-			commentDetail: m.commentDetails[n.ID],
+	items := m.notificationItems(m.notifications)
+	if m.filterMode == FilterAssigned {
+		m.list.Title = "Assigned Issues" + m.labelFilterBadge() + m.staleBadge(github.SourceAssigned)
+		m.list.SetItems(m.assignedIssueItems())
+	} else {
+		title := "Notifications"
+		if m.filterMode == FilterMentions {
+			title = "Mentions"
+		}
+		m.list.Title = sortedTitle(title, m.sortSettings.Notifications, config.DefaultSortSettings.Notifications)
+		if m.readSettings.UnreadOnly {
+			m.list.Title += " · unread"
 		}
+		m.list.Title += m.staleBadge(github.SourceNotifications)
+		m.list.SetItems(append(m.secretAlertItems(), items...))
 	}
-	m.list.SetItems(items)
 
 	// Send desktop notification if unread count increased
-	unreadCount := 0
-	for _, n := range m.notifications {
-		if n.Unread {
-			unreadCount++
+	unread := unreadCount(m.notifications)
+	if unread > m.lastNotifyCount {
+		newCount := unread - m.lastNotifyCount
+		m.dashboardStats.recordNotifications(newCount)
+		if !m.popup {
+			m.desktopNotify(
+				"GitHub Notifications",
+				fmt.Sprintf("You have %d new notification(s)", newCount),
+			)
 		}
 	}
+	m.lastNotifyCount = unread
+}
 
-	if unreadCount > m.lastNotifyCount {
-		newCount := unreadCount - m.lastNotifyCount
-		m.dashboardStats.recordNotifications(newCount)
-		notify.SendDesktopNotification(
-			"GitHub Notifications",
-			fmt.Sprintf("You have %d new notification(s)", newCount),
-		)
+// unreadCount returns how many of notifications are unread.
+func unreadCount(notifications []*github.Notification) int {
+	n := 0
+	for _, notification := range notifications {
+		if notification.Unread {
+			n++
+		}
 	}
-	m.lastNotifyCount = unreadCount
+	return n
 }
 
 // updatePRList rebuilds the right-pane PR list from current prInfos and prStatuses
 func (m *Model) updatePRList() {
-	// Collect PRItems and sort by CreatedAt descending (newest first)
-	items := make([]list.Item, 0, len(m.prInfos))
+	// Collect PRItems and sort by the configured order
+	prItems := make([]PRItem, 0, len(m.prInfos))
 	for key := range m.prInfos {
-		items = append(items, PRItem{
-			info:   m.prInfos[key],
-			status: m.prStatuses[key],
+		if !m.matchesLabelFilter(m.prInfos[key].Labels) {
+			continue
+		}
+		prItems = append(prItems, PRItem{
+			info:       m.prInfos[key],
+			status:     m.prStatuses[key],
+			tickets:    m.prTickets(m.prInfos[key]),
+			update:     m.branchUpdateLabel(key),
+			forkSync:   m.forkSyncLabel(key),
+			autoMerge:  m.autoMergeLabel(key),
+			outdated:   m.prInfos[key].BehindBy >= m.updateBranch.OutdatedAfter,
+			badTitle:   m.titleLint != nil && !m.prInfos[key].Watched && !m.titleLint.MatchString(m.prInfos[key].Title),
+			refreshing: m.prRefreshLabel(key),
 		})
 	}
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].(PRItem).info.CreatedAt.After(items[j].(PRItem).info.CreatedAt)
-	})
+	m.sortPRItems(prItems)
+	items := make([]list.Item, len(prItems))
+	for i, item := range prItems {
+		items[i] = item
+	}
+	m.prList.Title = sortedTitle("Open PRs", m.sortSettings.PRs, config.DefaultSortSettings.PRs) + m.labelFilterBadge() + m.staleBadge(github.SourcePRs)
 	m.prList.SetItems(items)
 }
 
@@ -516,16 +1232,26 @@ func (m *Model) buildTimelineEvents() []TimelineEvent {
 					Actor:     member.Login,
 				})
 			}
-			for _, pr := range member.MergedPRs {
+			// The earliest merge of a first-time contributor is their first ever
+			firstIdx := -1
+			if member.FirstContribution {
+				for i, pr := range member.MergedPRs {
+					if firstIdx < 0 || pr.MergedAt.Before(member.MergedPRs[firstIdx].MergedAt) {
+						firstIdx = i
+					}
+				}
+			}
+			for i, pr := range member.MergedPRs {
 				events = append(events, TimelineEvent{
-					EventType: TimelineEventMerged,
-					Timestamp: pr.MergedAt,
-					Owner:     pr.Owner,
-					Repo:      pr.Repo,
-					Number:    pr.Number,
-					Title:     pr.Title,
-					URL:       pr.URL,
-					Actor:     member.Login,
+					EventType:         TimelineEventMerged,
+					Timestamp:         pr.MergedAt,
+					Owner:             pr.Owner,
+					Repo:              pr.Repo,
+					Number:            pr.Number,
+					Title:             pr.Title,
+					URL:               pr.URL,
+					Actor:             member.Login,
+					FirstContribution: i == firstIdx,
 				})
 			}
 		}
@@ -555,24 +1281,34 @@ func (m *Model) buildTimelineEvents() []TimelineEvent {
 		}
 	}
 
-	// Approved events from user's PRs reviews (always available)
+	// Approved and changes-requested events from user's PRs reviews (always available)
 	for _, info := range m.prInfos {
 		for _, r := range info.Reviews {
-			if r.State == "APPROVED" {
-				events = append(events, TimelineEvent{
-					EventType: TimelineEventApproved,
-					Timestamp: r.SubmittedAt,
-					Owner:     info.Owner,
-					Repo:      info.Repo,
-					Number:    info.Number,
-					Title:     info.Title,
-					URL:       info.URL,
-					Actor:     r.User.Login,
-				})
+			var eventType TimelineEventType
+			switch r.State {
+			case "APPROVED":
+				eventType = TimelineEventApproved
+			case "CHANGES_REQUESTED":
+				eventType = TimelineEventChangesRequested
+			default:
+				continue
 			}
+			events = append(events, TimelineEvent{
+				EventType: eventType,
+				Timestamp: r.SubmittedAt,
+				Owner:     info.Owner,
+				Repo:      info.Repo,
+				Number:    info.Number,
+				Title:     info.Title,
+				URL:       info.URL,
+				Actor:     r.User.Login,
+			})
 		}
 	}
 
+	events = append(events, m.notificationTimelineEvents()...)
+	events = m.mergeTimelineHistory(events)
+
 	// Sort most-recent-first
 	sort.Slice(events, func(i, j int) bool {
 		return events[i].Timestamp.After(events[j].Timestamp)
@@ -581,6 +1317,59 @@ func (m *Model) buildTimelineEvents() []TimelineEvent {
 	return events
 }
 
+// subjectAPIURLPattern matches GitHub API issue and PR URLs like
+// https://api.github.com/repos/owner/repo/issues/123
+var subjectAPIURLPattern = regexp.MustCompile(`/repos/([^/]+)/([^/]+)/(?:issues|pulls)/(\d+)$`)
+
+// notificationTimelineEvents derives review-requested events from
+// review_requested notifications, and closed and reopened events from
+// state_change notifications whose subject state has been fetched. Merges
+// are left to the merged PR data.
+func (m *Model) notificationTimelineEvents() []TimelineEvent {
+	var events []TimelineEvent
+	for _, n := range m.allNotifications {
+		var eventType TimelineEventType
+		switch n.Reason {
+		case "review_requested":
+			eventType = TimelineEventReviewRequested
+		case "state_change":
+			detail := m.commentDetails[n.ID]
+			if detail == nil {
+				continue
+			}
+			switch detail.SubjectState {
+			case "closed":
+				eventType = TimelineEventClosed
+			case "open":
+				eventType = TimelineEventReopened
+			default:
+				continue
+			}
+		default:
+			continue
+		}
+
+		matches := subjectAPIURLPattern.FindStringSubmatch(n.Subject.URL)
+		if matches == nil {
+			continue
+		}
+		number, err := strconv.Atoi(matches[3])
+		if err != nil {
+			continue
+		}
+		events = append(events, TimelineEvent{
+			EventType: eventType,
+			Timestamp: n.UpdatedAt,
+			Owner:     matches[1],
+			Repo:      matches[2],
+			Number:    number,
+			Title:     n.Subject.Title,
+			URL:       m.notificationURL(n),
+		})
+	}
+	return events
+}
+
 // updateTimelineList rebuilds the timeline pane from current data.
 func (m *Model) updateTimelineList() {
 	events := m.buildTimelineEvents()
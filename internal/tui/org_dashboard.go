@@ -2,21 +2,31 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
 	"github.com/jpoz/hubell/internal/github"
 )
 
+// orgCacheTTL is how long cached org activity is shown before opening the
+// overlay triggers a background refresh.
+const orgCacheTTL = 5 * time.Minute
+
 var orgLoadingSteps = []github.OrgLoadingStep{
 	github.OrgStepMembers,
 	github.OrgStepMergedPRs,
 	github.OrgStepOpenPRs,
 	github.OrgStepCommits,
 	github.OrgStepReviews,
+	github.OrgStepIssues,
 	github.OrgStepDiffStats,
+	github.OrgStepTrends,
+	github.OrgStepFirstPRs,
+	github.OrgStepCoverage,
 	github.OrgStepAggregate,
 }
 
@@ -93,11 +103,93 @@ func totalOrgStats(members []github.OrgMemberActivity) (commits, reviews, loc in
 	return
 }
 
+// sparkBlocks are the eighth-height block characters used for sparklines.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// renderSparkline renders values as a single-line sparkline scaled to the
+// largest value, so each member's trend shape is visible regardless of volume.
+func renderSparkline(values []int) string {
+	if len(values) == 0 {
+		return ""
+	}
+	peak := 0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+	out := make([]rune, len(values))
+	for i, v := range values {
+		idx := 0
+		if peak > 0 {
+			idx = v * (len(sparkBlocks) - 1) / peak
+		}
+		out[i] = sparkBlocks[idx]
+	}
+	return string(out)
+}
+
+// formatIssueSummary formats the org-wide issue triage line: issues opened
+// and closed, backlog growth, and median time to first response.
+func formatIssueSummary(s github.OrgActivitySummary) string {
+	growth := s.IssuesOpened - s.IssuesClosed
+	line := fmt.Sprintf("Issues: %d opened  ·  %d closed  ·  backlog %+d (%d open)", s.IssuesOpened, s.IssuesClosed, growth, s.IssueBacklog)
+	if s.MedianIssueResponse > 0 {
+		line += fmt.Sprintf("  ·  median first response %s", formatMergeDuration(s.MedianIssueResponse))
+	}
+	if s.UnansweredIssues > 0 {
+		line += fmt.Sprintf("  ·  %d unanswered", s.UnansweredIssues)
+	}
+	return line
+}
+
+// afterHoursMerges counts merges that landed outside the configured working hours.
+func (m *Model) afterHoursMerges(prs []github.MergedPRInfo) int {
+	return countAfterHours(m.workingHours, prs, func(pr github.MergedPRInfo) time.Time { return pr.MergedAt })
+}
+
+// afterHoursReviews counts reviews submitted outside the configured working
+// hours.
+func (m *Model) afterHoursReviews(times []time.Time) int {
+	return countAfterHours(m.workingHours, times, func(t time.Time) time.Time { return t })
+}
+
+// countAfterHours counts the items whose time, as returned by at, falls
+// outside the working hours. Zero times are skipped.
+func countAfterHours[T any](w config.WorkingHours, items []T, at func(T) time.Time) int {
+	count := 0
+	for _, item := range items {
+		if t := at(item); !t.IsZero() && w.IsAfterHours(t) {
+			count++
+		}
+	}
+	return count
+}
+
+// formatAfterHours formats an after-hours count with its percentage of total,
+// e.g. "3 (25%)".
+func formatAfterHours(count, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d (%d%%)", count, count*100/total)
+}
+
+// formatReviewLoad formats a member's review count with their share of all
+// reviews given, e.g. "12 (18%)", so uneven review load stands out.
+func formatReviewLoad(reviews, total int) string {
+	if total == 0 || reviews == 0 {
+		return fmt.Sprintf("%d", reviews)
+	}
+	return fmt.Sprintf("%d (%d%%)", reviews, reviews*100/total)
+}
+
 // renderOrgDashboard renders the org activity overlay.
 func (m *Model) renderOrgDashboard() string {
 	if m.orgInputActive {
 		return m.renderOrgInput()
 	}
+	if m.teamInputActive {
+		return m.renderTeamInput()
+	}
 
 	maxWidth := max(m.width-2, 40)
 	maxHeight := max(m.height-2, 10)
@@ -112,27 +204,33 @@ func (m *Model) renderOrgDashboard() string {
 	var b strings.Builder
 
 	// Title
-	b.WriteString(titleStyle.Render(fmt.Sprintf("%s - Org Activity (last 7 days)", m.orgName)))
+	title := fmt.Sprintf("%s - Org Activity (last 7 days)", m.orgScopeLabel())
+	if m.orgGroupByRepo {
+		title = fmt.Sprintf("%s - Org Activity by Repository (last 7 days)", m.orgScopeLabel())
+	}
+	b.WriteString(titleStyle.Render(title))
 	b.WriteString("\n\n")
 
-	if m.orgLoading {
+	if m.orgLoading && len(m.orgMembers) == 0 {
 		b.WriteString(m.renderOrgLoading(maxWidth-6, accentStyle, subtleStyle))
 		b.WriteString("\n\n")
 		b.WriteString(subtleStyle.Render("esc: close"))
-	} else if m.orgError != nil {
+	} else if m.orgError != nil && len(m.orgMembers) == 0 {
 		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.orgError)))
 		b.WriteString("\n\n")
-		b.WriteString(subtleStyle.Render("r: retry  esc: close"))
+		b.WriteString(subtleStyle.Render("O: switch org  T: team  r: retry  esc: close"))
 	} else if len(m.orgMembers) == 0 {
 		b.WriteString(subtleStyle.Render("No active engineers found in the last 7 days."))
 		b.WriteString("\n\n")
-		b.WriteString(subtleStyle.Render("r: refresh  esc: close"))
+		b.WriteString(subtleStyle.Render("i: inactive  b: bots  O: switch org  T: team  r: refresh  esc: close"))
+	} else if m.orgGroupByRepo {
+		b.WriteString(m.renderOrgRepoTable(maxWidth-6, maxHeight, accentStyle, subtleStyle, selectedStyle, normalStyle, errorStyle))
 	} else {
 		// Column headers
 		innerWidth := maxWidth - 6 // padding
 		rowPrefix := "  "
 		selectedRowPrefix := "▸ "
-		statsWidth := 47 // " %9s %9s %8s %8s %8s"
+		statsWidth := 92 // " %9s %12s %8s %8s %8s %8s %8s %11s %11s"
 		tableWidth := max(innerWidth-lipgloss.Width(rowPrefix), 0)
 		nameWidth := max(tableWidth-statsWidth, 16)
 
@@ -158,7 +256,7 @@ func (m *Model) renderOrgDashboard() string {
 			nameHeader = "Engineer ▼"
 		}
 
-		header := fmt.Sprintf("%s%-*s %9s %9s %8s %8s %8s", rowPrefix, nameWidth, nameHeader, headerCommits, headerReviews, headerLOC, headerMerged, headerOpen)
+		header := fmt.Sprintf("%s%-*s %9s %12s %8s %8s %8s %8s %8s %11s %11s", rowPrefix, nameWidth, nameHeader, headerCommits, headerReviews, headerLOC, headerMerged, "Trend", headerOpen, "Issues", "Off-hrs PR", "Off-hrs Rv")
 		b.WriteString(accentStyle.Render(header))
 		b.WriteString("\n")
 		b.WriteString(subtleStyle.Render(rowPrefix + strings.Repeat("─", tableWidth)))
@@ -166,7 +264,7 @@ func (m *Model) renderOrgDashboard() string {
 
 		// Calculate visible rows
 		headerLines := 4 // title + blank + header + separator
-		footerLines := 4 // blank + summary + blank + help
+		footerLines := 6 // blank + summary + issues + freshness + blank + help
 		visibleRows := max(maxHeight-headerLines-footerLines, 3)
 
 		// Scroll offset
@@ -176,10 +274,14 @@ func (m *Model) renderOrgDashboard() string {
 		}
 
 		endIdx := min(scrollOffset+visibleRows, len(m.orgMembers))
+		totalCommits, totalReviews, totalLOC := totalOrgStats(m.orgMembers)
 
 		for i := scrollOffset; i < endIdx; i++ {
 			member := m.orgMembers[i]
 			name := "@" + member.Login
+			if member.Bot && !strings.HasSuffix(strings.ToLower(member.Login), "[bot]") {
+				name += " (bot)"
+			}
 			if len(name) > nameWidth {
 				name = name[:nameWidth-1] + "…"
 			}
@@ -190,11 +292,17 @@ func (m *Model) renderOrgDashboard() string {
 			merged := len(member.MergedPRs)
 			open := len(member.OpenPRs)
 
-			line := fmt.Sprintf("%-*s %9d %9d %8d %8d %8d", nameWidth, name, commits, reviews, loc, merged, open)
+			offHoursMerges := formatAfterHours(m.afterHoursMerges(member.MergedPRs), merged)
+			offHoursReviews := formatAfterHours(m.afterHoursReviews(member.ReviewTimes), len(member.ReviewTimes))
+			issues := fmt.Sprintf("+%d/-%d", member.IssuesOpened, member.IssuesClosed)
+			line := fmt.Sprintf("%-*s %9d %12s %8d %8d %8s %8d %8s %11s %11s", nameWidth, name, commits, formatReviewLoad(reviews, totalReviews), loc, merged, renderSparkline(member.WeeklyMerged), open, issues, offHoursMerges, offHoursReviews)
 
-			if i == m.orgSelectedIndex {
+			switch {
+			case i == m.orgSelectedIndex:
 				b.WriteString(selectedStyle.Render(selectedRowPrefix + line))
-			} else {
+			case !member.Active():
+				b.WriteString(subtleStyle.Render(rowPrefix + line))
+			default:
 				b.WriteString(normalStyle.Render(rowPrefix + line))
 			}
 			b.WriteString("\n")
@@ -209,17 +317,43 @@ func (m *Model) renderOrgDashboard() string {
 
 		// Summary
 		b.WriteString("\n")
-		totalCommits, totalReviews, totalLOC := totalOrgStats(m.orgMembers)
-		summary := fmt.Sprintf("%d engineers active  ·  %d commits  ·  %d reviews  ·  %d LOC  ·  %d PRs merged",
-			len(m.orgMembers), totalCommits, totalReviews, totalLOC, totalMergedPRs(m.orgMembers))
+		totalMerged := totalMergedPRs(m.orgMembers)
+		totalAfterHours, timedReviews, afterHoursReviews := 0, 0, 0
+		for _, member := range m.orgMembers {
+			totalAfterHours += m.afterHoursMerges(member.MergedPRs)
+			timedReviews += len(member.ReviewTimes)
+			afterHoursReviews += m.afterHoursReviews(member.ReviewTimes)
+		}
+		active, bots := 0, 0
+		for _, member := range m.orgMembers {
+			switch {
+			case member.Bot:
+				bots++
+			case member.Active():
+				active++
+			}
+		}
+		summary := fmt.Sprintf("%d engineers active", active)
+		if inactive := len(m.orgLastLoadSummary.InactiveMembers); inactive > 0 {
+			summary += fmt.Sprintf(" (%d inactive)", inactive)
+		}
+		if bots > 0 {
+			summary += fmt.Sprintf(" + %d bots", bots)
+		}
+		summary += fmt.Sprintf("  ·  %d commits  ·  %d reviews (%s after hours)  ·  %d LOC  ·  %d PRs merged (%s after hours)",
+			totalCommits, totalReviews, formatAfterHours(afterHoursReviews, timedReviews), totalLOC, totalMerged, formatAfterHours(totalAfterHours, totalMerged))
 		if m.orgLastLoadSummary.Duration > 0 {
 			summary += fmt.Sprintf("  ·  loaded in %s", formatLoadDuration(m.orgLastLoadSummary.Duration))
 		}
 		b.WriteString(accentStyle.Render(summary))
+		b.WriteString("\n")
+		b.WriteString(accentStyle.Render(formatIssueSummary(m.orgLastLoadSummary)))
+		b.WriteString("\n")
+		b.WriteString(m.renderOrgFreshness(accentStyle, subtleStyle, errorStyle))
 		b.WriteString("\n\n")
 
 		// Help
-		b.WriteString(subtleStyle.Render("↑↓: navigate  ←→/s: sort  enter: details  r: refresh  esc: close"))
+		b.WriteString(subtleStyle.Render("↑↓: navigate  ←→/s: sort  enter: details  H: heatmap  g: group by repo  M: review matrix  S: summary  i: inactive  b: bots  O: switch org  T: team  r: refresh  esc: close"))
 	}
 
 	box := lipgloss.NewStyle().
@@ -232,6 +366,33 @@ func (m *Model) renderOrgDashboard() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
 }
 
+// renderOrgFreshness renders a one-line indicator of how old the displayed
+// org data is, or the progress of the background refresh replacing it.
+func (m *Model) renderOrgFreshness(accentStyle, subtleStyle, errorStyle lipgloss.Style) string {
+	if m.orgLoading {
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		line := fmt.Sprintf("%s Refreshing in background", spinner)
+		if m.orgStreaming {
+			line = fmt.Sprintf("%s Loading, showing partial results", spinner)
+		}
+		for _, step := range orgLoadingSteps {
+			if p, ok := m.orgLoadProgress[step]; ok && !p.Done {
+				line += fmt.Sprintf(" · %s: %s", step, p.Detail)
+				break
+			}
+		}
+		return accentStyle.Render(line)
+	}
+	updated := "never"
+	if !m.orgUpdatedAt.IsZero() {
+		updated = formatDuration(time.Since(m.orgUpdatedAt))
+	}
+	if m.orgError != nil {
+		return errorStyle.Render(fmt.Sprintf("Refresh failed: %s (showing data from %s)", m.orgError, updated))
+	}
+	return subtleStyle.Render(fmt.Sprintf("Last updated %s", updated))
+}
+
 func (m *Model) renderOrgLoading(maxWidth int, accentStyle, subtleStyle lipgloss.Style) string {
 	spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
 	elapsed := time.Since(m.orgLoadStartedAt)
@@ -346,11 +507,132 @@ func (m *Model) renderOrgInput() string {
 	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render("Enter GitHub Organization"))
+	title := "Enter GitHub Organization"
+	if len(m.orgScopes) > 0 || m.orgName != "" {
+		title = "Switch Org Dashboard"
+	}
+	b.WriteString(titleStyle.Render(title))
 	b.WriteString("\n\n")
 	b.WriteString(m.orgInput.View())
 	b.WriteString("\n\n")
-	b.WriteString(subtleStyle.Render("enter: confirm  esc: cancel"))
+	var picker strings.Builder
+	m.renderOrgPicker(&picker)
+	if picker.Len() > 0 {
+		b.WriteString(picker.String())
+		b.WriteString("\n\n")
+	}
+	b.WriteString(subtleStyle.Render("↑↓: choose  tab: complete  ctrl+x: forget  enter: confirm  esc: cancel"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// setOrgMembers replaces the org table rows, keeping the cursor on the same
+// engineer across refreshes.
+func (m *Model) setOrgMembers(members []github.OrgMemberActivity) {
+	selected := ""
+	if m.orgSelectedIndex < len(m.orgMembers) {
+		selected = m.orgMembers[m.orgSelectedIndex].Login
+	}
+	m.orgLoadedMembers = members
+	m.orgMembers = m.orgRows(members)
+	m.orgSelectedIndex = 0
+	m.sortOrgMembers()
+	for i, member := range m.orgMembers {
+		if member.Login == selected {
+			m.orgSelectedIndex = i
+			break
+		}
+	}
+}
+
+// loadCachedOrgData replaces the org table with the on-disk cache for the
+// current scope, so the overlay has something to show while refreshing.
+func (m *Model) loadCachedOrgData() {
+	m.orgMembers = nil
+	m.orgLoadedMembers = nil
+	m.orgUpdatedAt = time.Time{}
+	m.orgLastLoadSummary = github.OrgActivitySummary{}
+	m.orgSelectedIndex = 0
+	m.reviewMatrix = nil
+	m.reviewMatrixError = nil
+	if entry, ok := config.LoadOrgCache(m.orgScopeLabel()); ok {
+		m.orgUpdatedAt = entry.UpdatedAt
+		m.orgLastLoadSummary = entry.Summary
+		m.setOrgMembers(entry.Members)
+	}
+}
+
+// orgRows returns the org table rows for the loaded members: bots only when
+// shown, plus an empty row for each member the last load found no activity
+// for when inactive members are shown.
+func (m *Model) orgRows(members []github.OrgMemberActivity) []github.OrgMemberActivity {
+	rows := slices.Clone(members)
+	if !m.botSettings.Show {
+		rows = slices.DeleteFunc(rows, func(a github.OrgMemberActivity) bool { return a.Bot })
+	}
+	if !m.orgShowInactive {
+		return rows
+	}
+	listed := make(map[string]bool, len(members))
+	for _, a := range members {
+		listed[strings.ToLower(a.Login)] = true
+	}
+	for _, login := range m.orgLastLoadSummary.InactiveMembers {
+		if !listed[strings.ToLower(login)] {
+			rows = append(rows, github.OrgMemberActivity{Login: login})
+		}
+	}
+	return rows
+}
+
+// toggleInactiveMembers shows or hides the members without activity.
+func (m *Model) toggleInactiveMembers() {
+	m.orgShowInactive = !m.orgShowInactive
+	m.setOrgMembers(m.orgLoadedMembers)
+}
+
+// toggleBots shows or hides bot activity in the org table, remembering the
+// choice.
+func (m *Model) toggleBots() {
+	m.botSettings.Show = !m.botSettings.Show
+	_ = config.SaveBotSettings(m.botSettings)
+	m.setOrgMembers(m.orgLoadedMembers)
+}
+
+// orgDataStale reports whether the displayed org data should be refreshed.
+func (m *Model) orgDataStale() bool {
+	return m.orgUpdatedAt.IsZero() || time.Since(m.orgUpdatedAt) > orgCacheTTL
+}
+
+// orgScopeLabel returns the org name, suffixed with the team slug when the
+// dashboard is scoped to a team.
+func (m *Model) orgScopeLabel() string {
+	if m.orgTeam != "" {
+		return m.orgName + "/" + m.orgTeam
+	}
+	return m.orgName
+}
+
+// renderTeamInput renders the team slug text input overlay.
+func (m *Model) renderTeamInput() string {
+	maxWidth := max(min(56, m.width-4), 30)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Scope %s to a Team", m.orgName)))
+	b.WriteString("\n\n")
+	b.WriteString(m.teamInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(subtleStyle.Render("enter: confirm (empty clears)  esc: cancel"))
 
 	box := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
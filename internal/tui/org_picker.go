@@ -0,0 +1,250 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// orgPickerRows is how many matching organizations the org input lists.
+const orgPickerRows = 8
+
+// openOrgInput shows the org name input, which also switches between org
+// dashboards, fetching the user's organizations to suggest the first time
+// it opens.
+func (m *Model) openOrgInput() tea.Cmd {
+	m.orgInputActive = true
+	m.orgPickIndex, m.orgPicked = 0, false
+	m.orgInput.SetValue("")
+	cmds := []tea.Cmd{m.orgInput.Focus()}
+	if m.userOrgs == nil && !m.userOrgsLoading {
+		m.userOrgsLoading = true
+		m.userOrgsErr = nil
+		cmds = append(cmds, bannerTick(), fetchUserOrgs(m.ctx, m.githubClient, m.username))
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchUserOrgs creates a command that lists the organizations username
+// belongs to.
+func fetchUserOrgs(ctx context.Context, client *github.Client, username string) tea.Cmd {
+	return func() tea.Msg {
+		orgs, err := client.Orgs.ListUserOrgs(ctx)
+		return UserOrgsMsg{Username: username, Orgs: orgs, Err: err}
+	}
+}
+
+// handleUserOrgs stores the fetched organizations for the org input. A
+// failed fetch only shows in the input; the slug can still be typed.
+func (m *Model) handleUserOrgs(msg UserOrgsMsg) {
+	if msg.Username != m.username {
+		return
+	}
+	m.userOrgsLoading = false
+	if msg.Err != nil {
+		m.userOrgsErr = msg.Err
+		return
+	}
+	m.userOrgs = msg.Orgs
+	if m.userOrgs == nil {
+		m.userOrgs = []string{}
+	}
+	m.orgPickIndex, m.orgPicked = 0, false
+}
+
+// orgCandidates returns the org dashboards to switch between, the current
+// one included, followed by the user's other organizations.
+func (m *Model) orgCandidates() []string {
+	var candidates []string
+	seen := make(map[string]bool)
+	add := func(scope string) {
+		if scope != "" && !seen[strings.ToLower(scope)] {
+			seen[strings.ToLower(scope)] = true
+			candidates = append(candidates, scope)
+		}
+	}
+	for _, scope := range m.orgScopes {
+		add(scope)
+	}
+	if m.orgName != "" {
+		add(m.orgScopeLabel())
+	}
+	for _, org := range m.userOrgs {
+		add(org)
+	}
+	return candidates
+}
+
+// orgMatches returns the org candidates that contain the typed text,
+// ignoring case, with those starting with it first.
+func (m *Model) orgMatches() []string {
+	candidates := m.orgCandidates()
+	typed := strings.ToLower(strings.TrimSpace(m.orgInput.Value()))
+	if typed == "" {
+		return candidates
+	}
+	var prefix, contains []string
+	for _, org := range candidates {
+		lower := strings.ToLower(org)
+		switch {
+		case strings.HasPrefix(lower, typed):
+			prefix = append(prefix, org)
+		case strings.Contains(lower, typed):
+			contains = append(contains, org)
+		}
+	}
+	return append(prefix, contains...)
+}
+
+// handleOrgInputKey handles keyboard events in the org name input: the
+// arrows move through the matching dashboards and organizations, tab
+// completes the highlighted one, ctrl+x forgets a saved dashboard and enter
+// opens the typed scope, or the highlighted one when nothing is typed or
+// one was picked with the arrows.
+func (m *Model) handleOrgInputKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	matches := m.orgMatches()
+	switch {
+	case key.Matches(msg, inputKeys.Cancel):
+		m.orgInputActive = false
+		// Switching dashboards goes back to the current one
+		m.showOrgDashboard = m.orgName != ""
+		return m, nil
+	case key.Matches(msg, orgInputKeys.Up):
+		if m.orgPickIndex > 0 {
+			m.orgPickIndex--
+		}
+		m.orgPicked = len(matches) > 0
+		return m, nil
+	case key.Matches(msg, orgInputKeys.Down):
+		if m.orgPickIndex < len(matches)-1 {
+			m.orgPickIndex++
+		}
+		m.orgPicked = len(matches) > 0
+		return m, nil
+	case key.Matches(msg, orgInputKeys.Complete):
+		if m.orgPickIndex < len(matches) {
+			m.orgInput.SetValue(matches[m.orgPickIndex])
+			m.orgInput.CursorEnd()
+			m.orgPickIndex, m.orgPicked = 0, false
+		}
+		return m, nil
+	case key.Matches(msg, orgInputKeys.Forget):
+		if m.orgPickIndex < len(matches) {
+			m.forgetOrgScope(matches[m.orgPickIndex])
+			m.orgPickIndex = max(0, min(m.orgPickIndex, len(m.orgMatches())-1))
+		}
+		return m, nil
+	case key.Matches(msg, inputKeys.Confirm):
+		val := strings.TrimSpace(m.orgInput.Value())
+		if (val == "" || m.orgPicked) && m.orgPickIndex < len(matches) {
+			val = matches[m.orgPickIndex]
+		}
+		if val == "" {
+			return m, nil
+		}
+		m.orgInputActive = false
+		return m, m.switchOrgScope(val)
+	}
+
+	before := m.orgInput.Value()
+	var cmd tea.Cmd
+	m.orgInput, cmd = m.orgInput.Update(msg)
+	if m.orgInput.Value() != before {
+		m.orgPickIndex, m.orgPicked = 0, false
+	}
+	return m, cmd
+}
+
+// switchOrgScope points the org dashboard at scope, an "org" or "org/team"
+// scope, showing its cached activity and refreshing it when stale.
+func (m *Model) switchOrgScope(scope string) tea.Cmd {
+	org, team, _ := strings.Cut(scope, "/")
+	m.orgName, m.orgTeam = strings.TrimSpace(org), strings.TrimSpace(team)
+	m.showOrgDashboard = true
+	_ = config.SaveOrg(m.orgName)
+	_ = config.SaveTeam(m.orgTeam)
+	m.rememberOrgScope()
+	m.cancelOrgLoad()
+	m.loadCachedOrgData()
+	m.updateTimelineList()
+	if m.orgDataStale() {
+		return m.beginOrgLoad(true)
+	}
+	return nil
+}
+
+// rememberOrgScope adds the current org dashboard to the ones to switch
+// between.
+func (m *Model) rememberOrgScope() {
+	scope := m.orgScopeLabel()
+	if m.orgName == "" || slices.Contains(m.orgScopes, scope) {
+		return
+	}
+	m.orgScopes = append(m.orgScopes, scope)
+	m.saveOrgScopes()
+}
+
+// forgetOrgScope removes scope from the org dashboards to switch between.
+// The current dashboard and the user's organizations stay listed.
+func (m *Model) forgetOrgScope(scope string) {
+	i := slices.Index(m.orgScopes, scope)
+	if i < 0 {
+		return
+	}
+	m.orgScopes = slices.Delete(m.orgScopes, i, i+1)
+	m.saveOrgScopes()
+}
+
+// saveOrgScopes writes the org dashboards to config.toml, showing why when
+// they can't be kept.
+func (m *Model) saveOrgScopes() {
+	if err := config.SaveOrgs(m.orgScopes); err != nil {
+		m.err = fmt.Errorf("save org dashboards: %w", err)
+	}
+}
+
+// renderOrgPicker renders the dashboards and organizations matching the
+// org input, the highlighted one marked, under the input.
+func (m *Model) renderOrgPicker(b *strings.Builder) {
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+
+	matches := m.orgMatches()
+	// Scroll so the highlighted org stays in view
+	start := max(0, m.orgPickIndex-orgPickerRows+1)
+	end := min(len(matches), start+orgPickerRows)
+	var lines []string
+	for i := start; i < end; i++ {
+		label := matches[i]
+		if m.orgName != "" && label == m.orgScopeLabel() {
+			label += " (current)"
+		}
+		if i == m.orgPickIndex {
+			lines = append(lines, selectedStyle.Render("▸ "+label))
+		} else {
+			lines = append(lines, normalStyle.Render("  "+label))
+		}
+	}
+	if rest := len(matches) - end; rest > 0 {
+		lines = append(lines, subtleStyle.Render(fmt.Sprintf("  +%d more", rest)))
+	}
+
+	switch {
+	case m.userOrgsLoading:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		lines = append(lines, subtleStyle.Render(spinner+" loading your organizations…"))
+	case m.userOrgsErr != nil:
+		lines = append(lines, subtleStyle.Render("couldn't list your organizations: "+m.userOrgsErr.Error()))
+	case len(matches) == 0 && m.userOrgs != nil:
+		lines = append(lines, subtleStyle.Render("no matching organization"))
+	}
+	b.WriteString(strings.Join(lines, "\n"))
+}
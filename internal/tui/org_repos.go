@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// orgRepoActivity aggregates org activity for one repository, for the
+// repository-grouped org view.
+type orgRepoActivity struct {
+	Owner          string
+	Repo           string
+	Merged         int
+	Open           int
+	AvgTimeToMerge time.Duration
+	Contributors   []orgRepoContributor // most active first
+}
+
+// orgRepoContributor counts one engineer's PRs (merged + open) in a repo.
+type orgRepoContributor struct {
+	Login string
+	PRs   int
+}
+
+// FullName returns "owner/repo".
+func (r orgRepoActivity) FullName() string {
+	return r.Owner + "/" + r.Repo
+}
+
+// buildOrgRepoActivity regroups per-member activity by repository, busiest
+// repositories first.
+func buildOrgRepoActivity(members []github.OrgMemberActivity) []orgRepoActivity {
+	type accum struct {
+		activity     orgRepoActivity
+		mergeTime    time.Duration
+		timedMerges  int
+		contributors map[string]int
+	}
+	byRepo := make(map[string]*accum)
+	get := func(owner, repo string) *accum {
+		key := owner + "/" + repo
+		a, ok := byRepo[key]
+		if !ok {
+			a = &accum{activity: orgRepoActivity{Owner: owner, Repo: repo}, contributors: make(map[string]int)}
+			byRepo[key] = a
+		}
+		return a
+	}
+
+	for _, member := range members {
+		for _, pr := range member.MergedPRs {
+			a := get(pr.Owner, pr.Repo)
+			a.activity.Merged++
+			a.contributors[member.Login]++
+			if !pr.CreatedAt.IsZero() && !pr.MergedAt.IsZero() {
+				a.mergeTime += pr.MergedAt.Sub(pr.CreatedAt)
+				a.timedMerges++
+			}
+		}
+		for _, pr := range member.OpenPRs {
+			a := get(pr.Owner, pr.Repo)
+			a.activity.Open++
+			a.contributors[member.Login]++
+		}
+	}
+
+	repos := make([]orgRepoActivity, 0, len(byRepo))
+	for _, a := range byRepo {
+		if a.timedMerges > 0 {
+			a.activity.AvgTimeToMerge = a.mergeTime / time.Duration(a.timedMerges)
+		}
+		for login, n := range a.contributors {
+			a.activity.Contributors = append(a.activity.Contributors, orgRepoContributor{Login: login, PRs: n})
+		}
+		sort.Slice(a.activity.Contributors, func(i, j int) bool {
+			ci, cj := a.activity.Contributors[i], a.activity.Contributors[j]
+			if ci.PRs != cj.PRs {
+				return ci.PRs > cj.PRs
+			}
+			return strings.ToLower(ci.Login) < strings.ToLower(cj.Login)
+		})
+		repos = append(repos, a.activity)
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		if repos[i].Merged != repos[j].Merged {
+			return repos[i].Merged > repos[j].Merged
+		}
+		if repos[i].Open != repos[j].Open {
+			return repos[i].Open > repos[j].Open
+		}
+		return strings.ToLower(repos[i].FullName()) < strings.ToLower(repos[j].FullName())
+	})
+	return repos
+}
+
+// orgRowCount returns the number of rows in the current org view grouping.
+func (m *Model) orgRowCount() int {
+	if m.orgGroupByRepo {
+		return len(buildOrgRepoActivity(m.orgMembers))
+	}
+	return len(m.orgMembers)
+}
+
+// formatMergeTime formats an average time to merge compactly, e.g. "3h" or "2.5d".
+func formatMergeTime(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "-"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%.1fd", d.Hours()/24)
+	}
+}
+
+// renderOrgRepoTable renders the repository-grouped org table, its summary,
+// and help line.
+func (m *Model) renderOrgRepoTable(innerWidth, maxHeight int, accentStyle, subtleStyle, selectedStyle, normalStyle, errorStyle lipgloss.Style) string {
+	var b strings.Builder
+	repos := buildOrgRepoActivity(m.orgMembers)
+
+	rowPrefix := "  "
+	selectedRowPrefix := "▸ "
+	statsWidth := 27 // " %8s %8s %9s"
+	tableWidth := max(innerWidth-lipgloss.Width(rowPrefix), 0)
+	contribWidth := max((tableWidth-statsWidth)/2, 12)
+	nameWidth := max(tableWidth-statsWidth-contribWidth-1, 16)
+
+	header := fmt.Sprintf("%s%-*s %8s %8s %9s %-*s", rowPrefix, nameWidth, "Repository ▼", "Merged", "Open", "Avg TTM", contribWidth, "Top Contributors")
+	b.WriteString(accentStyle.Render(header))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(rowPrefix + strings.Repeat("─", tableWidth)))
+	b.WriteString("\n")
+
+	headerLines := 4 // title + blank + header + separator
+	footerLines := 5 // blank + summary + freshness + blank + help
+	visibleRows := max(maxHeight-headerLines-footerLines, 3)
+
+	scrollOffset := 0
+	if m.orgSelectedIndex >= visibleRows {
+		scrollOffset = m.orgSelectedIndex - visibleRows + 1
+	}
+	endIdx := min(scrollOffset+visibleRows, len(repos))
+
+	totalMerged, totalOpen := 0, 0
+	for _, r := range repos {
+		totalMerged += r.Merged
+		totalOpen += r.Open
+	}
+
+	for i := scrollOffset; i < endIdx; i++ {
+		r := repos[i]
+		var top []string
+		for _, c := range r.Contributors[:min(len(r.Contributors), 3)] {
+			top = append(top, fmt.Sprintf("@%s (%d)", c.Login, c.PRs))
+		}
+		name := truncateOrgLoadingText(r.FullName(), nameWidth)
+		contributors := truncateOrgLoadingText(strings.Join(top, ", "), contribWidth)
+		line := fmt.Sprintf("%-*s %8d %8d %9s %-*s", nameWidth, name, r.Merged, r.Open, formatMergeTime(r.AvgTimeToMerge), contribWidth, contributors)
+
+		if i == m.orgSelectedIndex {
+			b.WriteString(selectedStyle.Render(selectedRowPrefix + line))
+		} else {
+			b.WriteString(normalStyle.Render(rowPrefix + line))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(repos) > visibleRows {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(repos))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	summary := fmt.Sprintf("%d repositories active  ·  %d PRs merged  ·  %d PRs open", len(repos), totalMerged, totalOpen)
+	b.WriteString(accentStyle.Render(summary))
+	b.WriteString("\n")
+	b.WriteString(m.renderOrgFreshness(accentStyle, subtleStyle, errorStyle))
+	b.WriteString("\n\n")
+	b.WriteString(subtleStyle.Render("↑↓: navigate  enter: open repo  g: group by engineer  M: review matrix  T: team  r: refresh  esc: close"))
+
+	return b.String()
+}
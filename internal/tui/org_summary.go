@@ -0,0 +1,201 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// orgSummaryRepos is how many of the busiest repositories the org summary
+// lists, and orgSummaryUnreviewed how many PRs merged without review.
+const (
+	orgSummaryRepos      = 5
+	orgSummaryUnreviewed = 5
+)
+
+// orgSummary aggregates the org table's members for the summary overlay.
+type orgSummary struct {
+	Weekly      []BarChartData
+	Merged      int
+	MedianMerge time.Duration
+	// Unreviewed are the merged PRs nobody reviewed, newest first
+	Unreviewed []github.MergedPRInfo
+	Repos      []orgRepoActivity
+}
+
+// buildOrgSummary aggregates the members shown in the org table, so hidden
+// bots and the team scope apply to the summary too.
+func (m *Model) buildOrgSummary() orgSummary {
+	var s orgSummary
+
+	// Weekly merge counts, summed across members, oldest week first
+	weeks := 0
+	for _, member := range m.orgMembers {
+		weeks = max(weeks, len(member.WeeklyMerged))
+	}
+	counts := make([]int, weeks)
+	for _, member := range m.orgMembers {
+		// Align the members' trends on the current week
+		offset := weeks - len(member.WeeklyMerged)
+		for i, n := range member.WeeklyMerged {
+			counts[offset+i] += n
+		}
+	}
+	now := time.Now()
+	for i, n := range counts {
+		_, week := now.AddDate(0, 0, -(weeks-1-i)*7).ISOWeek()
+		s.Weekly = append(s.Weekly, BarChartData{Label: fmt.Sprintf("W%d", week), Value: n})
+	}
+
+	unreviewed := make(map[string]bool, len(m.orgLastLoadSummary.UnreviewedMerged))
+	for _, k := range m.orgLastLoadSummary.UnreviewedMerged {
+		unreviewed[k] = true
+	}
+	var durations []time.Duration
+	for _, member := range m.orgMembers {
+		for _, pr := range member.MergedPRs {
+			s.Merged++
+			if !pr.CreatedAt.IsZero() && !pr.MergedAt.IsZero() {
+				durations = append(durations, pr.MergedAt.Sub(pr.CreatedAt))
+			}
+			if unreviewed[github.PRKey(pr.Owner, pr.Repo, pr.Number)] {
+				s.Unreviewed = append(s.Unreviewed, pr)
+			}
+		}
+	}
+	if len(durations) > 0 {
+		slices.Sort(durations)
+		s.MedianMerge = durations[len(durations)/2]
+	}
+	slices.SortFunc(s.Unreviewed, func(a, b github.MergedPRInfo) int {
+		return b.MergedAt.Compare(a.MergedAt)
+	})
+
+	s.Repos = buildOrgRepoActivity(m.orgMembers)
+	return s
+}
+
+// handleOrgSummaryKey handles keyboard events in the org summary overlay.
+func (m *Model) handleOrgSummaryKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, orgSummaryKeys.Close) {
+		m.showOrgSummary = false
+	}
+	return m, nil
+}
+
+// renderOrgSummary renders the org-level summary overlay: merged PRs per
+// week, median time to merge, review coverage and the busiest repositories.
+func (m *Model) renderOrgSummary() string {
+	maxWidth := max(min(80, m.width-4), 40)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	successStyle := lipgloss.NewStyle().Foreground(m.theme.StatusSuccess)
+	warnStyle := lipgloss.NewStyle().Foreground(m.theme.StatusPending)
+	failureStyle := lipgloss.NewStyle().Foreground(m.theme.StatusFailure)
+
+	sep := subtleStyle.Render(strings.Repeat("─", maxWidth-6))
+	s := m.buildOrgSummary()
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s - Org Summary (last 7 days)", m.orgScopeLabel())))
+	b.WriteString("\n\n")
+
+	if len(m.orgMembers) == 0 {
+		b.WriteString(subtleStyle.Render("No org activity loaded yet."))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("esc: back"))
+		return m.placeOrgSummary(b.String(), maxWidth)
+	}
+
+	// Merged PRs per week
+	b.WriteString(accentStyle.Render("PRs Merged Per Week"))
+	b.WriteString("\n")
+	b.WriteString(sep)
+	b.WriteString("\n")
+	if chart := renderBarChart(s.Weekly, maxWidth-6, 10, m.theme.Accent, m.theme.Subtle, m.theme.StatusSuccess); chart != "" {
+		b.WriteString(chart)
+	} else {
+		b.WriteString(subtleStyle.Render("No merge history yet."))
+	}
+	b.WriteString("\n\n")
+
+	// Time to merge and review coverage
+	b.WriteString(accentStyle.Render("Merges This Week"))
+	b.WriteString("\n")
+	b.WriteString(sep)
+	b.WriteString("\n")
+	b.WriteString(normalStyle.Render(fmt.Sprintf("%d PRs merged  ·  median time to merge ", s.Merged)))
+	b.WriteString(accentStyle.Render(formatMergeDuration(s.MedianMerge)))
+	b.WriteString("\n")
+	switch {
+	case !m.orgLastLoadSummary.CoverageKnown:
+		b.WriteString(subtleStyle.Render("Review coverage unavailable; refresh to search for unreviewed merges."))
+		b.WriteString("\n")
+	case s.Merged > 0:
+		reviewed := s.Merged - len(s.Unreviewed)
+		pct := reviewed * 100 / s.Merged
+		style := successStyle
+		switch {
+		case pct < 50:
+			style = failureStyle
+		case pct < 90:
+			style = warnStyle
+		}
+		b.WriteString(normalStyle.Render("Review coverage "))
+		b.WriteString(style.Render(fmt.Sprintf("%d%%", pct)))
+		b.WriteString(normalStyle.Render(fmt.Sprintf("  ·  %d of %d reviewed  ·  %d merged without review", reviewed, s.Merged, len(s.Unreviewed))))
+		b.WriteString("\n")
+		for _, pr := range s.Unreviewed[:min(len(s.Unreviewed), orgSummaryUnreviewed)] {
+			line := fmt.Sprintf("  %s/%s#%d @%s  %s", pr.Owner, pr.Repo, pr.Number, pr.Author, pr.Title)
+			b.WriteString(subtleStyle.Render(truncateOrgLoadingText(line, maxWidth-6)))
+			b.WriteString("\n")
+		}
+		if rest := len(s.Unreviewed) - orgSummaryUnreviewed; rest > 0 {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf("  +%d more", rest)))
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString("\n")
+
+	// Busiest repositories
+	b.WriteString(accentStyle.Render("Busiest Repositories"))
+	b.WriteString("\n")
+	b.WriteString(sep)
+	b.WriteString("\n")
+	if len(s.Repos) == 0 {
+		b.WriteString(subtleStyle.Render("No PRs this week."))
+		b.WriteString("\n")
+	}
+	nameWidth := max(maxWidth-6-36, 16)
+	for _, repo := range s.Repos[:min(len(s.Repos), orgSummaryRepos)] {
+		line := fmt.Sprintf("%-*s %8s %6s %14s", nameWidth, truncateOrgLoadingText(repo.FullName(), nameWidth),
+			fmt.Sprintf("%d merged", repo.Merged), fmt.Sprintf("%d open", repo.Open), "avg "+formatMergeTime(repo.AvgTimeToMerge))
+		b.WriteString(normalStyle.Render(line))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("esc: back"))
+
+	return m.placeOrgSummary(b.String(), maxWidth)
+}
+
+// placeOrgSummary boxes the org summary content and centers it.
+func (m *Model) placeOrgSummary(content string, maxWidth int) string {
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(content)
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// defaultPager is used when $PAGER is unset.
+const defaultPager = "less -R"
+
+// openInPager suspends the TUI and pipes content into the user's $PAGER,
+// resuming when it exits.
+func openInPager(content string) tea.Cmd {
+	pager := os.Getenv("PAGER")
+	if pager == "" {
+		pager = defaultPager
+	}
+	// Through the shell so $PAGER may carry flags, e.g. "bat -l diff"
+	cmd := exec.Command("sh", "-c", pager)
+	cmd.Stdin = strings.NewReader(content)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		if err != nil {
+			err = fmt.Errorf("pager %q: %w", pager, err)
+		}
+		return PagerFinishedMsg{Err: err}
+	})
+}
+
+// threadText renders the comment thread overlay's content as plain text.
+func (m *Model) threadText() string {
+	n := m.threadNotification
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n%s\n%s\n", n.Subject.Title, n.Repository.FullName, m.notificationURL(n))
+	for _, c := range m.threadComments {
+		fmt.Fprintf(&b, "\n@%s · %s\n\n", c.Author, c.CreatedAt.Local().Format(time.DateTime))
+		body := strings.TrimSpace(strings.ReplaceAll(c.Body, "\r\n", "\n"))
+		if body == "" {
+			body = "(no text)"
+		}
+		b.WriteString(body)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// prFilesText renders the PR files overlay's content as a unified diff.
+func (m *Model) prFilesText() string {
+	info := m.prFilesInfo
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%s#%d %s\n%s/files\n", info.Owner, info.Repo, info.Number, info.Title, info.URL)
+	for _, f := range m.prFiles {
+		from := f.Filename
+		if f.PreviousFilename != "" {
+			from = f.PreviousFilename
+		}
+		fmt.Fprintf(&b, "\ndiff --git a/%s b/%s\n--- a/%s\n+++ b/%s\n", from, f.Filename, from, f.Filename)
+		if f.Patch == "" {
+			// GitHub omits patches for binary and very large files
+			fmt.Fprintf(&b, "(no patch: %s, +%d -%d)\n", f.Status, f.Additions, f.Deletions)
+			continue
+		}
+		b.WriteString(f.Patch)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,314 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// panelPollInterval is how often custom panels are re-queried.
+const panelPollInterval = 2 * time.Minute
+
+// panelState holds the latest result of one custom panel query.
+type panelState struct {
+	rows      []map[string]any
+	err       error
+	loading   bool
+	updatedAt time.Time
+}
+
+// fetchPanel creates a command that runs one custom panel's query.
+func fetchPanel(ctx context.Context, client *github.Client, index int, panel config.Panel) tea.Cmd {
+	return func() tea.Msg {
+		var (
+			rows []map[string]any
+			err  error
+		)
+		if panel.GraphQL != "" {
+			rows, err = client.Search.GraphQLRows(ctx, panel.GraphQL, panel.Items)
+		} else {
+			rows, err = client.Search.SearchIssuesRaw(ctx, panel.Query)
+		}
+		return PanelResultMsg{Index: index, Rows: rows, Err: err}
+	}
+}
+
+// panelPollTick schedules the next refresh of all custom panels.
+func panelPollTick() tea.Cmd {
+	return tea.Tick(panelPollInterval, func(time.Time) tea.Msg {
+		return PanelPollMsg{}
+	})
+}
+
+// refreshPanels starts queries for every configured panel.
+func (m *Model) refreshPanels() tea.Cmd {
+	cmds := make([]tea.Cmd, len(m.panels))
+	for i, panel := range m.panels {
+		m.panelStates[i].loading = true
+		cmds[i] = fetchPanel(m.ctx, m.githubClient, i, panel)
+	}
+	return tea.Batch(cmds...)
+}
+
+// panelsLoading reports whether any custom panel query is in flight.
+func (m *Model) panelsLoading() bool {
+	for _, s := range m.panelStates {
+		if s.loading {
+			return true
+		}
+	}
+	return false
+}
+
+// handlePanelsKey handles keyboard events in the custom panels overlay.
+func (m *Model) handlePanelsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, panelsKeys.Close):
+		m.showPanels = false
+		return m, nil
+
+	case key.Matches(msg, panelsKeys.Next):
+		m.panelIndex = (m.panelIndex + 1) % len(m.panels)
+		m.panelSelected = 0
+		return m, nil
+
+	case key.Matches(msg, panelsKeys.Prev):
+		m.panelIndex = (m.panelIndex - 1 + len(m.panels)) % len(m.panels)
+		m.panelSelected = 0
+		return m, nil
+
+	case key.Matches(msg, panelsKeys.Up):
+		if m.panelSelected > 0 {
+			m.panelSelected--
+		}
+		return m, nil
+
+	case key.Matches(msg, panelsKeys.Down):
+		if m.panelSelected < len(m.panelStates[m.panelIndex].rows)-1 {
+			m.panelSelected++
+		}
+		return m, nil
+
+	case key.Matches(msg, panelsKeys.Open):
+		rows := m.panelStates[m.panelIndex].rows
+		if m.panelSelected < len(rows) {
+			u := panelField(rows[m.panelSelected], "html_url")
+			if u == "" {
+				u = panelField(rows[m.panelSelected], "url")
+			}
+			if u != "" {
+				if err := browser.Open(u); err != nil {
+					m.err = err
+				}
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, panelsKeys.Refresh):
+		state := &m.panelStates[m.panelIndex]
+		if !state.loading {
+			state.loading = true
+			return m, tea.Batch(bannerTick(), fetchPanel(m.ctx, m.githubClient, m.panelIndex, m.panels[m.panelIndex]))
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// panelField resolves a dot path like "user.login" in a panel row. Arrays
+// along the path are mapped over and their values joined with commas.
+func panelField(row map[string]any, path string) string {
+	return formatPanelValue(lookupPanelPath(row, strings.Split(path, ".")))
+}
+
+func lookupPanelPath(v any, keys []string) any {
+	if len(keys) == 0 {
+		return v
+	}
+	switch node := v.(type) {
+	case map[string]any:
+		return lookupPanelPath(node[keys[0]], keys[1:])
+	case []any:
+		values := make([]any, 0, len(node))
+		for _, elem := range node {
+			values = append(values, lookupPanelPath(elem, keys))
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// formatPanelValue renders a JSON value for a panel cell. Timestamps are shown
+// relative to now.
+func formatPanelValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		if t, err := time.Parse(time.RFC3339, val); err == nil {
+			return formatDuration(time.Since(t))
+		}
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		if val {
+			return "yes"
+		}
+		return "no"
+	case []any:
+		parts := make([]string, 0, len(val))
+		for _, elem := range val {
+			if s := formatPanelValue(elem); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// panelColumnWidths assigns each column its configured width and splits the
+// remaining space evenly among the rest.
+func panelColumnWidths(columns []config.PanelColumn, width int) []int {
+	widths := make([]int, len(columns))
+	remaining := width
+	flexible := 0
+	for i, col := range columns {
+		if col.Width > 0 {
+			widths[i] = col.Width
+			remaining -= col.Width + 1
+		} else {
+			flexible++
+		}
+	}
+	if flexible > 0 {
+		share := max((remaining-flexible+1)/flexible, 6)
+		for i := range widths {
+			if widths[i] == 0 {
+				widths[i] = share
+			}
+		}
+	}
+	return widths
+}
+
+// renderPanels renders the custom query panels overlay.
+func (m *Model) renderPanels() string {
+	maxWidth := max(m.width-2, 40)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+
+	innerWidth := maxWidth - 6
+	panel := m.panels[m.panelIndex]
+	state := m.panelStates[m.panelIndex]
+
+	var b strings.Builder
+
+	// Panel tabs
+	tabs := make([]string, len(m.panels))
+	for i, p := range m.panels {
+		if i == m.panelIndex {
+			tabs[i] = titleStyle.Render("[" + p.Name + "]")
+		} else {
+			tabs[i] = subtleStyle.Render(" " + p.Name + " ")
+		}
+	}
+	b.WriteString(strings.Join(tabs, " "))
+	b.WriteString("\n")
+	source := panel.Query
+	if panel.GraphQL != "" {
+		source = "GraphQL → " + panel.Items
+	}
+	b.WriteString(subtleStyle.Render(truncateOrgLoadingText(source, innerWidth)))
+	b.WriteString("\n\n")
+
+	if state.err != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", state.err)))
+		b.WriteString("\n\n")
+	}
+
+	if state.loading && state.rows == nil {
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Loading %s...", spinner, panel.Name)))
+		b.WriteString("\n")
+	} else if len(state.rows) == 0 {
+		b.WriteString(subtleStyle.Render("No results."))
+		b.WriteString("\n")
+	} else {
+		widths := panelColumnWidths(panel.Columns, innerWidth-2)
+		cells := make([]string, len(panel.Columns))
+		for i, col := range panel.Columns {
+			cells[i] = fmt.Sprintf("%-*s", widths[i], truncateOrgLoadingText(col.Header, widths[i]))
+		}
+		b.WriteString(accentStyle.Render("  " + strings.Join(cells, " ")))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("  " + strings.Repeat("─", innerWidth-2)))
+		b.WriteString("\n")
+
+		headerLines := 7 // tabs + source + blank + header + separator + box padding
+		footerLines := 4 // scroll + blank + status + help
+		visibleRows := max(maxHeight-headerLines-footerLines, 3)
+
+		scrollOffset := 0
+		if m.panelSelected >= visibleRows {
+			scrollOffset = m.panelSelected - visibleRows + 1
+		}
+		endIdx := min(scrollOffset+visibleRows, len(state.rows))
+
+		for i := scrollOffset; i < endIdx; i++ {
+			for c, col := range panel.Columns {
+				cells[c] = fmt.Sprintf("%-*s", widths[c], truncateOrgLoadingText(panelField(state.rows[i], col.Field), widths[c]))
+			}
+			line := strings.Join(cells, " ")
+			if i == m.panelSelected {
+				b.WriteString(selectedStyle.Render("▸ " + line))
+			} else {
+				b.WriteString(normalStyle.Render("  " + line))
+			}
+			b.WriteString("\n")
+		}
+		if len(state.rows) > visibleRows {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(state.rows))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	status := "Not loaded yet"
+	if state.loading {
+		status = spinnerFrames[m.bannerFrame%len(spinnerFrames)] + " Refreshing"
+	} else if !state.updatedAt.IsZero() {
+		status = "Last updated " + formatDuration(time.Since(state.updatedAt))
+	}
+	b.WriteString(subtleStyle.Render(status))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("tab/←→: switch panel  ↑↓: navigate  enter: open  r: refresh  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,160 @@
+package tui
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/browser"
+)
+
+// popupList returns the list shown in popup mode. Popup mode only cycles
+// between the notification inbox and open PRs.
+func (m *Model) popupList() *list.Model {
+	if m.focusedPane == RightPane {
+		return &m.prList
+	}
+	return &m.list
+}
+
+// handlePopupKey implements the quick-triage keymap used by --popup. Opening
+// an item exits so the popup closes as soon as there is something to act on.
+func (m *Model) handlePopupKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	l := m.popupList()
+
+	// Let the list own the keyboard while its filter is being edited
+	if l.FilterState() == list.Filtering {
+		var cmd tea.Cmd
+		*l, cmd = l.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, popupKeys.Quit):
+		if msg.String() == "esc" && l.FilterState() == list.FilterApplied {
+			l.ResetFilter()
+			return m, nil
+		}
+		m.cancel()
+		return m, tea.Quit
+
+	case key.Matches(msg, mainKeys.Help):
+		m.showHelp = true
+		m.helpScroll = 0
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Yank):
+		return m, m.yank(false)
+
+	case key.Matches(msg, mainKeys.YankRef):
+		return m, m.yank(true)
+
+	case key.Matches(msg, popupKeys.SwitchPane):
+		if m.focusedPane == RightPane {
+			m.focusedPane = LeftPane
+		} else {
+			m.focusedPane = RightPane
+		}
+		return m, nil
+
+	case key.Matches(msg, popupKeys.Open):
+		switch m.focusedPane {
+		case RightPane:
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				if err := browser.Open(selectedItem.info.URL); err != nil {
+					m.err = err
+					return m, nil
+				}
+				return m, tea.Quit
+			}
+		default:
+			switch selectedItem := m.list.SelectedItem().(type) {
+			case NotificationItem:
+				webURL := m.notificationURL(selectedItem.notification)
+				if err := browser.Open(webURL); err != nil {
+					m.err = err
+					return m, nil
+				}
+				// Opening a notification counts as reading it
+				return m, tea.Sequence(
+					markAsRead(m.ctx, m.githubClient, selectedItem.notification.ID),
+					tea.Quit,
+				)
+			case AssignedIssueItem:
+				if err := browser.Open(selectedItem.issue.HTMLURL); err != nil {
+					m.err = err
+					return m, nil
+				}
+				return m, tea.Quit
+			case SecretAlertItem:
+				if err := browser.Open(selectedItem.alert.HTMLURL); err != nil {
+					m.err = err
+					return m, nil
+				}
+				return m, tea.Quit
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, popupKeys.MarkRead):
+		if m.focusedPane != RightPane {
+			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok {
+				return m, markAsRead(m.ctx, m.githubClient, selectedItem.notification.ID)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, popupKeys.MarkDone):
+		if m.focusedPane != RightPane {
+			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok {
+				return m, markAsDone(m.ctx, m.githubClient, selectedItem.notification.ID)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, popupKeys.Thread):
+		if m.focusedPane != RightPane {
+			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok {
+				return m, m.openThread(selectedItem.notification)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, popupKeys.Filter):
+		if m.focusedPane != RightPane {
+			m.filterMode = (m.filterMode + 1) % filterModeCount
+			m.updateNotifications(nil)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	*l, cmd = l.Update(msg)
+	return m, cmd
+}
+
+// renderPopup renders the compact single-pane layout used by --popup.
+func (m *Model) renderPopup() string {
+	errorBanner := ""
+	if m.err != nil {
+		errorBanner = m.errorStyle().Render(fmt.Sprintf("⚠ Error: %s", m.err)) + "\n"
+	}
+
+	contentWidth := max(m.width-2, 0)
+	contentHeight := max(m.height-5, 0)
+
+	l := m.popupList()
+	l.SetSize(contentWidth, contentHeight)
+	pane := m.focusedPaneStyle().
+		Width(contentWidth).
+		Height(contentHeight).
+		Render(l.View())
+
+	help := "enter: open & exit | tab: PRs | ?: help | esc: exit"
+	if m.focusedPane != RightPane {
+		help = fmt.Sprintf("enter: open & exit | v: thread | r: mark read | f: filter [%s] | tab: PRs | /: search | ?: help | esc: exit", m.filterMode)
+	}
+
+	return errorBanner + pane + "\n" + m.helpStyle().Render(m.statusIndicators()+help)
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/power"
+)
+
+// powerCheckInterval is how often the power source is re-checked.
+const powerCheckInterval = time.Minute
+
+// powerTick schedules the next power source check.
+func powerTick(ctx context.Context) tea.Cmd {
+	return tea.Tick(powerCheckInterval, func(time.Time) tea.Msg {
+		return PowerStateMsg{State: power.Detect(ctx)}
+	})
+}
+
+// isLowPower reports whether the given power state should trigger the
+// low-power profile under the configured thresholds.
+func isLowPower(settings config.PowerSettings, s power.State) bool {
+	if !settings.Enabled {
+		return false
+	}
+	if s.OnBattery && (s.BatteryPercent < 0 || s.BatteryPercent <= settings.BatteryThreshold) {
+		return true
+	}
+	return settings.Metered && s.Metered
+}
+
+// setPowerState records a new power reading and switches the poll interval
+// and animations when the low-power profile turns on or off.
+func (m *Model) setPowerState(s power.State) tea.Cmd {
+	m.powerState = s
+	low := isLowPower(m.powerSettings, s)
+	if low == m.lowPower {
+		return nil
+	}
+	m.lowPower = low
+
+	if m.poller != nil {
+		if low {
+			m.poller.SetInterval(m.powerSettings.LowPowerPollInterval())
+		} else {
+			m.poller.SetInterval(0)
+		}
+	}
+	if !low {
+		// Resume any loading animation that was paused
+		return bannerTick()
+	}
+	return nil
+}
+
+// powerIndicator returns the low-power badge for the help line, or "" when
+// running normally.
+func (m *Model) powerIndicator() string {
+	if !m.lowPower {
+		return ""
+	}
+	reason := "metered"
+	if m.powerState.OnBattery {
+		reason = "battery"
+		if m.powerState.BatteryPercent >= 0 {
+			reason = fmt.Sprintf("battery %d%%", m.powerState.BatteryPercent)
+		}
+	}
+	return lipgloss.NewStyle().Foreground(m.theme.StatusPending).Render(fmt.Sprintf("⏾ low power (%s)", reason)) + " | "
+}
@@ -6,6 +6,8 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"time"
+	"unicode"
 
 	"charm.land/bubbles/v2/list"
 	tea "charm.land/bubbletea/v2"
@@ -16,6 +18,10 @@ import (
 
 const maxCheckDots = 10
 
+// staleReviewThreshold is how long a PR can wait for its first review before
+// it is flagged in the PR pane.
+const staleReviewThreshold = 24 * time.Hour
+
 // PRDelegate is a custom list.ItemDelegate that renders PR items with
 // individually colored CI badges, review badges, check dots, and diff stats.
 type PRDelegate struct {
@@ -44,12 +50,92 @@ func (d PRDelegate) Render(w io.Writer, m list.Model, index int, item list.Item)
 
 	// Repo identifier
 	repoID := fmt.Sprintf("%s/%s#%d", prItem.info.Owner, prItem.info.Repo, prItem.info.Number)
+	waiting := prItem.info.UnreviewedFor(time.Now())
+	stale := waiting > staleReviewThreshold
 	repoColor := d.theme.NormalForeground
 	if selected {
 		repoColor = d.theme.SelectedForeground
+	} else if stale {
+		repoColor = d.theme.StatusPending
 	}
 	segments = append(segments, lipgloss.NewStyle().Foreground(repoColor).Render(repoID))
 
+	// Watchlist badge: someone else's PR I'm following
+	if prItem.info.Watched {
+		watched := "  👁 watching"
+		if prItem.info.Author != "" {
+			watched += " @" + prItem.info.Author
+		}
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Subtle).Render(watched))
+	}
+
+	// Stale review badge: waiting too long for a first review
+	if stale {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.StatusPending).Bold(true).Render("  ⌛ "+formatMergeDuration(waiting)))
+	}
+
+	// Merge conflict badge
+	if prItem.info.Conflicted {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.StatusFailure).Bold(true).Render("  ⚠ conflicts"))
+	}
+
+	// Outdated base badge: base branch has advanced a lot since branching
+	if prItem.outdated {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.StatusPending).Render(fmt.Sprintf("  ↓ outdated by %d commits", prItem.info.BehindBy)))
+	}
+
+	// On-demand refresh in progress
+	if prItem.refreshing != "" {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Accent).Render("  "+prItem.refreshing+" refreshing"))
+	}
+
+	// Auto-merge armed or being toggled
+	if prItem.autoMerge != "" {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.StatusSuccess).Render("  "+prItem.autoMerge))
+	}
+
+	// Update-branch request status
+	if prItem.update != "" {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Accent).Render("  "+prItem.update))
+	}
+
+	// Security badge: code scanning alerts introduced by the PR, colored by
+	// the most severe one
+	if alerts := prItem.info.CodeScanningAlerts; len(alerts) > 0 {
+		worst := alerts[0]
+		for _, a := range alerts[1:] {
+			if a.SeverityRank() < worst.SeverityRank() {
+				worst = a
+			}
+		}
+		segments = append(segments, lipgloss.NewStyle().Foreground(severityColor(d.theme, worst)).Bold(true).Render(fmt.Sprintf("  ⛨ %d %s", len(alerts), worst.Severity)))
+	}
+
+	// Fork sync badge: the fork's copy of the base branch is behind upstream
+	if prItem.forkSync != "" {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Accent).Render("  "+prItem.forkSync))
+	} else if prItem.info.ForkBehindBy > 0 {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.StatusPending).Render(fmt.Sprintf("  ⑂ fork %d behind", prItem.info.ForkBehindBy)))
+	}
+
+	// Milestone badge
+	if ms := prItem.info.Milestone; ms != nil {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Subtle).Render("  ◷ "+milestoneLabel(ms)))
+	}
+
+	// Title lint badge: title doesn't match the configured pattern
+	if prItem.badTitle {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.StatusPending).Bold(true).Render("  ⚠ title"))
+	}
+
+	// Ticket badges
+	for _, t := range prItem.tickets {
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Accent).Render("  "+t.Key))
+		if t.status != "" {
+			segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Subtle).Render(" "+t.status))
+		}
+	}
+
 	// CI badge
 	switch prItem.status {
 	case github.PRStatusSuccess:
@@ -70,6 +156,24 @@ func (d PRDelegate) Render(w io.Writer, m list.Model, index int, item list.Item)
 		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.StatusPending).Render("  Reviewed"))
 	}
 
+	// Reviewer initials, colored by where each review stands
+	if len(prItem.info.Reviewers) > 0 {
+		var reviewers []string
+		for _, r := range prItem.info.Reviewers {
+			reviewers = append(reviewers, d.reviewerBadge(r))
+		}
+		segments = append(segments, "  "+strings.Join(reviewers, " "))
+	}
+
+	// Assignee initials
+	if len(prItem.info.Assignees) > 0 {
+		var assignees []string
+		for _, login := range prItem.info.Assignees {
+			assignees = append(assignees, initials(login))
+		}
+		segments = append(segments, lipgloss.NewStyle().Foreground(d.theme.Subtle).Render("  ⇢ "+strings.Join(assignees, " ")))
+	}
+
 	// Check dots (one per check run, colored by result)
 	// Sort: pending first, then failed, then successful so the most
 	// important statuses are visible when truncated.
@@ -95,16 +199,16 @@ func (d PRDelegate) Render(w io.Writer, m list.Model, index int, item list.Item)
 			switch {
 			case cr.Status == "queued" || cr.Status == "in_progress":
 				dotColor = d.theme.StatusPending
-				dot = "○"
+				dot = icons.CheckPending
 			case cr.Conclusion == "success":
 				dotColor = d.theme.StatusSuccess
-				dot = "●"
+				dot = icons.CheckSuccess
 			case cr.Conclusion == "failure" || cr.Conclusion == "cancelled" || cr.Conclusion == "timed_out":
 				dotColor = d.theme.StatusFailure
-				dot = "●"
+				dot = icons.CheckFailure
 			default:
 				dotColor = d.theme.Subtle
-				dot = "●"
+				dot = icons.CheckOther
 			}
 			dots.WriteString(lipgloss.NewStyle().Foreground(dotColor).Render(dot))
 		}
@@ -138,6 +242,9 @@ func (d PRDelegate) Render(w io.Writer, m list.Model, index int, item list.Item)
 		descColor = d.theme.SelectedDesc
 	}
 	var descParts []string
+	if len(prItem.info.Labels) > 0 {
+		descParts = append(descParts, labelChips(prItem.info.Labels))
+	}
 	if prItem.info.Branch != "" {
 		descParts = append(descParts, lipgloss.NewStyle().Foreground(d.theme.Subtle).Render(prItem.info.Branch))
 	}
@@ -168,6 +275,53 @@ func (d PRDelegate) Render(w io.Writer, m list.Model, index int, item list.Item)
 	fmt.Fprint(w, rendered)
 }
 
+// reviewerBadge renders a reviewer's initials followed by their review
+// state's icon: approved, changes requested, commented or pending. Team
+// requests are prefixed with @.
+func (d PRDelegate) reviewerBadge(r github.ReviewerState) string {
+	label := initials(r.Login)
+	if r.Team {
+		label = "@" + label
+	}
+	switch r.State {
+	case github.PRReviewApproved:
+		return lipgloss.NewStyle().Foreground(d.theme.StatusSuccess).Render(label + icons.Approved)
+	case github.PRReviewChangesRequested:
+		return lipgloss.NewStyle().Foreground(d.theme.StatusFailure).Render(label + icons.ChangesRequested)
+	case github.PRReviewReviewed:
+		return lipgloss.NewStyle().Foreground(d.theme.Accent).Render(label + icons.Reviewed)
+	default:
+		return lipgloss.NewStyle().Foreground(d.theme.StatusPending).Render(label + icons.ReviewPending)
+	}
+}
+
+// initials abbreviates a login to two uppercase letters: the first letters
+// of its first two words when it has separators or camel case (jane-doe,
+// janeDoe: JD), else its first two letters.
+func initials(login string) string {
+	var letters []rune
+	prev := '-'
+	for _, r := range login {
+		switch {
+		case r == '-' || r == '_' || r == '.':
+		case unicode.IsLetter(prev) && !(unicode.IsUpper(r) && unicode.IsLower(prev)):
+		default:
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				letters = append(letters, r)
+			}
+		}
+		prev = r
+		if len(letters) == 2 {
+			break
+		}
+	}
+	if len(letters) < 2 {
+		letters = []rune(login)
+		letters = letters[:min(2, len(letters))]
+	}
+	return strings.ToUpper(string(letters))
+}
+
 // checkRunSortKey returns a sort priority for a check run:
 // 0 = pending/in-progress, 1 = failed/cancelled/timed_out, 2 = success, 3 = other.
 func checkRunSortKey(cr github.CheckRun) int {
@@ -0,0 +1,237 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// diffBarWidth is the number of blocks in each file's additions/deletions bar.
+const diffBarWidth = 5
+
+// openPRFiles shows the changed-files overlay for a PR and starts loading it.
+func (m *Model) openPRFiles(info github.PRInfo) tea.Cmd {
+	m.showPRFiles = true
+	m.prFilesInfo = info
+	m.prFiles = nil
+	m.prFilesConflicts = nil
+	m.prFilesError = nil
+	m.prFilesScroll = 0
+	m.prFilesLoading = true
+	key := github.PRKey(info.Owner, info.Repo, info.Number)
+	return tea.Batch(bannerTick(), fetchPRFiles(m.ctx, m.githubClient, key, info))
+}
+
+// fetchPRFiles creates a command that lists the files changed in a PR and,
+// when the PR is conflicted, which of them also changed on its base branch.
+func fetchPRFiles(ctx context.Context, client *github.Client, key string, info github.PRInfo) tea.Cmd {
+	return func() tea.Msg {
+		files, err := client.PullRequests.ListPullRequestFiles(ctx, info.Owner, info.Repo, info.Number)
+		if err != nil {
+			return PRFilesErrorMsg{Key: key, Err: err}
+		}
+		msg := PRFilesMsg{Key: key, Files: files}
+		if info.Conflicted && info.HeadSHA != "" && info.BaseBranch != "" {
+			msg.Conflicts, msg.ConflictErr = client.PullRequests.ListConflictingFiles(ctx, info.Owner, info.Repo, info.HeadSHA, info.BaseBranch, files)
+		}
+		return msg
+	}
+}
+
+// prFilesKey returns the key of the PR shown in the files overlay.
+func (m *Model) prFilesKey() string {
+	return github.PRKey(m.prFilesInfo.Owner, m.prFilesInfo.Repo, m.prFilesInfo.Number)
+}
+
+// handlePRFilesKey handles key events in the PR files overlay.
+func (m *Model) handlePRFilesKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, prFilesKeys.Close):
+		m.showPRFiles = false
+		return m, nil
+
+	case key.Matches(msg, prFilesKeys.Up):
+		if m.prFilesScroll > 0 {
+			m.prFilesScroll--
+		}
+		return m, nil
+
+	case key.Matches(msg, prFilesKeys.Down):
+		if m.prFilesScroll < len(m.prFiles)-1 {
+			m.prFilesScroll++
+		}
+		return m, nil
+
+	case key.Matches(msg, prFilesKeys.Open):
+		if err := browser.Open(m.prFilesInfo.URL + "/files"); err != nil {
+			m.prFilesError = err
+		}
+		return m, nil
+
+	case key.Matches(msg, prFilesKeys.Pager):
+		if m.prFilesLoading || len(m.prFiles) == 0 {
+			return m, nil
+		}
+		return m, openInPager(m.prFilesText())
+	}
+
+	return m, nil
+}
+
+// fileStatusGlyph returns a one-letter marker for a file's change status.
+func fileStatusGlyph(status string) string {
+	switch status {
+	case "added":
+		return "A"
+	case "removed":
+		return "D"
+	case "renamed":
+		return "R"
+	case "copied":
+		return "C"
+	default:
+		return "M"
+	}
+}
+
+// truncateLeft shortens s to width runes by dropping its beginning, so the
+// most specific part of a path stays visible.
+func truncateLeft(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	if width <= 1 {
+		return "…"
+	}
+	return "…" + string(r[len(r)-width+1:])
+}
+
+// renderDiffBar renders GitHub-style blocks showing the share of additions
+// and deletions in a file.
+func (m *Model) renderDiffBar(additions, deletions int) string {
+	total := additions + deletions
+	if total == 0 {
+		return lipgloss.NewStyle().Foreground(m.theme.Subtle).Render(strings.Repeat("▪", diffBarWidth))
+	}
+	adds := additions * diffBarWidth / total
+	if additions > 0 && adds == 0 {
+		adds = 1
+	}
+	dels := min(diffBarWidth-adds, (deletions*diffBarWidth+total-1)/total)
+	rest := diffBarWidth - adds - dels
+	return lipgloss.NewStyle().Foreground(m.theme.StatusSuccess).Render(strings.Repeat("▪", adds)) +
+		lipgloss.NewStyle().Foreground(m.theme.StatusFailure).Render(strings.Repeat("▪", dels)) +
+		lipgloss.NewStyle().Foreground(m.theme.Subtle).Render(strings.Repeat("▪", rest))
+}
+
+// renderPRFiles renders the changed-files overlay for a PR.
+func (m *Model) renderPRFiles() string {
+	maxWidth := max(min(100, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+	addStyle := lipgloss.NewStyle().Foreground(m.theme.StatusSuccess)
+	delStyle := lipgloss.NewStyle().Foreground(m.theme.StatusFailure)
+	conflictStyle := lipgloss.NewStyle().Foreground(m.theme.StatusFailure).Bold(true)
+
+	innerWidth := maxWidth - 6
+	info := m.prFilesInfo
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(truncateOrgLoadingText(fmt.Sprintf("%s/%s#%d %s", info.Owner, info.Repo, info.Number, info.Title), innerWidth)))
+	b.WriteString("\n")
+
+	if m.prFilesError != nil {
+		b.WriteString("\n")
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.prFilesError)))
+		b.WriteString("\n")
+	}
+
+	if m.prFilesLoading {
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString("\n")
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Loading changed files...", spinner)))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("esc: close"))
+	} else {
+		additions, deletions := 0, 0
+		for _, f := range m.prFiles {
+			additions += f.Additions
+			deletions += f.Deletions
+		}
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("%d files changed  ", len(m.prFiles))))
+		b.WriteString(addStyle.Render(fmt.Sprintf("+%d", additions)))
+		b.WriteString(" ")
+		b.WriteString(delStyle.Render(fmt.Sprintf("−%d", deletions)))
+		b.WriteString("\n")
+		if info.Conflicted {
+			b.WriteString(conflictStyle.Render(fmt.Sprintf("⚠ conflicts with %s: %d files also changed on base", info.BaseBranch, len(m.prFilesConflicts))))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+
+		statsWidth := 8 + 1 + 8 + 1 + diffBarWidth
+		nameWidth := max(innerWidth-2-2-statsWidth-1, 10)
+
+		footerLines := 3
+		visibleRows := max(maxHeight-4-footerLines-6, 3)
+		scrollOffset := 0
+		if m.prFilesScroll >= visibleRows {
+			scrollOffset = m.prFilesScroll - visibleRows + 1
+		}
+		endIdx := min(scrollOffset+visibleRows, len(m.prFiles))
+
+		for i := scrollOffset; i < endIdx; i++ {
+			f := m.prFiles[i]
+			name := f.Filename
+			if f.PreviousFilename != "" {
+				name = f.PreviousFilename + " → " + f.Filename
+			}
+			name = fmt.Sprintf("%-*s", nameWidth, truncateLeft(name, nameWidth))
+			label := fileStatusGlyph(f.Status) + " " + name + " "
+			switch {
+			case i == m.prFilesScroll:
+				b.WriteString(selectedStyle.Render("▸ " + label))
+			case m.prFilesConflicts[f.Filename]:
+				b.WriteString(conflictStyle.Render("! " + label))
+			default:
+				b.WriteString(normalStyle.Render("  " + label))
+			}
+			b.WriteString(addStyle.Render(fmt.Sprintf("%8s", fmt.Sprintf("+%d", f.Additions))))
+			b.WriteString(" ")
+			b.WriteString(delStyle.Render(fmt.Sprintf("%8s", fmt.Sprintf("−%d", f.Deletions))))
+			b.WriteString(" ")
+			b.WriteString(m.renderDiffBar(f.Additions, f.Deletions))
+			b.WriteString("\n")
+		}
+
+		if len(m.prFiles) > visibleRows {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(m.prFiles))))
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("↑↓: navigate  enter: open files tab  |: diff in pager  esc: close"))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,189 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// openPRTimeline shows a PR's activity timeline and starts loading it.
+func (m *Model) openPRTimeline(info github.PRInfo) tea.Cmd {
+	m.showPRTimeline = true
+	m.prTimelineInfo = info
+	m.prTimelineEvents = nil
+	m.prTimelineError = nil
+	m.prTimelineLoading = true
+	m.prTimelineIndex = 0
+	key := github.PRKey(info.Owner, info.Repo, info.Number)
+	return tea.Batch(bannerTick(), fetchPRTimeline(m.ctx, m.githubClient, key, info))
+}
+
+// fetchPRTimeline creates a command that loads a PR's timeline.
+func fetchPRTimeline(ctx context.Context, client *github.Client, key string, info github.PRInfo) tea.Cmd {
+	return func() tea.Msg {
+		events, err := client.PullRequests.GetPRTimeline(ctx, info.Owner, info.Repo, info.Number)
+		return PRTimelineMsg{Key: key, Events: events, Err: err}
+	}
+}
+
+// handlePRTimeline shows a loaded timeline, newest event selected.
+func (m *Model) handlePRTimeline(msg PRTimelineMsg) {
+	if !m.showPRTimeline || msg.Key != github.PRKey(m.prTimelineInfo.Owner, m.prTimelineInfo.Repo, m.prTimelineInfo.Number) {
+		return
+	}
+	m.prTimelineLoading = false
+	m.prTimelineEvents = msg.Events
+	m.prTimelineError = msg.Err
+	m.prTimelineIndex = max(len(msg.Events)-1, 0)
+}
+
+// prTimelineIcon returns the icon and color for a PR timeline event kind.
+func (m *Model) prTimelineIcon(e github.PRTimelineEvent) (string, lipgloss.Style) {
+	style := lipgloss.NewStyle()
+	switch e.Kind {
+	case "committed":
+		return icons.Commit, style.Foreground(m.theme.Accent)
+	case "head_ref_force_pushed":
+		return icons.ForcePush, style.Foreground(m.theme.StatusPending)
+	case "reviewed":
+		switch {
+		case strings.HasPrefix(e.Detail, "approved"):
+			return icons.Approved, style.Foreground(m.theme.StatusSuccess)
+		case strings.HasPrefix(e.Detail, "changes requested"):
+			return icons.ChangesRequested, style.Foreground(m.theme.StatusFailure)
+		}
+		return icons.Review, style.Foreground(m.theme.Accent)
+	case "commented":
+		return icons.Comment, style.Foreground(m.theme.NormalForeground)
+	case "deployed":
+		return icons.Deployed, style.Foreground(m.theme.StatusSuccess)
+	case "merged":
+		return icons.Merged, style.Foreground(m.theme.StatusSuccess)
+	case "closed":
+		return icons.Closed, style.Foreground(m.theme.StatusFailure)
+	default:
+		return icons.Event, style.Foreground(m.theme.Subtle)
+	}
+}
+
+// prTimelineVerb describes a PR timeline event kind.
+func prTimelineVerb(kind string) string {
+	switch kind {
+	case "head_ref_force_pushed":
+		return "force-pushed"
+	case "review_requested":
+		return "requested review from"
+	case "ready_for_review":
+		return "marked ready for review"
+	case "convert_to_draft":
+		return "converted to draft"
+	default:
+		return kind
+	}
+}
+
+// handlePRTimelineKey handles key events in the PR timeline overlay.
+func (m *Model) handlePRTimelineKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, prTimelineKeys.Close):
+		m.showPRTimeline = false
+	case key.Matches(msg, prTimelineKeys.Up):
+		m.prTimelineIndex = max(m.prTimelineIndex-1, 0)
+	case key.Matches(msg, prTimelineKeys.Down):
+		m.prTimelineIndex = min(m.prTimelineIndex+1, max(len(m.prTimelineEvents)-1, 0))
+	case key.Matches(msg, prTimelineKeys.Open):
+		url := m.prTimelineInfo.URL
+		if m.prTimelineIndex < len(m.prTimelineEvents) && m.prTimelineEvents[m.prTimelineIndex].URL != "" {
+			url = m.prTimelineEvents[m.prTimelineIndex].URL
+		}
+		if err := browser.Open(url); err != nil {
+			m.prTimelineError = err
+		}
+	}
+	return m, nil
+}
+
+// renderPRTimeline renders a PR's activity timeline, oldest first.
+func (m *Model) renderPRTimeline() string {
+	maxWidth := max(min(100, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+
+	innerWidth := maxWidth - 6
+	info := m.prTimelineInfo
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(truncateOrgLoadingText(fmt.Sprintf("%s/%s#%d %s", info.Owner, info.Repo, info.Number, info.Title), innerWidth)))
+	b.WriteString("\n\n")
+
+	if m.prTimelineError != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.prTimelineError)))
+		b.WriteString("\n\n")
+	}
+
+	switch {
+	case m.prTimelineLoading:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Loading timeline...", spinner)))
+		b.WriteString("\n")
+	case m.prTimelineError == nil && len(m.prTimelineEvents) == 0:
+		b.WriteString(subtleStyle.Render("  (no activity)"))
+		b.WriteString("\n")
+	}
+
+	visibleRows := max(maxHeight-10, 3)
+	scrollOffset := 0
+	if m.prTimelineIndex >= visibleRows {
+		scrollOffset = m.prTimelineIndex - visibleRows + 1
+	}
+	endIdx := min(scrollOffset+visibleRows, len(m.prTimelineEvents))
+	now := time.Now()
+	for i := scrollOffset; i < endIdx; i++ {
+		e := m.prTimelineEvents[i]
+		icon, iconStyle := m.prTimelineIcon(e)
+		when := fmt.Sprintf("%-8s", formatDuration(now.Sub(e.At)))
+		text := prTimelineVerb(e.Kind)
+		if e.Actor != "" {
+			text = e.Actor + " " + text
+		}
+		if e.Detail != "" {
+			text += " " + e.Detail
+		}
+		text = truncateOrgLoadingText(text, max(innerWidth-10, 10))
+		if i == m.prTimelineIndex {
+			b.WriteString(selectedStyle.Render("▸ ") + iconStyle.Render(icon) + " " + subtleStyle.Render(when) + " " + selectedStyle.Render(text))
+		} else {
+			b.WriteString("  " + iconStyle.Render(icon) + " " + subtleStyle.Render(when) + " " + normalStyle.Render(text))
+		}
+		b.WriteString("\n")
+	}
+	if len(m.prTimelineEvents) > visibleRows {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(m.prTimelineEvents))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑↓: navigate  enter: open event  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/config"
+)
+
+// hideReadMsg fires when read notifications may have outlived their grace
+// period.
+type hideReadMsg struct{}
+
+// noteRead records when notifications were first seen read, so they can be
+// shown faded and hidden after the configured grace period. Returns a
+// command that fires when the newly read ones are due, or nil.
+func (m *Model) noteRead() tea.Cmd {
+	hideAfter := m.readSettings.HideAfter()
+	if hideAfter <= 0 {
+		return nil
+	}
+	now := time.Now()
+	added := false
+	for id, n := range m.allNotifications {
+		if _, ok := m.readAt[id]; !ok && !n.Unread {
+			m.readAt[id] = now
+			added = true
+		}
+	}
+	if !added {
+		return nil
+	}
+	return tea.Tick(hideAfter, func(time.Time) tea.Msg { return hideReadMsg{} })
+}
+
+// hideExpiredRead moves read notifications whose grace period has passed to
+// the archive. Ones that became unread again stay.
+func (m *Model) hideExpiredRead() {
+	cutoff := time.Now().Add(-m.readSettings.HideAfter())
+	for id, at := range m.readAt {
+		n, ok := m.allNotifications[id]
+		switch {
+		case !ok || n.Unread:
+			delete(m.readAt, id)
+		case !at.After(cutoff):
+			m.archiveNotification(id, false)
+			delete(m.readAt, id)
+		}
+	}
+	m.updateNotifications(nil)
+}
+
+// notificationRead handles a notification marked read in hubell: it's
+// archived at once, or kept faded for the grace period when one is set.
+func (m *Model) notificationRead(threadID string) tea.Cmd {
+	n, ok := m.allNotifications[threadID]
+	if !ok || m.readSettings.HideAfter() <= 0 {
+		m.archiveNotification(threadID, false)
+		m.updateNotifications(nil)
+		return nil
+	}
+	n.Unread = false
+	cmd := m.noteRead()
+	m.updateNotifications(nil)
+	return cmd
+}
+
+// toggleUnreadOnly shows or hides read notifications and saves the choice.
+func (m *Model) toggleUnreadOnly() {
+	m.readSettings.UnreadOnly = !m.readSettings.UnreadOnly
+	m.updateNotifications(nil)
+	if err := config.SaveReadSettings(m.readSettings); err != nil {
+		m.err = err
+	}
+}
@@ -0,0 +1,152 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/auth"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// reauthMsg reports whether a replacement token was accepted.
+type reauthMsg struct {
+	account int
+	token   string
+	user    *github.User
+	err     error
+}
+
+// newReauthInput creates the masked input for a replacement token.
+func newReauthInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "ghp_… or github_pat_…"
+	ti.EchoMode = textinput.EchoPassword
+	ti.SetWidth(50)
+	return ti
+}
+
+// openReauth shows the re-authentication prompt after GitHub rejected the
+// active account's token. Once dismissed it stays closed for the session.
+func (m *Model) openReauth() tea.Cmd {
+	if m.showReauth || m.reauthDismissed {
+		return nil
+	}
+	m.showReauth = true
+	m.reauthErr = nil
+	m.reauthInput.SetValue("")
+	return m.reauthInput.Focus()
+}
+
+// verifyToken switches the client to a new token and checks GitHub accepts
+// it.
+func verifyToken(ctx context.Context, client *github.Client, account int, token string) tea.Cmd {
+	return func() tea.Msg {
+		client.SetToken(token)
+		user, err := client.GetAuthenticatedUser(ctx)
+		return reauthMsg{account: account, token: token, user: user, err: err}
+	}
+}
+
+// handleReauth resumes polling once a replacement token is accepted, saving
+// it when it belongs to the primary account.
+func (m *Model) handleReauth(msg reauthMsg) {
+	if msg.account != m.account {
+		return
+	}
+	m.reauthChecking = false
+	if msg.err != nil {
+		m.reauthErr = msg.err
+		return
+	}
+	m.showReauth = false
+	m.reauthInput.Blur()
+	m.err = nil
+	if len(m.accounts) > 0 {
+		m.accounts[m.account].MissingScopes = github.MissingScopes(msg.user.Scopes, m.orgName != "")
+	}
+	m.notice = fmt.Sprintf("token accepted for @%s, resuming", msg.user.Login)
+	if msg.account == 0 && m.saveToken != nil {
+		if err := m.saveToken(msg.token); err != nil {
+			m.err = fmt.Errorf("save token: %w", err)
+		}
+	}
+	if m.poller != nil {
+		m.poller.PollNow()
+	}
+}
+
+// handleReauthKey handles key events in the re-authentication prompt.
+func (m *Model) handleReauthKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, inputKeys.Cancel):
+		m.showReauth = false
+		m.reauthDismissed = true
+		m.reauthInput.Blur()
+		return m, nil
+	case key.Matches(msg, inputKeys.Confirm):
+		if m.reauthChecking {
+			return m, nil
+		}
+		token, err := auth.CleanToken(m.reauthInput.Value())
+		if err != nil {
+			m.reauthErr = err
+			return m, nil
+		}
+		m.reauthErr = nil
+		m.reauthChecking = true
+		return m, verifyToken(m.ctx, m.githubClient, m.account, token)
+	}
+	var cmd tea.Cmd
+	m.reauthInput, cmd = m.reauthInput.Update(msg)
+	return m, cmd
+}
+
+// renderReauth renders the re-authentication prompt.
+func (m *Model) renderReauth() string {
+	maxWidth := max(min(90, m.width-2), 50)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("GitHub token expired or revoked"))
+	b.WriteString("\n\n")
+	account := ""
+	if label := m.accountLabel(); label != "" {
+		account = " for " + label
+	}
+	b.WriteString(normalStyle.Render("GitHub rejected the token" + account + ". To create a new one:"))
+	b.WriteString("\n")
+	for i, step := range auth.TokenSteps {
+		b.WriteString(normalStyle.Render(fmt.Sprintf("  %d. %s", i+1, step)))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(m.reauthInput.View())
+	b.WriteString("\n")
+	if m.reauthChecking {
+		b.WriteString(subtleStyle.Render("Checking token…"))
+		b.WriteString("\n")
+	} else if m.reauthErr != nil {
+		b.WriteString(m.errorStyle().Render(fmt.Sprintf("⚠ %s", m.reauthErr)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("enter: use token and resume polling  esc: not now"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
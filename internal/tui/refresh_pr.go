@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// refreshTarget returns the PR of the focused pane's selection and, for a
+// notification, its ID and latest comment URL. ok is false when the
+// selection isn't a PR.
+func (m *Model) refreshTarget() (owner, repo string, number int, notifID, commentURL string, ok bool) {
+	switch m.focusedPane {
+	case LeftPane:
+		item, isNotif := m.list.SelectedItem().(NotificationItem)
+		if !isNotif || item.notification.Subject.Type != "PullRequest" {
+			return "", "", 0, "", "", false
+		}
+		n := item.notification
+		owner, repo, number, ok = github.ParseSubjectURL(n.Subject.URL)
+		return owner, repo, number, n.ID, n.Subject.LatestCommentURL, ok
+	case RightPane:
+		if item, isPR := m.prList.SelectedItem().(PRItem); isPR {
+			return item.info.Owner, item.info.Repo, item.info.Number, "", "", true
+		}
+	case TimelinePane:
+		if item, isEvent := m.timelineList.SelectedItem().(TimelineEvent); isEvent && item.Number > 0 {
+			return item.Owner, item.Repo, item.Number, "", "", true
+		}
+	}
+	return "", "", 0, "", "", false
+}
+
+// refreshSelectedPR re-fetches the selected PR's status, reviews and latest
+// comment out-of-band from the poll cycle.
+func (m *Model) refreshSelectedPR() tea.Cmd {
+	owner, repo, number, notifID, commentURL, ok := m.refreshTarget()
+	if !ok || m.poller == nil {
+		return nil
+	}
+	key := github.PRKey(owner, repo, number)
+	if m.prRefreshes[key] {
+		return nil
+	}
+	m.prRefreshes[key] = true
+	m.updateNotifications(nil)
+	m.updatePRList()
+	return tea.Batch(bannerTick(), fetchPRRefresh(m.ctx, m.poller, m.githubClient, key, owner, repo, number, notifID, commentURL))
+}
+
+// fetchPRRefresh creates a command that fetches one PR and, when set, a
+// notification's latest comment.
+func fetchPRRefresh(ctx context.Context, poller *github.Poller, client *github.Client, key, owner, repo string, number int, notifID, commentURL string) tea.Cmd {
+	return func() tea.Msg {
+		msg := PRRefreshMsg{Key: key, NotificationID: notifID}
+		msg.Status, msg.Info, msg.Err = poller.RefreshPR(ctx, owner, repo, number)
+		if msg.Err == nil && commentURL != "" {
+			// The PR itself refreshed; a missing comment keeps the old one
+			msg.Comment, _ = client.Notifications.FetchCommentDetail(ctx, commentURL)
+		}
+		return msg
+	}
+}
+
+// handlePRRefresh applies a refreshed PR. Only PRs already in the PR pane
+// get their details replaced, so refreshing someone else's PR from a
+// notification doesn't list it (watch it for that).
+func (m *Model) handlePRRefresh(msg PRRefreshMsg) {
+	delete(m.prRefreshes, msg.Key)
+	if msg.Err != nil {
+		m.err = fmt.Errorf("refresh %s: %w", msg.Key, msg.Err)
+	} else {
+		m.prStatuses[msg.Key] = msg.Status
+		if old, listed := m.prInfos[msg.Key]; listed {
+			msg.Info.Watched = old.Watched
+			m.prInfos[msg.Key] = msg.Info
+		}
+		if msg.Comment != nil && msg.NotificationID != "" {
+			m.commentDetails[msg.NotificationID] = msg.Comment
+		}
+		m.notice = "refreshed " + msg.Key
+		m.noticeLink = ""
+	}
+	m.updateNotifications(nil)
+	m.updatePRList()
+}
+
+// prRefreshLabel returns a spinner while the PR is being refreshed, or "".
+func (m *Model) prRefreshLabel(key string) string {
+	if !m.prRefreshes[key] {
+		return ""
+	}
+	return spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+}
+
+// notificationRefreshLabel returns a spinner while the notification's PR is
+// being refreshed, or "".
+func (m *Model) notificationRefreshLabel(n *github.Notification) string {
+	if len(m.prRefreshes) == 0 || n.Subject.Type != "PullRequest" {
+		return ""
+	}
+	owner, repo, number, ok := github.ParseSubjectURL(n.Subject.URL)
+	if !ok {
+		return ""
+	}
+	return m.prRefreshLabel(github.PRKey(owner, repo, number))
+}
@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// reportWeeks is how many weeks of merge counts the report charts.
+const reportWeeks = 8
+
+// weeklyReport renders the dashboard as a Markdown report for standups and
+// performance notes: the weekly merged chart, review latency, CI pass rate
+// and this week's merged PRs.
+func (m *Model) weeklyReport(now time.Time) string {
+	d := &m.dashboardStats
+	weekday := (int(now.Weekday()) + 6) % 7
+	monday := time.Date(now.Year(), now.Month(), now.Day()-weekday, 0, 0, 0, 0, now.Location())
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Weekly report: %s (%s – %s)\n\n", config.WeekKey(now),
+		monday.Format("Jan 2"), monday.AddDate(0, 0, 6).Format("Jan 2"))
+
+	b.WriteString("## PRs merged per week\n\n")
+	chart := d.buildWeeklyChartData(reportWeeks)
+	busiest := 1
+	for _, w := range chart {
+		busiest = max(busiest, w.Value)
+	}
+	b.WriteString("```\n")
+	for _, w := range chart {
+		fmt.Fprintf(&b, "%-4s %-20s %d\n", w.Label, strings.Repeat("█", w.Value*20/busiest), w.Value)
+	}
+	b.WriteString("```\n\n")
+
+	b.WriteString("## Reviews and CI\n\n")
+	if avg := d.averageReviewLatency(); avg > 0 {
+		fmt.Fprintf(&b, "- Avg time to first review on my PRs: %s\n", formatMergeDuration(avg))
+	} else {
+		b.WriteString("- Avg time to first review on my PRs: N/A\n")
+	}
+	if t := d.ReviewTurnaround; t != nil && len(t.Turnarounds) > 0 {
+		fmt.Fprintf(&b, "- My review turnaround: %s median over %d reviews", formatMergeDuration(t.Median()), len(t.Turnarounds))
+		if len(t.Pending) > 0 {
+			fmt.Fprintf(&b, ", %d waiting on me", len(t.Pending))
+		}
+		b.WriteString("\n")
+	}
+	if d.ChecksTotal > 0 {
+		fmt.Fprintf(&b, "- CI pass rate: %d%% (%d/%d checks)\n", int(d.ciPassRate()*100), d.ChecksSuccess, d.ChecksTotal)
+	} else {
+		b.WriteString("- CI pass rate: N/A\n")
+	}
+	b.WriteString("\n")
+
+	merged := slices.Clone(d.MergedPRs)
+	slices.SortFunc(merged, func(a, b github.MergedPRInfo) int {
+		return a.MergedAt.Compare(b.MergedAt)
+	})
+	fmt.Fprintf(&b, "## Merged this week (%d)\n\n", len(merged))
+	if len(merged) == 0 {
+		b.WriteString("_Nothing merged yet._\n")
+	}
+	for _, pr := range merged {
+		fmt.Fprintf(&b, "- [%s/%s#%d](%s) %s (%s)\n", pr.Owner, pr.Repo, pr.Number, pr.URL,
+			pr.Title, pr.MergedAt.Local().Format("Mon Jan 2"))
+	}
+	return b.String()
+}
+
+// exportReport writes the weekly report to a Markdown file in the temp
+// directory and copies it to the clipboard.
+func (m *Model) exportReport() tea.Cmd {
+	now := time.Now()
+	report := m.weeklyReport(now)
+	path := filepath.Join(os.TempDir(), fmt.Sprintf("hubell-report-%s.md", config.WeekKey(now)))
+	if err := os.WriteFile(path, []byte(report), 0600); err != nil {
+		m.err = fmt.Errorf("report: %w", err)
+		return nil
+	}
+	m.notice = "report saved to " + path + " and copied"
+	m.noticeLink = ""
+	return tea.Batch(tea.SetClipboard(report), copyToClipboard(report))
+}
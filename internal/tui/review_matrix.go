@@ -0,0 +1,236 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// reviewConcentration is the share of one person's reviews that must come
+// from (or go to) a single counterpart before it is flagged.
+const reviewConcentration = 0.75
+
+// beginReviewMatrixLoad starts building the review matrix for the members
+// currently shown in the org dashboard.
+func (m *Model) beginReviewMatrixLoad() tea.Cmd {
+	logins := make([]string, len(m.orgMembers))
+	for i, member := range m.orgMembers {
+		logins[i] = member.Login
+	}
+	m.reviewMatrixLoading = true
+	m.reviewMatrixError = nil
+	return tea.Batch(bannerTick(), fetchReviewMatrix(m.ctx, m.githubClient, m.orgName, logins))
+}
+
+// fetchReviewMatrix creates a command that builds the who-reviews-whom matrix.
+func fetchReviewMatrix(ctx context.Context, client *github.Client, org string, logins []string) tea.Cmd {
+	return func() tea.Msg {
+		matrix, err := client.Orgs.FetchReviewMatrix(ctx, org, logins)
+		if err != nil {
+			return ReviewMatrixErrorMsg{Err: err}
+		}
+		return ReviewMatrixMsg{Matrix: matrix}
+	}
+}
+
+// handleReviewMatrixKey handles keyboard events in the review matrix overlay.
+func (m *Model) handleReviewMatrixKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, reviewMatrixKeys.Close):
+		m.showReviewMatrix = false
+		return m, nil
+
+	case key.Matches(msg, reviewMatrixKeys.Up):
+		if m.reviewMatrixScroll > 0 {
+			m.reviewMatrixScroll--
+		}
+		return m, nil
+
+	case key.Matches(msg, reviewMatrixKeys.Down):
+		if m.reviewMatrix != nil && m.reviewMatrixScroll < len(m.reviewMatrix.Reviewers)-1 {
+			m.reviewMatrixScroll++
+		}
+		return m, nil
+
+	case key.Matches(msg, reviewMatrixKeys.Refresh):
+		if !m.reviewMatrixLoading {
+			return m, m.beginReviewMatrixLoad()
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// reviewHotspots returns human-readable findings about concentrated review
+// relationships: authors who depend on a single reviewer, and reviewers who
+// only review one person.
+func reviewHotspots(rm *github.ReviewMatrix) (singlePoints, silos []string) {
+	for _, author := range rm.Authors {
+		received := rm.ReviewsReceived(author)
+		if received < 3 {
+			continue
+		}
+		for _, reviewer := range rm.Reviewers {
+			n := rm.Counts[reviewer][author]
+			if float64(n) >= float64(received)*reviewConcentration {
+				singlePoints = append(singlePoints, fmt.Sprintf("@%s gets %d of %d reviews from @%s", author, n, received, reviewer))
+				break
+			}
+		}
+	}
+	for _, reviewer := range rm.Reviewers {
+		given := rm.ReviewsGiven(reviewer)
+		if given < 3 {
+			continue
+		}
+		for author, n := range rm.Counts[reviewer] {
+			if float64(n) >= float64(given)*reviewConcentration {
+				silos = append(silos, fmt.Sprintf("@%s gives %d of %d reviews to @%s", reviewer, n, given, author))
+				break
+			}
+		}
+	}
+	return singlePoints, silos
+}
+
+// renderReviewMatrix renders the who-reviews-whom heatmap overlay. Rows are
+// reviewers, columns are PR authors.
+func (m *Model) renderReviewMatrix() string {
+	maxWidth := max(m.width-2, 40)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+	warnStyle := lipgloss.NewStyle().Foreground(m.theme.StatusPending)
+
+	// Heat levels from cold to hot
+	heatStyles := []lipgloss.Style{
+		lipgloss.NewStyle().Foreground(m.theme.NormalDesc),
+		lipgloss.NewStyle().Foreground(m.theme.NormalForeground),
+		lipgloss.NewStyle().Foreground(m.theme.StatusPending).Bold(true),
+		lipgloss.NewStyle().Foreground(m.theme.StatusFailure).Bold(true),
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Review Reciprocity - %s (Last 7 Days)", m.orgScopeLabel())))
+	b.WriteString("\n\n")
+
+	if m.reviewMatrixError != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.reviewMatrixError)))
+		b.WriteString("\n\n")
+	}
+
+	rm := m.reviewMatrix
+	switch {
+	case m.reviewMatrixLoading:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Searching reviews for %d members...", spinner, len(m.orgMembers))))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("esc: close"))
+	case rm == nil || len(rm.Reviewers) == 0:
+		b.WriteString(subtleStyle.Render("No reviews between members in this window."))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("r: refresh  esc: close"))
+	default:
+		innerWidth := maxWidth - 6
+		nameWidth := 16
+		cellWidth := 5
+		totalWidth := 6
+		cols := min(max((innerWidth-nameWidth-totalWidth)/cellWidth, 1), len(rm.Authors))
+		authors := rm.Authors[:cols]
+
+		maxCount := 0
+		for _, row := range rm.Counts {
+			for _, n := range row {
+				maxCount = max(maxCount, n)
+			}
+		}
+
+		// Column headers: authors, shortened to fit the cell
+		var header strings.Builder
+		header.WriteString(fmt.Sprintf("%-*s", nameWidth, "reviewer ↓ author →"))
+		for _, author := range authors {
+			header.WriteString(fmt.Sprintf("%*s", cellWidth, truncateOrgLoadingText(author, cellWidth-1)))
+		}
+		header.WriteString(fmt.Sprintf("%*s", totalWidth, "Total"))
+		b.WriteString(accentStyle.Render(truncateOrgLoadingText(header.String(), innerWidth)))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render(strings.Repeat("─", min(nameWidth+cols*cellWidth+totalWidth, innerWidth))))
+		b.WriteString("\n")
+
+		singlePoints, silos := reviewHotspots(rm)
+		findingLines := 0
+		if len(singlePoints) > 0 {
+			findingLines += 1 + min(len(singlePoints), 3)
+		}
+		if len(silos) > 0 {
+			findingLines += 1 + min(len(silos), 3)
+		}
+		visibleRows := max(maxHeight-findingLines-12, 3)
+
+		start := min(m.reviewMatrixScroll, max(len(rm.Reviewers)-visibleRows, 0))
+		end := min(start+visibleRows, len(rm.Reviewers))
+		for _, reviewer := range rm.Reviewers[start:end] {
+			b.WriteString(normalStyle.Render(fmt.Sprintf("%-*s", nameWidth, truncateOrgLoadingText(reviewer, nameWidth-1))))
+			for _, author := range authors {
+				n := rm.Counts[reviewer][author]
+				if n == 0 || reviewer == author {
+					b.WriteString(subtleStyle.Render(fmt.Sprintf("%*s", cellWidth, "·")))
+					continue
+				}
+				level := min((n*len(heatStyles)-1)/maxCount, len(heatStyles)-1)
+				b.WriteString(heatStyles[level].Render(fmt.Sprintf("%*d", cellWidth, n)))
+			}
+			b.WriteString(accentStyle.Render(fmt.Sprintf("%*d", totalWidth, rm.ReviewsGiven(reviewer))))
+			b.WriteString("\n")
+		}
+		if len(rm.Reviewers) > visibleRows {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d reviewers)", start+1, end, len(rm.Reviewers))))
+			b.WriteString("\n")
+		}
+		if cols < len(rm.Authors) {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" showing %d of %d authors", cols, len(rm.Authors))))
+			b.WriteString("\n")
+		}
+
+		if len(singlePoints) > 0 {
+			b.WriteString("\n")
+			b.WriteString(warnStyle.Render("Single points of failure"))
+			b.WriteString("\n")
+			for _, s := range singlePoints[:min(len(singlePoints), 3)] {
+				b.WriteString(normalStyle.Render("  " + s))
+				b.WriteString("\n")
+			}
+		}
+		if len(silos) > 0 {
+			b.WriteString("\n")
+			b.WriteString(warnStyle.Render("Review silos"))
+			b.WriteString("\n")
+			for _, s := range silos[:min(len(silos), 3)] {
+				b.WriteString(normalStyle.Render("  " + s))
+				b.WriteString("\n")
+			}
+		}
+
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("↑↓: scroll  r: refresh  esc: back"))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
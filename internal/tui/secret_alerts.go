@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/list"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// SecretAlertItem implements list.Item for an open secret scanning alert. They
+// are pinned above the notifications since they should never be missed.
+type SecretAlertItem struct {
+	alert github.SecretScanningAlert
+}
+
+// FilterValue implements list.Item
+func (i SecretAlertItem) FilterValue() string {
+	return i.alert.SecretType
+}
+
+// Title implements list.DefaultItem
+func (i SecretAlertItem) Title() string {
+	what := "Secret leaked"
+	if i.alert.PushProtectionBypassed {
+		what = "Push protection bypassed"
+	}
+	return fmt.Sprintf("! [%s/%s] %s: %s", i.alert.Owner, i.alert.Repo, what, i.alert.SecretType)
+}
+
+// Description implements list.DefaultItem
+func (i SecretAlertItem) Description() string {
+	desc := fmt.Sprintf("secret scanning alert #%d", i.alert.Number)
+	if i.alert.PushProtectionBypassed && i.alert.BypassedBy != "" {
+		desc += " · bypassed by @" + i.alert.BypassedBy
+	}
+	if i.alert.Validity == "active" {
+		desc += " · still active"
+	}
+	return fmt.Sprintf("%s · %s", desc, formatDuration(time.Since(i.alert.UpdatedAt())))
+}
+
+// secretAlertItems converts the open secret scanning alerts into list items.
+func (m *Model) secretAlertItems() []list.Item {
+	items := make([]list.Item, len(m.secretAlerts))
+	for i, a := range m.secretAlerts {
+		items[i] = SecretAlertItem{alert: a}
+	}
+	return items
+}
@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// openSecurity shows the code scanning alerts a PR introduces, most severe
+// first.
+func (m *Model) openSecurity(info github.PRInfo) {
+	alerts := make([]github.CodeScanningAlert, len(info.CodeScanningAlerts))
+	copy(alerts, info.CodeScanningAlerts)
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return alerts[i].SeverityRank() < alerts[j].SeverityRank()
+	})
+	m.showSecurity = true
+	m.securityInfo = info
+	m.securityAlerts = alerts
+	m.securityIndex = 0
+	m.securityError = nil
+}
+
+// severityColor maps an alert's severity onto the status colors.
+func severityColor(theme Theme, a github.CodeScanningAlert) color.Color {
+	switch a.SeverityRank() {
+	case 0, 1:
+		return theme.StatusFailure
+	case 2:
+		return theme.StatusPending
+	default:
+		return theme.Subtle
+	}
+}
+
+// handleSecurityKey handles key events in the code scanning alerts overlay.
+func (m *Model) handleSecurityKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, securityKeys.Close):
+		m.showSecurity = false
+		return m, nil
+
+	case key.Matches(msg, securityKeys.Up):
+		if m.securityIndex > 0 {
+			m.securityIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, securityKeys.Down):
+		if m.securityIndex < len(m.securityAlerts)-1 {
+			m.securityIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, securityKeys.Open):
+		if m.securityIndex < len(m.securityAlerts) {
+			if err := browser.Open(m.securityAlerts[m.securityIndex].HTMLURL); err != nil {
+				m.securityError = err
+			}
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// renderSecurity renders the code scanning alerts overlay for a PR.
+func (m *Model) renderSecurity() string {
+	maxWidth := max(min(90, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+
+	innerWidth := maxWidth - 6
+	info := m.securityInfo
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(truncateOrgLoadingText(fmt.Sprintf("%s/%s#%d %s", info.Owner, info.Repo, info.Number, info.Title), innerWidth)))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("%d code scanning alerts introduced by this PR", len(m.securityAlerts))))
+	b.WriteString("\n\n")
+
+	if m.securityError != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.securityError)))
+		b.WriteString("\n\n")
+	}
+
+	if len(m.securityAlerts) == 0 {
+		b.WriteString(subtleStyle.Render("  (none)"))
+		b.WriteString("\n")
+	}
+
+	// Each alert takes two lines: rule and message, then location
+	visibleRows := max((maxHeight-10)/2, 3)
+	scrollOffset := 0
+	if m.securityIndex >= visibleRows {
+		scrollOffset = m.securityIndex - visibleRows + 1
+	}
+	endIdx := min(scrollOffset+visibleRows, len(m.securityAlerts))
+	for i := scrollOffset; i < endIdx; i++ {
+		a := m.securityAlerts[i]
+		severity := lipgloss.NewStyle().Foreground(severityColor(m.theme, a)).Bold(true).Render(fmt.Sprintf("%-8s", a.Severity))
+		text := truncateOrgLoadingText(a.Message, max(innerWidth-12, 10))
+		if i == m.securityIndex {
+			b.WriteString(selectedStyle.Render("▸ ") + severity + selectedStyle.Render(" "+text))
+		} else {
+			b.WriteString("  " + severity + normalStyle.Render(" "+text))
+		}
+		b.WriteString("\n")
+		location := a.Path
+		if a.Line > 0 {
+			location = fmt.Sprintf("%s:%d", a.Path, a.Line)
+		}
+		b.WriteString(subtleStyle.Render(truncateOrgLoadingText(fmt.Sprintf("           %s · %s · %s", location, a.RuleID, a.Tool), innerWidth)))
+		b.WriteString("\n")
+	}
+	if len(m.securityAlerts) > visibleRows {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(m.securityAlerts))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("↑↓: navigate  enter: open alert  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// snapshot is the in-memory poll state written for bug reports.
+type snapshot struct {
+	TakenAt        time.Time                        `json:"taken_at"`
+	Notifications  []*github.Notification           `json:"notifications"`
+	CommentDetails map[string]*github.CommentDetail `json:"comment_details"`
+	Mentions       map[string]*github.Mention       `json:"mentions"`
+	SubjectDetails map[string]*github.SubjectDetail `json:"subject_details"`
+	PRStatuses     map[string]github.PRStatus       `json:"pr_statuses"`
+	PRInfos        map[string]github.PRInfo         `json:"pr_infos"`
+	AssignedIssues []github.SearchItem              `json:"assigned_issues"`
+	SecretAlerts   []github.SecretScanningAlert     `json:"secret_alerts"`
+	FailingMains   []github.BranchHealth            `json:"failing_mains"`
+	OrgMembers     []github.OrgMemberActivity       `json:"org_members"`
+	OrgSummary     github.OrgActivitySummary        `json:"org_summary"`
+}
+
+// redactedFields are the free-text fields (matched case-insensitively by
+// JSON key) replaced in snapshots: they aren't needed to reproduce a
+// rendering bug and may be private.
+var redactedFields = []string{"title", "body", "message", "breakingmessage", "email", "excerpt", "notes"}
+
+// redact replaces the values of redactedFields throughout a decoded JSON
+// value.
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if s, ok := field.(string); ok && s != "" && slices.Contains(redactedFields, strings.ToLower(k)) {
+				v[k] = "[redacted]"
+				continue
+			}
+			v[k] = redact(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+	}
+	return v
+}
+
+// saveSnapshot writes the current poll and org state, redacted, to a JSON
+// file in the temp directory and returns its path.
+func (m *Model) saveSnapshot() (string, error) {
+	s := snapshot{
+		TakenAt:        time.Now(),
+		CommentDetails: m.commentDetails,
+		Mentions:       m.mentions,
+		SubjectDetails: m.subjectDetails,
+		PRStatuses:     m.prStatuses,
+		PRInfos:        m.prInfos,
+		AssignedIssues: m.assignedIssues,
+		SecretAlerts:   m.secretAlerts,
+		FailingMains:   m.failingMains,
+		OrgMembers:     m.orgMembers,
+		OrgSummary:     m.orgLastLoadSummary,
+	}
+	for _, n := range m.allNotifications {
+		s.Notifications = append(s.Notifications, n)
+	}
+	slices.SortFunc(s.Notifications, func(a, b *github.Notification) int {
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	})
+
+	// Round-trip through a generic value so every nested field is redacted
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return "", err
+	}
+	data, err = json.MarshalIndent(redact(generic), "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(os.TempDir(), fmt.Sprintf("hubell-snapshot-%s.json", s.TakenAt.Format("20060102-150405")))
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"sort"
+
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// ciStatusRank orders CI statuses by how much attention they need.
+func ciStatusRank(s github.PRStatus) int {
+	switch s {
+	case github.PRStatusFailure:
+		return 0
+	case github.PRStatusPending:
+		return 1
+	case github.PRStatusSuccess:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// reviewStateRank orders review states by how much attention they need.
+func reviewStateRank(s github.PRReviewState) int {
+	switch s {
+	case github.PRReviewChangesRequested:
+		return 0
+	case github.PRReviewApproved:
+		return 1
+	case github.PRReviewReviewed:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// sortNotifications orders notifications by the configured sort, newest
+// first within ties.
+func (m *Model) sortNotifications(ns []*github.Notification) {
+	sort.Slice(ns, func(i, j int) bool {
+		return ns[i].UpdatedAt.After(ns[j].UpdatedAt)
+	})
+	switch m.sortSettings.Notifications {
+	case config.SortRepo:
+		sort.SliceStable(ns, func(i, j int) bool {
+			return ns[i].Repository.FullName < ns[j].Repository.FullName
+		})
+	case config.SortReason:
+		sort.SliceStable(ns, func(i, j int) bool {
+			return formatReason(ns[i].Reason) < formatReason(ns[j].Reason)
+		})
+	case config.SortCI:
+		sort.SliceStable(ns, func(i, j int) bool {
+			return ciStatusRank(m.prStatusForNotification(ns[i])) < ciStatusRank(m.prStatusForNotification(ns[j]))
+		})
+	}
+}
+
+// sortPRItems orders PR items by the configured sort, newest first within
+// ties.
+func (m *Model) sortPRItems(items []PRItem) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].info.CreatedAt.After(items[j].info.CreatedAt)
+	})
+	switch m.sortSettings.PRs {
+	case config.SortUpdated:
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].info.UpdatedAt.After(items[j].info.UpdatedAt)
+		})
+	case config.SortCI:
+		sort.SliceStable(items, func(i, j int) bool {
+			return ciStatusRank(items[i].status) < ciStatusRank(items[j].status)
+		})
+	case config.SortReview:
+		sort.SliceStable(items, func(i, j int) bool {
+			return reviewStateRank(items[i].info.ReviewState) < reviewStateRank(items[j].info.ReviewState)
+		})
+	}
+}
+
+// cycleSort moves the focused pane to its next sort order and persists it.
+func (m *Model) cycleSort() {
+	switch m.focusedPane {
+	case LeftPane:
+		m.sortSettings.Notifications = config.NextSort(config.NotificationSorts, m.sortSettings.Notifications)
+		m.updateNotifications(nil)
+	case RightPane:
+		m.sortSettings.PRs = config.NextSort(config.PRSorts, m.sortSettings.PRs)
+		m.updatePRList()
+	default:
+		return
+	}
+	if err := config.SaveSortSettings(m.sortSettings); err != nil {
+		m.err = err
+	}
+}
+
+// sortedTitle appends a non-default sort order to a pane title.
+func sortedTitle(title, order, defaultOrder string) string {
+	if order == defaultOrder {
+		return title
+	}
+	return title + " · by " + order
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// staleErrWidth caps the error shown in a pane title.
+const staleErrWidth = 40
+
+// recordSources notes which poll sources failed this poll and when the
+// others last succeeded.
+func (m *Model) recordSources(errs map[github.PollSource]error) {
+	m.sourceErrs = errs
+	now := time.Now()
+	for _, s := range github.PollSources {
+		if errs[s] == nil {
+			m.fetchedAt[s] = now
+		}
+	}
+}
+
+// staleBadge returns a pane title suffix for a source that failed on the
+// latest poll: how old the data shown is and why it wasn't refreshed. It is
+// "" while the source is healthy.
+func (m *Model) staleBadge(source github.PollSource) string {
+	err := m.sourceErrs[source]
+	if err == nil {
+		return ""
+	}
+	reason := ansi.Truncate(err.Error(), staleErrWidth, "…")
+	fetched, ok := m.fetchedAt[source]
+	if !ok {
+		return " · ⚠ unavailable: " + reason
+	}
+	return " · ⚠ stale, updated " + formatDuration(time.Since(fetched)) + ": " + reason
+}
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// statusTickInterval is how often the status bar's countdown is redrawn;
+// statusTickLowPower replaces it in the low-power profile.
+const (
+	statusTickInterval = time.Second
+	statusTickLowPower = 15 * time.Second
+)
+
+// statusTick schedules the next status bar redraw.
+func statusTick(lowPower bool) tea.Cmd {
+	d := statusTickInterval
+	if lowPower {
+		d = statusTickLowPower
+	}
+	return tea.Tick(d, func(time.Time) tea.Msg { return StatusTickMsg{} })
+}
+
+// recordPollTiming keeps when the next poll is due and the rate limit left,
+// for the status bar.
+func (m *Model) recordPollTiming(polledAt time.Time, interval time.Duration, rl github.RateLimit) {
+	if !polledAt.IsZero() && interval > 0 {
+		m.nextPollAt = polledAt.Add(interval)
+	}
+	if rl.Limit > 0 {
+		m.rateLimit = rl
+	}
+}
+
+// refreshNow polls right away, restarting the poll interval, and shows
+// refreshing… in the status bar until the poll lands.
+func (m *Model) refreshNow() tea.Cmd {
+	if m.poller == nil || m.refreshing {
+		return nil
+	}
+	m.refreshing = true
+	m.poller.PollNow()
+	return bannerTick()
+}
+
+// renderStatusBar renders the bottom line of the main view: the status
+// badges, unread count, filter, account and org, the last successful poll
+// with a countdown to the next, and the API rate limit left.
+func (m *Model) renderStatusBar() string {
+	labelStyle := lipgloss.NewStyle().Foreground(m.theme.HelpText)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent)
+	sep := labelStyle.Render(" | ")
+
+	unread := 0
+	for _, n := range m.notifications {
+		if n.Unread {
+			unread++
+		}
+	}
+	segments := []string{
+		accentStyle.Render(fmt.Sprintf("%s %d unread", icons.Unread, unread)),
+		labelStyle.Render(m.filterMode.String()),
+	}
+
+	// With several accounts the status badges already name the current one
+	var who []string
+	if m.accountLabel() == "" && m.username != "" {
+		who = append(who, "@"+m.username)
+	}
+	if m.orgName != "" {
+		org := m.orgName
+		if m.orgTeam != "" {
+			org += "/" + m.orgTeam
+		}
+		who = append(who, org)
+	}
+	if len(who) > 0 {
+		segments = append(segments, labelStyle.Render(strings.Join(who, " · ")))
+	}
+
+	if m.refreshing {
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		segments = append(segments, accentStyle.Render(spinner+" refreshing…"))
+	} else if polled, ok := m.fetchedAt[github.SourceNotifications]; ok {
+		poll := "polled " + polled.Format("15:04:05")
+		if !m.nextPollAt.IsZero() {
+			if wait := time.Until(m.nextPollAt); wait > 0 {
+				poll += fmt.Sprintf(" · next in %ds", int(wait.Round(time.Second).Seconds()))
+			} else {
+				poll += " · polling…"
+			}
+		}
+		segments = append(segments, labelStyle.Render(poll))
+	}
+
+	if rl := m.rateLimit; rl.Limit > 0 {
+		style := labelStyle
+		text := fmt.Sprintf("API %d/%d", rl.Remaining, rl.Limit)
+		switch {
+		case rl.Remaining == 0:
+			style = lipgloss.NewStyle().Foreground(m.theme.StatusFailure)
+			text += " · resets " + rl.Reset.Local().Format("15:04")
+		case rl.Remaining < rl.Limit/10:
+			style = lipgloss.NewStyle().Foreground(m.theme.StatusPending)
+		}
+		segments = append(segments, style.Render(text))
+	}
+
+	left := m.statusIndicators() + strings.Join(segments, sep)
+	right := labelStyle.Render("?: help | q: quit")
+	gap := m.width - lipgloss.Width(left) - lipgloss.Width(right)
+	if gap < 1 {
+		return ansi.Truncate(left+sep+right, m.width, "…")
+	}
+	return left + strings.Repeat(" ", gap) + right
+}
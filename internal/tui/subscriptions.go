@@ -0,0 +1,348 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// subscriptionStateConcurrency bounds the subscription state requests made
+// while loading the watched repos.
+const subscriptionStateConcurrency = 8
+
+// watchedRepo pairs a watched repository with its subscription state.
+type watchedRepo struct {
+	repo  github.Repository
+	state github.SubscriptionState
+}
+
+// subscriptionReleasesOnly marks a repo as watched on GitHub with everything
+// except Release notifications hidden by hubell. GitHub's API has no way to
+// set the web UI's custom "Releases" watch level, so the filter is local.
+const subscriptionReleasesOnly github.SubscriptionState = "releases"
+
+// subscriptionStateLabel returns the display label for a subscription state.
+func subscriptionStateLabel(state github.SubscriptionState) string {
+	switch state {
+	case github.SubscriptionWatching:
+		return "all activity"
+	case github.SubscriptionIgnored:
+		return "ignored"
+	case subscriptionReleasesOnly:
+		return "releases only"
+	default:
+		return "participating"
+	}
+}
+
+// beginSubscriptionsLoad starts fetching the watched or starred repos,
+// depending on which list the overlay is showing.
+func (m *Model) beginSubscriptionsLoad() tea.Cmd {
+	m.subsLoading = true
+	m.subsError = nil
+	return tea.Batch(bannerTick(), fetchSubscriptions(m.ctx, m.githubClient, m.subsShowStarred))
+}
+
+// subsRows returns the rows of the list currently shown in the overlay.
+func (m *Model) subsRows() []watchedRepo {
+	if m.subsShowStarred {
+		return m.starredRepos
+	}
+	return m.subscriptions
+}
+
+// handleSubscriptionsKey handles keyboard events in the subscriptions overlay.
+func (m *Model) handleSubscriptionsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, subscriptionsKeys.Close):
+		m.showSubscriptions = false
+		return m, nil
+
+	case key.Matches(msg, subscriptionsKeys.Up):
+		if m.subsSelectedIndex > 0 {
+			m.subsSelectedIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, subscriptionsKeys.Down):
+		if m.subsSelectedIndex < len(m.subsRows())-1 {
+			m.subsSelectedIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, subscriptionsKeys.ToggleStarred):
+		m.subsShowStarred = !m.subsShowStarred
+		m.subsSelectedIndex = 0
+		if len(m.subsRows()) == 0 && !m.subsLoading {
+			return m, m.beginSubscriptionsLoad()
+		}
+		return m, nil
+
+	case key.Matches(msg, subscriptionsKeys.Refresh):
+		if !m.subsLoading {
+			return m, m.beginSubscriptionsLoad()
+		}
+		return m, nil
+
+	case key.Matches(msg, subscriptionsKeys.Open):
+		rows := m.subsRows()
+		if m.subsSelectedIndex < len(rows) {
+			if err := browser.Open(rows[m.subsSelectedIndex].repo.HTMLURL); err != nil {
+				m.err = err
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, subscriptionsKeys.Watch, subscriptionsKeys.Participating,
+		subscriptionsKeys.Ignore, subscriptionsKeys.ReleasesOnly):
+		rows := m.subsRows()
+		if m.subsLoading || m.subsSelectedIndex >= len(rows) {
+			return m, nil
+		}
+		repo := rows[m.subsSelectedIndex].repo
+		var state github.SubscriptionState
+		switch {
+		case key.Matches(msg, subscriptionsKeys.Watch):
+			state = github.SubscriptionWatching
+		case key.Matches(msg, subscriptionsKeys.Participating):
+			state = github.SubscriptionParticipating
+		case key.Matches(msg, subscriptionsKeys.Ignore):
+			state = github.SubscriptionIgnored
+		case key.Matches(msg, subscriptionsKeys.ReleasesOnly):
+			state = subscriptionReleasesOnly
+		}
+		m.subsError = nil
+		return m, updateSubscription(m.ctx, m.githubClient, repo, state)
+	}
+
+	return m, nil
+}
+
+// fetchSubscriptions creates a command that fetches the user's watched or
+// starred repos. /user/subscriptions also lists ignored repos, so each
+// watched repo's state is fetched too.
+func fetchSubscriptions(ctx context.Context, client *github.Client, starred bool) tea.Cmd {
+	return func() tea.Msg {
+		if starred {
+			repos, err := client.Notifications.ListStarredRepos(ctx)
+			if err != nil {
+				return SubscriptionErrorMsg{Err: err}
+			}
+			return SubscriptionsMsg{Repos: repos, Starred: true}
+		}
+
+		repos, err := client.Notifications.ListWatchedRepos(ctx)
+		if err != nil {
+			return SubscriptionErrorMsg{Err: err}
+		}
+		states := make(map[string]github.SubscriptionState, len(repos))
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, subscriptionStateConcurrency)
+		for _, r := range repos {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				state, err := client.Notifications.GetRepoSubscription(ctx, r.Owner.Login, r.Name)
+				<-sem
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				states[r.FullName] = state
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+		return SubscriptionsMsg{Repos: repos, States: states}
+	}
+}
+
+// updateSubscription creates a command that changes a repo subscription.
+// Releases-only subscribes the repo via GraphQL; hiding the non-release
+// notifications is done by matchesFilter.
+func updateSubscription(ctx context.Context, client *github.Client, repo github.Repository, state github.SubscriptionState) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		switch state {
+		case subscriptionReleasesOnly:
+			err = client.Notifications.UpdateSubscription(ctx, repo.NodeID, github.SubscriptionWatching)
+		default:
+			err = client.Notifications.SetRepoSubscription(ctx, repo.Owner.Login, repo.Name, state)
+		}
+		if err != nil {
+			return SubscriptionErrorMsg{Err: fmt.Errorf("%s: %w", repo.FullName, err)}
+		}
+		return SubscriptionUpdatedMsg{FullName: repo.FullName, State: state}
+	}
+}
+
+// setSubscriptions replaces the overlay rows with freshly fetched repos.
+// Watched repos take their fetched state, falling back to the state known
+// from before the reload and then to watching; starred repos take their
+// state from the watched list when it has been loaded.
+func (m *Model) setSubscriptions(repos []github.Repository, starred bool, states map[string]github.SubscriptionState) {
+	sort.Slice(repos, func(i, j int) bool {
+		return strings.ToLower(repos[i].FullName) < strings.ToLower(repos[j].FullName)
+	})
+
+	watched := make(map[string]github.SubscriptionState, len(m.subscriptions))
+	for _, s := range m.subscriptions {
+		watched[s.repo.FullName] = s.state
+	}
+
+	rows := make([]watchedRepo, len(repos))
+	for i, r := range repos {
+		state := github.SubscriptionWatching
+		if starred {
+			state = github.SubscriptionParticipating
+		}
+		if ws, ok := watched[r.FullName]; ok {
+			state = ws
+		}
+		if fs, ok := states[r.FullName]; ok {
+			state = fs
+		}
+		// Releases-only is watching with a local filter
+		if state == github.SubscriptionWatching && m.releasesOnlyRepos[r.FullName] {
+			state = subscriptionReleasesOnly
+		}
+		rows[i] = watchedRepo{repo: r, state: state}
+	}
+
+	if starred {
+		m.starredRepos = rows
+	} else {
+		m.subscriptions = rows
+	}
+	if m.subsSelectedIndex >= len(m.subsRows()) {
+		m.subsSelectedIndex = max(len(m.subsRows())-1, 0)
+	}
+}
+
+// applySubscriptionUpdate records a successful subscription change in both
+// lists and in the persisted releases-only set.
+func (m *Model) applySubscriptionUpdate(fullName string, state github.SubscriptionState) {
+	for _, rows := range [][]watchedRepo{m.subscriptions, m.starredRepos} {
+		for i := range rows {
+			if rows[i].repo.FullName == fullName {
+				rows[i].state = state
+			}
+		}
+	}
+
+	releasesOnly := state == subscriptionReleasesOnly
+	if m.releasesOnlyRepos[fullName] != releasesOnly {
+		if releasesOnly {
+			m.releasesOnlyRepos[fullName] = true
+		} else {
+			delete(m.releasesOnlyRepos, fullName)
+		}
+		_ = config.SaveReleasesOnly(m.releasesOnlyRepos)
+		m.updateNotifications(nil)
+	}
+}
+
+// renderSubscriptions renders the repo subscription management overlay.
+func (m *Model) renderSubscriptions() string {
+	maxWidth := max(min(90, m.width-2), 40)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.theme.SelectedForeground).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+
+	rows := m.subsRows()
+	listName := "Watched"
+	if m.subsShowStarred {
+		listName = "Starred"
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s Repositories (%d)", listName, len(rows))))
+	b.WriteString("\n\n")
+
+	if m.subsError != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.subsError)))
+		b.WriteString("\n\n")
+	}
+
+	if m.subsLoading {
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Loading %s repositories...", spinner, strings.ToLower(listName))))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("esc: close"))
+	} else if len(rows) == 0 {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("No %s repositories.", strings.ToLower(listName))))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("tab: watched/starred  r: refresh  esc: close"))
+	} else {
+		innerWidth := maxWidth - 6
+		stateWidth := 14
+		nameWidth := max(innerWidth-stateWidth-4, 16)
+
+		header := fmt.Sprintf("  %-*s %*s", nameWidth, "Repository", stateWidth, "Notifications")
+		b.WriteString(accentStyle.Render(header))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("  " + strings.Repeat("─", innerWidth-2)))
+		b.WriteString("\n")
+
+		headerLines := 4 // title + blank + header + separator
+		footerLines := 3 // blank + scroll/help
+		visibleRows := max(maxHeight-headerLines-footerLines-4, 3)
+
+		scrollOffset := 0
+		if m.subsSelectedIndex >= visibleRows {
+			scrollOffset = m.subsSelectedIndex - visibleRows + 1
+		}
+		endIdx := min(scrollOffset+visibleRows, len(rows))
+
+		for i := scrollOffset; i < endIdx; i++ {
+			s := rows[i]
+			name := s.repo.FullName
+			if s.repo.Private {
+				name += " (private)"
+			}
+			name = truncateOrgLoadingText(name, nameWidth)
+			line := fmt.Sprintf("%-*s %*s", nameWidth, name, stateWidth, subscriptionStateLabel(s.state))
+			if i == m.subsSelectedIndex {
+				b.WriteString(selectedStyle.Render("▸ " + line))
+			} else {
+				b.WriteString(normalStyle.Render("  " + line))
+			}
+			b.WriteString("\n")
+		}
+
+		if len(rows) > visibleRows {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (%d-%d of %d)", scrollOffset+1, endIdx, len(rows))))
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("w: all activity  R: releases only  p/u: unwatch (participating only)  i: ignore"))
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("tab: watched/starred  enter: open  r: refresh  esc: close"))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
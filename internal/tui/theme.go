@@ -2,6 +2,8 @@ package tui
 
 import (
 	"image/color"
+	"io"
+	"slices"
 
 	"charm.land/bubbles/v2/list"
 	"charm.land/lipgloss/v2"
@@ -36,9 +38,13 @@ type Theme struct {
 	NormalDesc         color.Color
 
 	// Timeline event colors
-	TimelineCreated  color.Color
-	TimelineApproved color.Color
-	TimelineMerged   color.Color
+	TimelineCreated   color.Color
+	TimelineApproved  color.Color
+	TimelineMerged    color.Color
+	TimelineChanges   color.Color // changes requested
+	TimelineRequested color.Color // review requested
+	TimelineClosed    color.Color // closed without merging
+	TimelineReopened  color.Color
 
 	// General
 	Accent color.Color
@@ -67,6 +73,10 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("33"),
 		TimelineApproved:   lipgloss.Color("42"),
 		TimelineMerged:     lipgloss.Color("135"),
+		TimelineChanges:    lipgloss.Color("208"),
+		TimelineRequested:  lipgloss.Color("220"),
+		TimelineClosed:     lipgloss.Color("196"),
+		TimelineReopened:   lipgloss.Color("37"),
 		Accent:             lipgloss.Color("62"),
 		Subtle:             lipgloss.Color("241"),
 	},
@@ -90,6 +100,10 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("#81A1C1"),
 		TimelineApproved:   lipgloss.Color("#A3BE8C"),
 		TimelineMerged:     lipgloss.Color("#B48EAD"),
+		TimelineChanges:    lipgloss.Color("#D08770"),
+		TimelineRequested:  lipgloss.Color("#EBCB8B"),
+		TimelineClosed:     lipgloss.Color("#BF616A"),
+		TimelineReopened:   lipgloss.Color("#88C0D0"),
 		Accent:             lipgloss.Color("#88C0D0"),
 		Subtle:             lipgloss.Color("#4C566A"),
 	},
@@ -113,6 +127,10 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("#8BE9FD"),
 		TimelineApproved:   lipgloss.Color("#50FA7B"),
 		TimelineMerged:     lipgloss.Color("#BD93F9"),
+		TimelineChanges:    lipgloss.Color("#FFB86C"),
+		TimelineRequested:  lipgloss.Color("#F1FA8C"),
+		TimelineClosed:     lipgloss.Color("#FF5555"),
+		TimelineReopened:   lipgloss.Color("#FF79C6"),
 		Accent:             lipgloss.Color("#BD93F9"),
 		Subtle:             lipgloss.Color("#6272A4"),
 	},
@@ -136,6 +154,10 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("#89B4FA"),
 		TimelineApproved:   lipgloss.Color("#A6E3A1"),
 		TimelineMerged:     lipgloss.Color("#CBA6F7"),
+		TimelineChanges:    lipgloss.Color("#FAB387"),
+		TimelineRequested:  lipgloss.Color("#F9E2AF"),
+		TimelineClosed:     lipgloss.Color("#F38BA8"),
+		TimelineReopened:   lipgloss.Color("#94E2D5"),
 		Accent:             lipgloss.Color("#CBA6F7"),
 		Subtle:             lipgloss.Color("#585B70"),
 	},
@@ -159,9 +181,94 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("#268BD2"),
 		TimelineApproved:   lipgloss.Color("#859900"),
 		TimelineMerged:     lipgloss.Color("#6C71C4"),
+		TimelineChanges:    lipgloss.Color("#CB4B16"),
+		TimelineRequested:  lipgloss.Color("#B58900"),
+		TimelineClosed:     lipgloss.Color("#DC322F"),
+		TimelineReopened:   lipgloss.Color("#2AA198"),
 		Accent:             lipgloss.Color("#268BD2"),
 		Subtle:             lipgloss.Color("#586E75"),
 	},
+	"solarized-light": {
+		Name:               "Solarized Light",
+		Error:              lipgloss.Color("#DC322F"),
+		HelpText:           lipgloss.Color("#93A1A1"),
+		FocusedBorder:      lipgloss.Color("#268BD2"),
+		UnfocusedBorder:    lipgloss.Color("#93A1A1"),
+		BannerDark:         [3]int{238, 232, 213},
+		BannerBright:       [3]int{38, 139, 210},
+		StatusSuccess:      lipgloss.Color("#859900"),
+		StatusFailure:      lipgloss.Color("#DC322F"),
+		StatusPending:      lipgloss.Color("#B58900"),
+		Title:              lipgloss.Color("#268BD2"),
+		TitleBar:           lipgloss.Color("#EEE8D5"),
+		SelectedForeground: lipgloss.Color("#268BD2"),
+		SelectedDesc:       lipgloss.Color("#586E75"),
+		NormalForeground:   lipgloss.Color("#073642"),
+		NormalDesc:         lipgloss.Color("#93A1A1"),
+		TimelineCreated:    lipgloss.Color("#268BD2"),
+		TimelineApproved:   lipgloss.Color("#859900"),
+		TimelineMerged:     lipgloss.Color("#6C71C4"),
+		TimelineChanges:    lipgloss.Color("#CB4B16"),
+		TimelineRequested:  lipgloss.Color("#B58900"),
+		TimelineClosed:     lipgloss.Color("#DC322F"),
+		TimelineReopened:   lipgloss.Color("#2AA198"),
+		Accent:             lipgloss.Color("#268BD2"),
+		Subtle:             lipgloss.Color("#93A1A1"),
+	},
+	"latte": {
+		Name:               "Catppuccin Latte",
+		Error:              lipgloss.Color("#D20F39"),
+		HelpText:           lipgloss.Color("#9CA0B0"),
+		FocusedBorder:      lipgloss.Color("#8839EF"),
+		UnfocusedBorder:    lipgloss.Color("#BCC0CC"),
+		BannerDark:         [3]int{204, 208, 218},
+		BannerBright:       [3]int{136, 57, 239},
+		StatusSuccess:      lipgloss.Color("#40A02B"),
+		StatusFailure:      lipgloss.Color("#D20F39"),
+		StatusPending:      lipgloss.Color("#DF8E1D"),
+		Title:              lipgloss.Color("#8839EF"),
+		TitleBar:           lipgloss.Color("#CCD0DA"),
+		SelectedForeground: lipgloss.Color("#8839EF"),
+		SelectedDesc:       lipgloss.Color("#4C4F69"),
+		NormalForeground:   lipgloss.Color("#4C4F69"),
+		NormalDesc:         lipgloss.Color("#9CA0B0"),
+		TimelineCreated:    lipgloss.Color("#1E66F5"),
+		TimelineApproved:   lipgloss.Color("#40A02B"),
+		TimelineMerged:     lipgloss.Color("#8839EF"),
+		TimelineChanges:    lipgloss.Color("#FE640B"),
+		TimelineRequested:  lipgloss.Color("#DF8E1D"),
+		TimelineClosed:     lipgloss.Color("#D20F39"),
+		TimelineReopened:   lipgloss.Color("#179299"),
+		Accent:             lipgloss.Color("#8839EF"),
+		Subtle:             lipgloss.Color("#9CA0B0"),
+	},
+	"github-light": {
+		Name:               "GitHub Light",
+		Error:              lipgloss.Color("#CF222E"),
+		HelpText:           lipgloss.Color("#656D76"),
+		FocusedBorder:      lipgloss.Color("#0969DA"),
+		UnfocusedBorder:    lipgloss.Color("#D0D7DE"),
+		BannerDark:         [3]int{208, 215, 222},
+		BannerBright:       [3]int{9, 105, 218},
+		StatusSuccess:      lipgloss.Color("#1A7F37"),
+		StatusFailure:      lipgloss.Color("#CF222E"),
+		StatusPending:      lipgloss.Color("#9A6700"),
+		Title:              lipgloss.Color("#0969DA"),
+		TitleBar:           lipgloss.Color("#F6F8FA"),
+		SelectedForeground: lipgloss.Color("#0969DA"),
+		SelectedDesc:       lipgloss.Color("#1F2328"),
+		NormalForeground:   lipgloss.Color("#1F2328"),
+		NormalDesc:         lipgloss.Color("#656D76"),
+		TimelineCreated:    lipgloss.Color("#0969DA"),
+		TimelineApproved:   lipgloss.Color("#1A7F37"),
+		TimelineMerged:     lipgloss.Color("#8250DF"),
+		TimelineChanges:    lipgloss.Color("#BC4C00"),
+		TimelineRequested:  lipgloss.Color("#9A6700"),
+		TimelineClosed:     lipgloss.Color("#CF222E"),
+		TimelineReopened:   lipgloss.Color("#1B7C83"),
+		Accent:             lipgloss.Color("#0969DA"),
+		Subtle:             lipgloss.Color("#656D76"),
+	},
 	"gruvbox": {
 		Name:               "Gruvbox",
 		Error:              lipgloss.Color("#FB4934"),
@@ -182,6 +289,10 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("#83A598"),
 		TimelineApproved:   lipgloss.Color("#B8BB26"),
 		TimelineMerged:     lipgloss.Color("#D3869B"),
+		TimelineChanges:    lipgloss.Color("#FE8019"),
+		TimelineRequested:  lipgloss.Color("#FABD2F"),
+		TimelineClosed:     lipgloss.Color("#FB4934"),
+		TimelineReopened:   lipgloss.Color("#8EC07C"),
 		Accent:             lipgloss.Color("#FE8019"),
 		Subtle:             lipgloss.Color("#665C54"),
 	},
@@ -205,6 +316,10 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("#7AA2F7"),
 		TimelineApproved:   lipgloss.Color("#9ECE6A"),
 		TimelineMerged:     lipgloss.Color("#BB9AF7"),
+		TimelineChanges:    lipgloss.Color("#FF9E64"),
+		TimelineRequested:  lipgloss.Color("#E0AF68"),
+		TimelineClosed:     lipgloss.Color("#F7768E"),
+		TimelineReopened:   lipgloss.Color("#73DACA"),
 		Accent:             lipgloss.Color("#7AA2F7"),
 		Subtle:             lipgloss.Color("#565F89"),
 	},
@@ -228,6 +343,10 @@ var themes = map[string]Theme{
 		TimelineCreated:    lipgloss.Color("#9CCFD8"),
 		TimelineApproved:   lipgloss.Color("#31748F"),
 		TimelineMerged:     lipgloss.Color("#C4A7E7"),
+		TimelineChanges:    lipgloss.Color("#EBBCBA"),
+		TimelineRequested:  lipgloss.Color("#F6C177"),
+		TimelineClosed:     lipgloss.Color("#EB6F92"),
+		TimelineReopened:   lipgloss.Color("#9CCFD8"),
 		Accent:             lipgloss.Color("#C4A7E7"),
 		Subtle:             lipgloss.Color("#6E6A86"),
 	},
@@ -235,6 +354,7 @@ var themes = map[string]Theme{
 
 // themeOrder defines the display order in the selector.
 var themeOrder = []string{
+	autoTheme,
 	"default",
 	"nord",
 	"dracula",
@@ -243,9 +363,29 @@ var themeOrder = []string{
 	"gruvbox",
 	"tokyonight",
 	"rosepine",
+	"solarized-light",
+	"latte",
+	"github-light",
+}
+
+// autoTheme follows the terminal background: the default theme on dark
+// backgrounds and GitHub Light on light ones.
+const autoTheme = "auto"
+
+// autoThemeFor returns the theme auto picks for a dark or light background.
+func autoThemeFor(dark bool) string {
+	if dark {
+		return "default"
+	}
+	return "github-light"
 }
 
 // GetTheme returns the theme for the given key, falling back to default.
+// ThemeNames returns the names of the built-in and custom themes.
+func ThemeNames() []string {
+	return slices.Clone(themeOrder)
+}
+
 func GetTheme(name string) Theme {
 	if t, ok := themes[name]; ok {
 		return t
@@ -269,6 +409,39 @@ func newThemedDelegate(t Theme) list.DefaultDelegate {
 	return d
 }
 
+// notificationDelegate is the themed delegate for notifications, which
+// soft-wraps each description over the lines below the title.
+type notificationDelegate struct {
+	list.DefaultDelegate
+	// fade colors read notifications about to be hidden
+	fade color.Color
+}
+
+// newNotificationDelegate creates a themed notification delegate showing
+// descLines lines of description per item.
+func newNotificationDelegate(t Theme, descLines int) notificationDelegate {
+	d := newThemedDelegate(t)
+	d.SetHeight(1 + max(descLines, 1))
+	return notificationDelegate{DefaultDelegate: d, fade: t.Subtle}
+}
+
+// Render wraps notification descriptions to the list width and fades read
+// ones before handing the item to the default delegate.
+func (d notificationDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	if n, ok := item.(NotificationItem); ok {
+		if d.Height() > 2 {
+			n.wrapWidth = m.Width() - d.Styles.NormalDesc.GetHorizontalFrameSize()
+			n.descLines = d.Height() - 1
+			item = n
+		}
+		if n.fading {
+			d.Styles.NormalTitle = d.Styles.NormalTitle.Foreground(d.fade).Faint(true)
+			d.Styles.NormalDesc = d.Styles.NormalDesc.Foreground(d.fade).Faint(true)
+		}
+	}
+	d.DefaultDelegate.Render(w, m, index, item)
+}
+
 // applyListTheme sets the title style on a list model.
 func applyListTheme(l *list.Model, t Theme) {
 	l.Styles.Title = l.Styles.Title.
@@ -290,7 +463,11 @@ func (i ThemeItem) Description() string { return "" }
 func buildThemeList() list.Model {
 	items := make([]list.Item, len(themeOrder))
 	for i, key := range themeOrder {
-		items[i] = ThemeItem{key: key, name: themes[key].Name}
+		name := themes[key].Name
+		if key == autoTheme {
+			name = "Auto (light/dark)"
+		}
+		items[i] = ThemeItem{key: key, name: name}
 	}
 
 	d := list.NewDefaultDelegate()
@@ -306,10 +483,20 @@ func buildThemeList() list.Model {
 
 // applyTheme switches the active theme and persists it.
 func (m *Model) applyTheme(name string) {
-	m.theme = GetTheme(name)
+	m.setTheme(name)
+	_ = config.SaveTheme(name)
+}
+
+// setTheme switches the active theme for this run.
+func (m *Model) setTheme(name string) {
+	m.themeKey = name
+	if name == autoTheme {
+		name = autoThemeFor(m.darkBackground)
+	}
+	m.theme = degradeTheme(GetTheme(name), m.colorProfile)
 
 	// Re-theme notification list
-	nd := newThemedDelegate(m.theme)
+	nd := newNotificationDelegate(m.theme, m.layout.DescriptionLines)
 	m.list.SetDelegate(nd)
 	applyListTheme(&m.list, m.theme)
 
@@ -319,12 +506,10 @@ func (m *Model) applyTheme(name string) {
 	applyListTheme(&m.prList, m.theme)
 
 	// Re-theme timeline list
-	td := newTimelineDelegate(m.theme)
+	td := newTimelineDelegate(m.theme, m.zones)
 	m.timelineList.SetDelegate(td)
 	applyListTheme(&m.timelineList, m.theme)
 
 	// Rebuild theme list so it picks up new styling
 	m.themeList = buildThemeList()
-
-	_ = config.SaveTheme(name)
 }
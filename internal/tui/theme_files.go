@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
+)
+
+// themeFilesInterval is how often the themes directory is checked for
+// edited theme files.
+const themeFilesInterval = 2 * time.Second
+
+// customThemes are the keys of the themes loaded from theme files; they
+// follow the built-ins in themeOrder.
+var customThemes []string
+
+// LoadCustomThemes (re)loads the user's theme files into the theme list,
+// replacing any loaded before. A theme file named after a built-in theme
+// is skipped and reported in the returned error, as are files that don't
+// load.
+func LoadCustomThemes() error {
+	files, err := config.LoadThemeFiles()
+
+	for _, k := range customThemes {
+		delete(themes, k)
+	}
+	themeOrder = themeOrder[:len(themeOrder)-len(customThemes)]
+	customThemes = nil
+
+	for _, f := range files {
+		if _, ok := themes[f.Key]; ok || f.Key == autoTheme {
+			err = errors.Join(err, fmt.Errorf("theme %q: name taken by a built-in theme", f.Key))
+			continue
+		}
+		themes[f.Key] = themeFromFile(f)
+		themeOrder = append(themeOrder, f.Key)
+		customThemes = append(customThemes, f.Key)
+	}
+	return err
+}
+
+// themeFromFile converts a theme file's color strings to a Theme.
+func themeFromFile(f config.ThemeFile) Theme {
+	return Theme{
+		Name:               f.Name,
+		Error:              lipgloss.Color(f.Error),
+		HelpText:           lipgloss.Color(f.HelpText),
+		FocusedBorder:      lipgloss.Color(f.FocusedBorder),
+		UnfocusedBorder:    lipgloss.Color(f.UnfocusedBorder),
+		BannerDark:         f.BannerDark,
+		BannerBright:       f.BannerBright,
+		StatusSuccess:      lipgloss.Color(f.StatusSuccess),
+		StatusFailure:      lipgloss.Color(f.StatusFailure),
+		StatusPending:      lipgloss.Color(f.StatusPending),
+		Title:              lipgloss.Color(f.Title),
+		TitleBar:           lipgloss.Color(f.TitleBar),
+		SelectedForeground: lipgloss.Color(f.SelectedForeground),
+		SelectedDesc:       lipgloss.Color(f.SelectedDesc),
+		NormalForeground:   lipgloss.Color(f.NormalForeground),
+		NormalDesc:         lipgloss.Color(f.NormalDesc),
+		TimelineCreated:    lipgloss.Color(f.TimelineCreated),
+		TimelineApproved:   lipgloss.Color(f.TimelineApproved),
+		TimelineMerged:     lipgloss.Color(f.TimelineMerged),
+		TimelineChanges:    lipgloss.Color(f.TimelineChanges),
+		TimelineRequested:  lipgloss.Color(f.TimelineRequested),
+		TimelineClosed:     lipgloss.Color(f.TimelineClosed),
+		TimelineReopened:   lipgloss.Color(f.TimelineReopened),
+		Accent:             lipgloss.Color(f.Accent),
+		Subtle:             lipgloss.Color(f.Subtle),
+	}
+}
+
+// themeFilesTick schedules the next check of the themes directory.
+func themeFilesTick() tea.Cmd {
+	return tea.Tick(themeFilesInterval, func(time.Time) tea.Msg {
+		return ThemeFilesMsg{Stamp: config.ThemeFilesStamp()}
+	})
+}
+
+// reloadThemes reloads the theme files after one was added, edited or
+// removed, restyling the view when the active theme changed with it.
+func (m *Model) reloadThemes() {
+	if err := LoadCustomThemes(); err != nil {
+		m.err = fmt.Errorf("themes: %w", err)
+	}
+	m.setTheme(m.themeKey)
+}
@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// threadCommentLimit is how many of the most recent comments are shown.
+const threadCommentLimit = 30
+
+// openThread shows the comment thread overlay for a notification and starts
+// loading its comments.
+func (m *Model) openThread(n *github.Notification) tea.Cmd {
+	owner, repo, number, ok := github.ParseSubjectURL(n.Subject.URL)
+	m.showThread = true
+	m.threadNotification = n
+	m.threadComments = nil
+	m.threadLabels, m.threadMilestone = nil, nil
+	m.threadError = nil
+	m.threadScroll = 0
+	if !ok {
+		m.threadLoading = false
+		m.threadError = fmt.Errorf("%s notifications have no comment thread", n.Subject.Type)
+		return nil
+	}
+	m.threadLoading = true
+	return tea.Batch(bannerTick(), fetchThread(m.ctx, m.githubClient, n.ID, owner, repo, number))
+}
+
+// fetchThread creates a command that loads the last comments of a thread.
+func fetchThread(ctx context.Context, client *github.Client, threadID, owner, repo string, number int) tea.Cmd {
+	return func() tea.Msg {
+		thread, err := client.Notifications.GetThread(ctx, owner, repo, number, threadCommentLimit)
+		if err != nil {
+			return ThreadCommentsMsg{ThreadID: threadID, Err: err}
+		}
+		return ThreadCommentsMsg{ThreadID: threadID, Comments: thread.Comments, Labels: thread.Labels, Milestone: thread.Milestone}
+	}
+}
+
+// handleThreadKey handles key events in the comment thread overlay.
+func (m *Model) handleThreadKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, threadKeys.Close):
+		m.showThread = false
+		return m, nil
+
+	case key.Matches(msg, threadKeys.Up):
+		if m.threadScroll > 0 {
+			m.threadScroll--
+		}
+		return m, nil
+
+	case key.Matches(msg, threadKeys.Down):
+		m.threadScroll++
+		return m, nil
+
+	case key.Matches(msg, threadKeys.PageUp):
+		m.threadScroll = max(m.threadScroll-10, 0)
+		return m, nil
+
+	case key.Matches(msg, threadKeys.PageDown):
+		m.threadScroll += 10
+		return m, nil
+
+	case key.Matches(msg, threadKeys.Latest):
+		m.threadScroll = 1 << 30 // clamped when rendering
+		return m, nil
+
+	case key.Matches(msg, threadKeys.Open):
+		if err := browser.Open(m.notificationURL(m.threadNotification)); err != nil {
+			m.threadError = err
+		}
+		return m, nil
+
+	case key.Matches(msg, threadKeys.Pager):
+		if m.threadLoading || len(m.threadComments) == 0 {
+			return m, nil
+		}
+		return m, openInPager(m.threadText())
+	}
+
+	return m, nil
+}
+
+// renderThread renders the comment thread overlay.
+func (m *Model) renderThread() string {
+	maxWidth := max(min(100, m.width-2), 50)
+	maxHeight := max(m.height-2, 10)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	accentStyle := lipgloss.NewStyle().Foreground(m.theme.Accent).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error).Bold(true)
+
+	innerWidth := maxWidth - 6
+	n := m.threadNotification
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(truncateOrgLoadingText(n.Subject.Title, innerWidth)))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(n.Repository.FullName))
+	if m.threadMilestone != nil {
+		b.WriteString(subtleStyle.Render(" · ◷ " + milestoneLabel(m.threadMilestone)))
+	}
+	b.WriteString("\n")
+	if len(m.threadLabels) > 0 {
+		b.WriteString(ansi.Truncate(labelChips(m.threadLabels), innerWidth, "…"))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if m.threadError != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %s", m.threadError)))
+		b.WriteString("\n\n")
+	}
+
+	switch {
+	case m.threadLoading:
+		spinner := spinnerFrames[m.bannerFrame%len(spinnerFrames)]
+		b.WriteString(accentStyle.Render(fmt.Sprintf(" %s Loading comments...", spinner)))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("esc: close"))
+
+	case m.threadError == nil && len(m.threadComments) == 0:
+		b.WriteString(subtleStyle.Render("No comments yet."))
+		b.WriteString("\n\n")
+		b.WriteString(subtleStyle.Render("enter: open in browser  esc: close"))
+
+	default:
+		var lines []string
+		now := time.Now()
+		for i, c := range m.threadComments {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, accentStyle.Render("@"+c.Author)+subtleStyle.Render(" · "+formatDuration(now.Sub(c.CreatedAt))))
+			body := strings.TrimSpace(strings.ReplaceAll(c.Body, "\r\n", "\n"))
+			if body == "" {
+				body = "(no text)"
+			}
+			wrapped := normalStyle.Width(innerWidth - 2).Render(body)
+			for line := range strings.SplitSeq(wrapped, "\n") {
+				lines = append(lines, "  "+line)
+			}
+		}
+
+		visible := max(maxHeight-10, 3)
+		maxScroll := max(len(lines)-visible, 0)
+		m.threadScroll = min(m.threadScroll, maxScroll)
+		end := min(m.threadScroll+visible, len(lines))
+		b.WriteString(strings.Join(lines[m.threadScroll:end], "\n"))
+		b.WriteString("\n")
+
+		if len(lines) > visible {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf(" (lines %d-%d of %d)", m.threadScroll+1, end, len(lines))))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("last %d comments  ↑↓: scroll  G: latest  enter: open in browser  |: pager  esc: close", len(m.threadComments))))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
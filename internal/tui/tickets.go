@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/browser"
+	"github.com/jpoz/hubell/internal/github"
+	"github.com/jpoz/hubell/internal/tickets"
+)
+
+// ticketStatusTTL is how long a fetched ticket status is reused before it is
+// requested again.
+const ticketStatusTTL = 10 * time.Minute
+
+// prTicket is a ticket referenced by a PR, with its status when known.
+type prTicket struct {
+	tickets.Ticket
+	status string
+}
+
+// prTickets returns the tickets referenced by a PR's branch and title.
+func (m *Model) prTickets(info github.PRInfo) []prTicket {
+	if len(m.ticketTrackers) == 0 {
+		return nil
+	}
+	found := tickets.Find(m.ticketTrackers, info.Branch, info.Title)
+	result := make([]prTicket, 0, len(found))
+	for _, t := range found {
+		result = append(result, prTicket{Ticket: t, status: m.ticketStatuses[t.Key]})
+	}
+	return result
+}
+
+// fetchTicketStatuses starts status lookups for referenced tickets whose
+// status is missing or older than ticketStatusTTL.
+func (m *Model) fetchTicketStatuses() tea.Cmd {
+	if m.lowPower {
+		return nil
+	}
+	now := time.Now()
+	var cmds []tea.Cmd
+	for _, info := range m.prInfos {
+		for _, t := range tickets.Find(m.ticketTrackers, info.Branch, info.Title) {
+			tracker := m.ticketTrackers[t.Tracker]
+			if !tracker.CanFetchStatus() {
+				continue
+			}
+			if at, ok := m.ticketFetchedAt[t.Key]; ok && now.Sub(at) < ticketStatusTTL {
+				continue
+			}
+			// Recorded up front so in-flight and failed lookups aren't repeated
+			m.ticketFetchedAt[t.Key] = now
+			cmds = append(cmds, fetchTicketStatus(m.ctx, tracker, t.Key))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchTicketStatus creates a command that looks up one ticket's status.
+func fetchTicketStatus(ctx context.Context, tracker tickets.Tracker, key string) tea.Cmd {
+	return func() tea.Msg {
+		status, err := tracker.FetchStatus(ctx, key)
+		return TicketStatusMsg{Key: key, Status: status, Err: err}
+	}
+}
+
+// openTickets opens every ticket referenced by a PR in the browser.
+func openTickets(ts []prTicket) error {
+	var errs []error
+	for _, t := range ts {
+		errs = append(errs, browser.Open(t.URL))
+	}
+	return errors.Join(errs...)
+}
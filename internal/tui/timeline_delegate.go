@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"io"
+	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/list"
@@ -16,10 +17,12 @@ import (
 // with colored icons per event type.
 type TimelineDelegate struct {
 	theme Theme
+	// zones are actors' timezones by lowercased login, shown next to them
+	zones map[string]*time.Location
 }
 
-func newTimelineDelegate(t Theme) TimelineDelegate {
-	return TimelineDelegate{theme: t}
+func newTimelineDelegate(t Theme, zones map[string]*time.Location) TimelineDelegate {
+	return TimelineDelegate{theme: t, zones: zones}
 }
 
 func (d TimelineDelegate) Height() int                             { return 2 }
@@ -40,17 +43,38 @@ func (d TimelineDelegate) Render(w io.Writer, m list.Model, index int, item list
 	var iconColor color.Color
 	switch evt.EventType {
 	case TimelineEventCreated:
-		icon = "+"
+		icon = icons.Created
 		label = "created"
 		iconColor = d.theme.TimelineCreated
 	case TimelineEventApproved:
-		icon = "✓"
+		icon = icons.Approved
 		label = "approved"
 		iconColor = d.theme.TimelineApproved
 	case TimelineEventMerged:
-		icon = "⊕"
+		icon = icons.Merged
 		label = "merged"
 		iconColor = d.theme.TimelineMerged
+	case TimelineEventChangesRequested:
+		icon = icons.ChangesRequested
+		label = "changes requested"
+		iconColor = d.theme.TimelineChanges
+	case TimelineEventReviewRequested:
+		icon = icons.ReviewRequested
+		label = "review requested"
+		iconColor = d.theme.TimelineRequested
+	case TimelineEventClosed:
+		icon = icons.Closed
+		label = "closed"
+		iconColor = d.theme.TimelineClosed
+	case TimelineEventReopened:
+		icon = icons.Reopened
+		label = "reopened"
+		iconColor = d.theme.TimelineReopened
+	}
+	if evt.FirstContribution {
+		icon = icons.FirstMerge
+		label = "first merge"
+		iconColor = d.theme.Accent
 	}
 
 	// Line 1: icon + "merged 2h ago owner/repo#number"
@@ -76,7 +100,12 @@ func (d TimelineDelegate) Render(w io.Writer, m list.Model, index int, item list
 
 	descText := evt.Title
 	if evt.Actor != "" {
-		descText = fmt.Sprintf("@%s · %s", evt.Actor, evt.Title)
+		actor := "@" + evt.Actor
+		// The actor's local time of the event shows where their day was
+		if loc, ok := d.zones[strings.ToLower(evt.Actor)]; ok {
+			actor += " (" + evt.Timestamp.In(loc).Format("15:04 MST") + ")"
+		}
+		descText = fmt.Sprintf("%s · %s", actor, evt.Title)
 	}
 	descLine := lipgloss.NewStyle().Foreground(descColor).Render(descText)
 
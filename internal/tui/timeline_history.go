@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/jpoz/hubell/internal/config"
+)
+
+// timelineKinds names event types in the timeline history file, so it
+// survives reordering of the constants.
+var timelineKinds = map[TimelineEventType]string{
+	TimelineEventCreated:          "created",
+	TimelineEventApproved:         "approved",
+	TimelineEventMerged:           "merged",
+	TimelineEventChangesRequested: "changes_requested",
+	TimelineEventReviewRequested:  "review_requested",
+	TimelineEventClosed:           "closed",
+	TimelineEventReopened:         "reopened",
+}
+
+// timelineRecordKey identifies an event in the history. The actor is left
+// out as the user-scoped timeline doesn't know it for created and merged
+// events.
+func timelineRecordKey(r config.TimelineRecord) string {
+	return fmt.Sprintf("%s|%s|%s/%s#%d|%d", r.Account, r.Kind, r.Owner, r.Repo, r.Number, r.At.Unix())
+}
+
+// timelineRecord converts an event seen on the account's timeline to a
+// history record.
+func timelineRecord(account string, e TimelineEvent) config.TimelineRecord {
+	return config.TimelineRecord{
+		Account:           account,
+		Kind:              timelineKinds[e.EventType],
+		At:                e.Timestamp,
+		Owner:             e.Owner,
+		Repo:              e.Repo,
+		Number:            e.Number,
+		Title:             e.Title,
+		URL:               e.URL,
+		Actor:             e.Actor,
+		FirstContribution: e.FirstContribution,
+	}
+}
+
+// timelineEventFromRecord converts a history record back to an event. The
+// second return value is false for kinds this version doesn't know.
+func timelineEventFromRecord(r config.TimelineRecord) (TimelineEvent, bool) {
+	for t, kind := range timelineKinds {
+		if kind == r.Kind {
+			return TimelineEvent{
+				EventType:         t,
+				Timestamp:         r.At,
+				Owner:             r.Owner,
+				Repo:              r.Repo,
+				Number:            r.Number,
+				Title:             r.Title,
+				URL:               r.URL,
+				Actor:             r.Actor,
+				FirstContribution: r.FirstContribution,
+			}, true
+		}
+	}
+	return TimelineEvent{}, false
+}
+
+// mergeTimelineHistory appends events not seen before to the timeline
+// history, and adds the active account's past events that are no longer in
+// the current data, e.g. PRs that dropped out of the merged window.
+func (m *Model) mergeTimelineHistory(events []TimelineEvent) []TimelineEvent {
+	if m.username == "" {
+		return events
+	}
+	live := make(map[string]bool, len(events))
+	var fresh []config.TimelineRecord
+	for _, e := range events {
+		if e.Timestamp.IsZero() {
+			continue
+		}
+		r := timelineRecord(m.username, e)
+		k := timelineRecordKey(r)
+		live[k] = true
+		if !m.timelineSeen[k] {
+			m.timelineSeen[k] = true
+			m.timelineHistory = append(m.timelineHistory, r)
+			fresh = append(fresh, r)
+		}
+	}
+	if err := config.AppendTimelineHistory(fresh); err != nil {
+		m.err = fmt.Errorf("save timeline history: %w", err)
+	}
+
+	for _, r := range m.timelineHistory {
+		if r.Account != m.username || live[timelineRecordKey(r)] {
+			continue
+		}
+		if e, ok := timelineEventFromRecord(r); ok {
+			events = append(events, e)
+		}
+	}
+	return events
+}
@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// heatGlyphs shade an hour's activity from none to the busiest hour.
+var heatGlyphs = []rune("·▁▂▃▄▅▆▇█")
+
+// timezoneActivity is the timeline activity of the members in one timezone.
+type timezoneActivity struct {
+	// name is the timezone's name, or "" for actors without one
+	name    string
+	loc     *time.Location
+	members map[string]bool
+	// hours counts events by UTC hour of day
+	hours [24]int
+	total int
+}
+
+// timezoneActivities buckets the timeline's events by their actor's
+// timezone, ordered by current UTC offset with unknown timezones last.
+func (m *Model) timezoneActivities(now time.Time) []*timezoneActivity {
+	byName := make(map[string]*timezoneActivity)
+	for _, e := range m.buildTimelineEvents() {
+		if e.Actor == "" {
+			continue
+		}
+		login := strings.ToLower(e.Actor)
+		loc, name := m.zones[login], ""
+		if loc != nil {
+			name = loc.String()
+		}
+		a, ok := byName[name]
+		if !ok {
+			a = &timezoneActivity{name: name, loc: loc, members: make(map[string]bool)}
+			byName[name] = a
+		}
+		a.members[login] = true
+		a.hours[e.Timestamp.UTC().Hour()]++
+		a.total++
+	}
+
+	activities := make([]*timezoneActivity, 0, len(byName))
+	for _, a := range byName {
+		activities = append(activities, a)
+	}
+	slices.SortFunc(activities, func(a, b *timezoneActivity) int {
+		if (a.loc == nil) != (b.loc == nil) {
+			if a.loc == nil {
+				return 1
+			}
+			return -1
+		}
+		if a.loc == nil {
+			return 0
+		}
+		_, ao := now.In(a.loc).Zone()
+		_, bo := now.In(b.loc).Zone()
+		if ao != bo {
+			return ao - bo
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	return activities
+}
+
+// handleTimezonesKey handles key events in the timezone activity overlay.
+func (m *Model) handleTimezonesKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, timezoneKeys.Close) {
+		m.showTimezones = false
+	}
+	return m, nil
+}
+
+// renderTimezones renders timeline activity as one row per timezone with a
+// cell per UTC hour, so hand-offs between regions line up vertically. Cells
+// inside the timezone's working hours are highlighted.
+func (m *Model) renderTimezones() string {
+	maxWidth := max(min(90, m.width-2), 50)
+	now := time.Now()
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	normalStyle := lipgloss.NewStyle().Foreground(m.theme.NormalForeground)
+	workStyle := lipgloss.NewStyle().Foreground(m.theme.Accent)
+
+	const labelWidth = 22
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Timeline activity by timezone"))
+	b.WriteString("\n\n")
+
+	activities := m.timezoneActivities(now)
+	if len(m.zones) == 0 {
+		b.WriteString(subtleStyle.Render("  No member timezones set. Map logins to timezones in timezones.json."))
+		b.WriteString("\n")
+	} else if len(activities) == 0 {
+		b.WriteString(subtleStyle.Render("  No timeline activity yet."))
+		b.WriteString("\n")
+	} else {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("%-*s%-24s", labelWidth, "UTC hour", "00    06    12    18")))
+		b.WriteString("\n")
+	}
+
+	busiest := 1
+	for _, a := range activities {
+		busiest = max(busiest, slices.Max(a.hours[:]))
+	}
+	for _, a := range activities {
+		label := a.name
+		if a.loc == nil {
+			label = "no timezone"
+		}
+		b.WriteString(normalStyle.Render(fmt.Sprintf("%-*s", labelWidth, truncateOrgLoadingText(label, labelWidth-2))))
+		for h, n := range a.hours {
+			glyph := string(heatGlyphs[(n*(len(heatGlyphs)-1)+busiest-1)/busiest])
+			local := time.Date(now.Year(), now.Month(), now.Day(), h, 0, 0, 0, time.UTC)
+			if a.loc != nil {
+				local = local.In(a.loc)
+			}
+			if a.loc != nil && local.Hour() >= m.workingHours.StartHour && local.Hour() < m.workingHours.EndHour {
+				b.WriteString(workStyle.Render(glyph))
+			} else {
+				b.WriteString(subtleStyle.Render(glyph))
+			}
+		}
+		detail := fmt.Sprintf("  %d by %d", a.total, len(a.members))
+		if a.loc != nil {
+			detail += " · now " + now.In(a.loc).Format("15:04")
+		}
+		b.WriteString(subtleStyle.Render(detail))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render("highlighted: working hours in that timezone  esc: close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
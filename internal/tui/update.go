@@ -2,10 +2,13 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
+	"strings"
 	"time"
 
+	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"github.com/jpoz/hubell/internal/browser"
 	"github.com/jpoz/hubell/internal/config"
@@ -21,9 +24,21 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 		return m, nil
 
+	case accountEventMsg:
+		// Drop what a poller replaced by an account switch still sent
+		if msg.account != m.account || msg.msg == nil {
+			return m, nil
+		}
+		return m.Update(msg.msg)
+
 	case PollResultMsg:
+		m.saveAttention()
 		m.loading = false
+		m.refreshing = false
 		m.err = nil
+		m.recordSources(msg.Errors)
+		m.recordPollTiming(msg.PolledAt, msg.Interval, msg.RateLimit)
+		m.pruneWatchlist(msg.ClosedWatched)
 		if msg.PRStatuses != nil {
 			m.prStatuses = msg.PRStatuses
 		}
@@ -33,18 +48,95 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.CommentDetails != nil {
 			maps.Copy(m.commentDetails, msg.CommentDetails)
 		}
-		for _, change := range msg.PRChanges {
-			notify.SendDesktopNotification(
-				fmt.Sprintf("CI %s: %s/%s", change.NewStatus, change.Owner, change.Repo),
-				fmt.Sprintf("PR #%d: %s (%s → %s)", change.Number, change.Title, change.OldStatus, change.NewStatus),
-			)
+		if msg.Mentions != nil {
+			maps.Copy(m.mentions, msg.Mentions)
+		}
+		if msg.SubjectDetails != nil {
+			maps.Copy(m.subjectDetails, msg.SubjectDetails)
+		}
+		if msg.AssignedIssues != nil {
+			m.assignedIssues = msg.AssignedIssues
+		}
+		m.secretAlerts = msg.SecretScanningAlerts
+		if msg.FailingMains != nil {
+			m.failingMains = msg.FailingMains
+			m.mainBoardIndex = min(m.mainBoardIndex, max(len(m.failingMains)-1, 0))
 		}
 		m.dashboardStats.updateFromPollResult(msg.MergedPRs, msg.WeeklyMergedCounts, msg.PRInfos)
 		m.checkReadyToMerge()
 		m.updateNotifications(msg.Notifications)
+		m.pruneBranchUpdates()
+		m.pruneForkSyncs()
+		m.pruneAutoMerges()
 		m.updatePRList()
 		m.updateTimelineList()
-		return m, waitForPollResult(m.pollCh)
+		return m, tea.Batch(m.waitForEvent(), m.fetchTicketStatuses(), m.noteRead())
+
+	case PollEventMsg:
+		if done, ok := msg.Event.(github.PollCompleted); ok {
+			m.refreshing = false
+			m.recordPollTiming(done.Result.PolledAt, done.Result.Interval, done.Result.RateLimit)
+			return m, m.waitForEvent()
+		}
+		if !m.popup {
+			m.notifyPollEvent(msg.Event)
+		}
+		return m, m.waitForEvent()
+
+	case PRRefreshMsg:
+		m.handlePRRefresh(msg)
+		return m, nil
+
+	case BranchUpdateMsg:
+		if u, ok := m.branchUpdates[msg.Key]; ok {
+			u.pending = false
+			u.result = msg.Result
+			u.err = msg.Err
+			m.branchUpdates[msg.Key] = u
+			if msg.Err != nil {
+				m.err = fmt.Errorf("%s: %w", msg.Key, msg.Err)
+			}
+			m.updatePRList()
+		}
+		return m, nil
+
+	case PRTimelineMsg:
+		m.handlePRTimeline(msg)
+		return m, nil
+
+	case LocalCommandMsg:
+		if msg.Err != nil {
+			m.err = msg.Err
+			m.notice = ""
+		} else {
+			m.notice = msg.Done
+		}
+		m.noticeLink = ""
+		return m, nil
+
+	case AutoMergeMsg:
+		m.handleAutoMerge(msg)
+		return m, nil
+
+	case ForkSyncMsg:
+		if s, ok := m.forkSyncs[msg.Key]; ok {
+			s.pending = false
+			s.result = msg.Result
+			s.err = msg.Err
+			m.forkSyncs[msg.Key] = s
+			if msg.Err != nil {
+				m.err = fmt.Errorf("%s: %w", msg.Key, msg.Err)
+			}
+			m.updatePRList()
+		}
+		return m, nil
+
+	case TicketStatusMsg:
+		if msg.Err == nil {
+			m.ticketStatuses[msg.Key] = msg.Status
+			m.updatePRList()
+		}
+		return m, nil
 
 	case LoadingProgressMsg:
 		if msg.Done {
@@ -54,14 +146,37 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, waitForLoadingStep(m.progressCh)
 
 	case OrgLoadingProgressMsg:
-		m.orgLoadProgress[msg.Step] = msg.OrgLoadingProgress
+		if msg.load != m.orgProgressCh {
+			// Drain a cancelled load so it can finish
+			return m, waitForOrgLoadingStep(msg.load)
+		}
+		if msg.Members != nil {
+			// Partial results only replace the table when there was nothing
+			// cached to show, so a background refresh doesn't flicker.
+			if m.orgStreaming {
+				m.setOrgMembers(msg.Members)
+			}
+		} else {
+			m.orgLoadProgress[msg.Step] = msg.OrgLoadingProgress
+		}
 		if m.orgProgressCh != nil {
 			return m, waitForOrgLoadingStep(m.orgProgressCh)
 		}
 		return m, nil
 
 	case BannerTickMsg:
-		if m.loading || m.orgLoading || m.engineerLoading {
+		// Animations are paused in low-power mode
+		if m.lowPower {
+			return m, nil
+		}
+		if len(m.prRefreshes) > 0 {
+			// Advance the spinners on the items being refreshed
+			m.bannerFrame++
+			m.updateNotifications(nil)
+			m.updatePRList()
+			return m, bannerTick()
+		}
+		if m.loading || m.orgLoading || m.engineerLoading || m.subsLoading || m.reviewMatrixLoading || m.prFilesLoading || m.prTimelineLoading || m.reviewTurnaroundLoading || m.userOrgsLoading || m.threadLoading || m.checksLoading || m.checksDownloading || m.refreshing || m.mainBoardLoading() || (m.showPanels && m.panelsLoading()) {
 			m.bannerFrame++
 			return m, bannerTick()
 		}
@@ -69,10 +184,35 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case ErrorMsg:
 		m.err = msg.Err
-		return m, waitForPollResult(m.pollCh)
+		m.refreshing = false
+		if errors.Is(msg.Err, github.ErrUnauthorized) {
+			return m, tea.Batch(m.waitForEvent(), m.openReauth())
+		}
+		return m, m.waitForEvent()
+
+	case PagerFinishedMsg:
+		if msg.Err != nil {
+			m.err = msg.Err
+		}
+		return m, nil
+
+	case reauthMsg:
+		m.handleReauth(msg)
+		return m, nil
+
+	case accountResolvedMsg:
+		return m, m.handleAccountResolved(msg)
 
 	case MarkAsReadSuccessMsg:
-		delete(m.allNotifications, msg.ThreadID)
+		return m, m.notificationRead(msg.ThreadID)
+
+	case hideReadMsg:
+		m.hideExpiredRead()
+		return m, nil
+
+	case MarkAsDoneSuccessMsg:
+		m.archiveNotification(msg.ThreadID, true)
+		delete(m.readAt, msg.ThreadID)
 		m.updateNotifications(nil)
 		return m, nil
 
@@ -81,32 +221,198 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case OrgDataMsg:
+		if msg.load != m.orgProgressCh {
+			return m, nil
+		}
 		m.orgLoading = false
 		m.orgProgressCh = nil
 		m.orgError = nil
 		m.orgLastLoadSummary = msg.Summary
-		m.orgMembers = msg.Members
-		m.orgSelectedIndex = 0
-		m.sortOrgMembers()
+		m.orgUpdatedAt = time.Now()
+		m.orgStreaming = false
+		m.setOrgMembers(msg.Members)
+
+		_ = config.SaveOrgCache(m.orgScopeLabel(), config.OrgCacheEntry{
+			UpdatedAt: m.orgUpdatedAt,
+			Members:   msg.Members,
+			Summary:   msg.Summary,
+		})
 		m.updateTimelineList()
 		return m, nil
 
+	case EngineerDetailPartialMsg:
+		if !m.engineerLoading || msg.Detail.Login != m.engineerLogin {
+			return m, nil
+		}
+		// Snapshots are sent concurrently; keep the most enriched one
+		if m.engineerDetail == nil || msg.Detail.EnrichDone >= m.engineerDetail.EnrichDone {
+			m.engineerDetail = msg.Detail
+		}
+		if m.engineerPartialCh != nil {
+			return m, waitForEngineerDetail(m.engineerPartialCh)
+		}
+		return m, nil
+
 	case EngineerDetailMsg:
-		m.engineerLoading = false
+		if msg.Detail.Login != m.engineerLogin {
+			return m, nil
+		}
+		m.cancelEngineerDetail()
 		m.engineerDetail = msg.Detail
-		m.engineerSelectedPR = 0
-		m.engineerScroll = 0
 		return m, nil
 
 	case OrgErrorMsg:
+		if msg.load != nil && msg.load != m.orgProgressCh {
+			return m, nil
+		}
 		m.orgLoading = false
+		m.orgStreaming = false
 		m.orgProgressCh = nil
 		m.engineerLoading = false
 		m.orgError = msg.Err
 		return m, nil
 
+	case SubscriptionsMsg:
+		m.subsLoading = false
+		m.setSubscriptions(msg.Repos, msg.Starred, msg.States)
+		return m, nil
+
+	case SubscriptionUpdatedMsg:
+		m.applySubscriptionUpdate(msg.FullName, msg.State)
+		return m, nil
+
+	case SubscriptionErrorMsg:
+		m.subsLoading = false
+		m.subsError = msg.Err
+		return m, nil
+
+	case ReviewMatrixMsg:
+		m.reviewMatrixLoading = false
+		m.reviewMatrix = msg.Matrix
+		m.reviewMatrixScroll = 0
+		return m, nil
+
+	case ReviewTurnaroundMsg:
+		m.handleReviewTurnaround(msg)
+		return m, nil
+
+	case UserOrgsMsg:
+		m.handleUserOrgs(msg)
+		return m, nil
+
+	case ReviewMatrixErrorMsg:
+		m.reviewMatrixLoading = false
+		m.reviewMatrixError = msg.Err
+		return m, nil
+
+	case PanelResultMsg:
+		state := &m.panelStates[msg.Index]
+		state.loading = false
+		state.err = msg.Err
+		if msg.Err == nil {
+			state.rows = msg.Rows
+			state.updatedAt = time.Now()
+			if msg.Index == m.panelIndex && m.panelSelected >= len(msg.Rows) {
+				m.panelSelected = max(len(msg.Rows)-1, 0)
+			}
+		}
+		return m, nil
+
+	case PRFilesMsg:
+		if msg.Key == m.prFilesKey() {
+			m.prFilesLoading = false
+			m.prFiles = msg.Files
+			m.prFilesError = msg.ConflictErr
+			m.prFilesConflicts = make(map[string]bool, len(msg.Conflicts))
+			for _, f := range msg.Conflicts {
+				m.prFilesConflicts[f] = true
+			}
+		}
+		return m, nil
+
+	case PRFilesErrorMsg:
+		if msg.Key == m.prFilesKey() {
+			m.prFilesLoading = false
+			m.prFilesError = msg.Err
+		}
+		return m, nil
+
+	case OpenURLMsg:
+		m.notice = "open on your machine: " + msg.URL
+		m.noticeLink = msg.URL
+		return m, tea.Batch(tea.SetClipboard(msg.URL), copyToClipboard(msg.URL))
+
+	case ClipboardErrorMsg:
+		m.notice = ""
+		m.noticeLink = ""
+		m.err = fmt.Errorf("copy to clipboard: %w", msg.Err)
+		return m, nil
+
+	case ArtifactsMsg:
+		if msg.Key == m.checksKey() {
+			m.checksLoading = false
+			m.checksArtifacts = msg.Artifacts
+			m.checksError = msg.Err
+		}
+		return m, nil
+
+	case ArtifactDownloadMsg:
+		m.checksDownloading = false
+		if msg.Err != nil {
+			m.checksError = fmt.Errorf("download %s: %w", msg.Name, msg.Err)
+		} else {
+			m.checksStatus = "Saved " + msg.Path
+		}
+		return m, nil
+
+	case ThreadCommentsMsg:
+		if m.threadNotification != nil && msg.ThreadID == m.threadNotification.ID {
+			m.threadLoading = false
+			m.threadComments = msg.Comments
+			m.threadLabels, m.threadMilestone = msg.Labels, msg.Milestone
+			m.threadError = msg.Err
+		}
+		return m, nil
+
+	case MeetingStateMsg:
+		return m, m.setMeetingState(msg)
+
+	case PowerStateMsg:
+		return m, tea.Batch(m.setPowerState(msg.State), powerTick(m.ctx))
+
+	case tea.ColorProfileMsg:
+		if msg.Profile != m.colorProfile {
+			m.colorProfile = msg.Profile
+			m.setTheme(m.themeKey)
+		}
+		return m, nil
+
+	case tea.BackgroundColorMsg:
+		if dark := msg.IsDark(); dark != m.darkBackground {
+			m.darkBackground = dark
+			if m.themeKey == autoTheme {
+				m.setTheme(autoTheme)
+			}
+		}
+		return m, nil
+
+	case StatusTickMsg:
+		return m, statusTick(m.lowPower)
+
+	case ThemeFilesMsg:
+		if msg.Stamp != m.themeFilesStamp {
+			m.themeFilesStamp = msg.Stamp
+			m.reloadThemes()
+		}
+		return m, themeFilesTick()
+
+	case PanelPollMsg:
+		return m, tea.Batch(m.refreshPanels(), panelPollTick())
+
 	case tea.KeyPressMsg:
-		return m.handleKeyMsg(msg)
+		model, cmd := m.handleKeyMsg(msg)
+		m.trackAttention(time.Now())
+		return model, cmd
 	}
 
 	// Pass to the focused list for navigation
@@ -124,33 +430,148 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // handleKeyMsg routes keyboard events to the appropriate handler.
 func (m *Model) handleKeyMsg(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
-	// Engineer detail overlay (innermost)
+	// Notices like "copied ..." last until the next key press
+	m.notice = ""
+	m.noticeLink = ""
+
+	// Re-authentication prompt (modal over everything)
+	if m.showReauth {
+		return m.handleReauthKey(msg)
+	}
+
+	// Help overlay (opened from the main view or popup)
+	if m.showHelp {
+		return m.handleHelpKey(msg)
+	}
+
+	// Activity heatmap (opened from the dashboard, org dashboard or
+	// engineer detail)
+	if m.showHeatmap {
+		return m.handleHeatmapKey(msg)
+	}
+
+	// Engineer detail overlay
 	if m.showEngineerDetail {
 		return m.handleEngineerDetailKey(msg)
 	}
 
+	// Review reciprocity overlay (opened from the org dashboard)
+	if m.showReviewMatrix {
+		return m.handleReviewMatrixKey(msg)
+	}
+
+	// Org summary overlay (opened from the org dashboard)
+	if m.showOrgSummary {
+		return m.handleOrgSummaryKey(msg)
+	}
+
 	// Org dashboard overlay
 	if m.showOrgDashboard {
 		return m.handleOrgDashboardKey(msg)
 	}
 
+	// Custom query panels overlay
+	if m.showPanels {
+		return m.handlePanelsKey(msg)
+	}
+
+	// Repo subscription overlay
+	if m.showSubscriptions {
+		return m.handleSubscriptionsKey(msg)
+	}
+
+	// Comment thread overlay
+	if m.showThread {
+		return m.handleThreadKey(msg)
+	}
+
+	// PR changed files overlay
+	if m.showPRFiles {
+		return m.handlePRFilesKey(msg)
+	}
+
+	// Checks and artifacts overlay
+	if m.showChecks {
+		return m.handleChecksKey(msg)
+	}
+
+	// Grouped thread overlay
+	if m.showGroup {
+		return m.handleGroupKey(msg)
+	}
+
+	// Read notification archive overlay
+	if m.showArchive {
+		return m.handleArchiveKey(msg)
+	}
+
+	// Failing-main board overlay
+	if m.showMainBoard {
+		return m.handleMainBoardKey(msg)
+	}
+
+	// Code scanning alerts overlay
+	if m.showSecurity {
+		return m.handleSecurityKey(msg)
+	}
+
+	// Per-PR timeline overlay
+	if m.showPRTimeline {
+		return m.handlePRTimelineKey(msg)
+	}
+
+	// CI settings overlay
+	if m.showCISettings {
+		return m.handleCISettingsKey(msg)
+	}
+
+	// Watch-by-reference prompt
+	if m.showWatchInput {
+		return m.handleWatchInputKey(msg)
+	}
+
+	// Auto-merge method picker
+	if m.showAutoMerge {
+		return m.handleAutoMergeKey(msg)
+	}
+
+	// Label filter prompt
+	if m.showLabelInput {
+		return m.handleLabelInputKey(msg)
+	}
+
+	// Debug log viewer
+	if m.showLog {
+		return m.handleLogKey(msg)
+	}
+
+	// Timezone activity overlay
+	if m.showTimezones {
+		return m.handleTimezonesKey(msg)
+	}
+
 	// Activity dashboard overlay
 	if m.showDashboard {
-		switch msg.String() {
-		case "esc", "q", "d":
+		switch {
+		case key.Matches(msg, dashboardKeys.Close):
 			m.showDashboard = false
 			return m, nil
+		case key.Matches(msg, dashboardKeys.Heatmap):
+			m.openHeatmap("")
+			return m, nil
+		case key.Matches(msg, dashboardKeys.Export):
+			return m, m.exportReport()
 		}
 		return m, nil
 	}
 
 	// Theme selector overlay
 	if m.showThemeSelector {
-		switch msg.String() {
-		case "esc", "q":
+		switch {
+		case key.Matches(msg, themeKeys.Close):
 			m.showThemeSelector = false
 			return m, nil
-		case "enter":
+		case key.Matches(msg, themeKeys.Apply):
 			if item, ok := m.themeList.SelectedItem().(ThemeItem); ok {
 				m.applyTheme(item.key)
 			}
@@ -163,44 +584,117 @@ func (m *Model) handleKeyMsg(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Popup mode has its own quick-triage keymap
+	if m.popup {
+		return m.handlePopupKey(msg)
+	}
+
 	// Main TUI keys
-	switch msg.String() {
-	case "ctrl+c", "q":
+	switch {
+	case key.Matches(msg, mainKeys.Quit):
+		m.trackAttention(time.Now())
+		m.saveAttention()
 		m.cancel()
 		return m, tea.Quit
 
-	case "d":
-		m.showDashboard = true
+	case key.Matches(msg, mainKeys.Help):
+		m.showHelp = true
+		m.helpScroll = 0
 		return m, nil
 
-	case "t":
+	case key.Matches(msg, mainKeys.Dashboard):
+		return m, m.openDashboard()
+
+	case key.Matches(msg, mainKeys.PRTimeline) && m.focusedPane == RightPane && m.prList.SelectedItem() != nil:
+		return m, m.openPRTimeline(m.prList.SelectedItem().(PRItem).info)
+
+	case key.Matches(msg, mainKeys.Theme):
 		m.showThemeSelector = true
 		return m, nil
 
-	case "o":
+	case key.Matches(msg, mainKeys.CISettings):
+		m.showCISettings = true
+		m.ciSettingsIndex = 0
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Subscriptions):
+		m.showSubscriptions = true
+		if len(m.subscriptions) == 0 && !m.subsLoading {
+			return m, m.beginSubscriptionsLoad()
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Panels):
+		if len(m.panels) == 0 {
+			return m, nil
+		}
+		m.showPanels = true
+		if m.panelsLoading() {
+			return m, bannerTick()
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Org):
 		m.showOrgDashboard = true
 		m.orgError = nil
 		if m.orgName == "" {
-			m.orgInputActive = true
-			return m, m.orgInput.Focus()
+			return m, m.openOrgInput()
 		}
-		if len(m.orgMembers) == 0 && !m.orgLoading {
+		if m.orgDataStale() && !m.orgLoading && !(m.lowPower && len(m.orgMembers) > 0) {
 			return m, m.beginOrgLoad(true)
 		}
 		return m, nil
 
-	case "tab":
-		m.focusedPane = (m.focusedPane + 1) % paneCount
+	case key.Matches(msg, mainKeys.NextPane):
+		m.nextPane()
+		return m, nil
+
+	case key.Matches(msg, mainKeys.PrevPane):
+		m.prevPane()
+		return m, nil
+
+	case key.Matches(msg, mainKeys.GrowPane):
+		m.resizeFocusedPane(resizeStep)
+		return m, nil
+
+	case key.Matches(msg, mainKeys.ShrinkPane):
+		m.resizeFocusedPane(-resizeStep)
+		return m, nil
+
+	case key.Matches(msg, mainKeys.TogglePane1):
+		m.togglePane(0)
+		return m, nil
+
+	case key.Matches(msg, mainKeys.TogglePane2):
+		m.togglePane(1)
 		return m, nil
 
-	case "enter":
+	case key.Matches(msg, mainKeys.TogglePane3):
+		m.togglePane(2)
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Open):
+		m.recordOpen()
 		switch m.focusedPane {
 		case LeftPane:
-			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok {
-				webURL := github.ConvertAPIURLToWeb(selectedItem.notification.Subject.URL)
+			switch selectedItem := m.list.SelectedItem().(type) {
+			case NotificationItem:
+				webURL := m.notificationURL(selectedItem.notification)
+				if selectedItem.mention != nil && selectedItem.mention.URL != "" {
+					// Jump straight to the comment that mentioned me
+					webURL = selectedItem.mention.URL
+				}
 				if err := browser.Open(webURL); err != nil {
 					m.err = err
 				}
+			case AssignedIssueItem:
+				if err := browser.Open(selectedItem.issue.HTMLURL); err != nil {
+					m.err = err
+				}
+			case SecretAlertItem:
+				if err := browser.Open(selectedItem.alert.HTMLURL); err != nil {
+					m.err = err
+				}
 			}
 		case RightPane:
 			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
@@ -217,17 +711,187 @@ func (m *Model) handleKeyMsg(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
-	case "r", "m":
+	case key.Matches(msg, mainKeys.Yank):
+		return m, m.yank(false)
+
+	case key.Matches(msg, mainKeys.YankRef):
+		return m, m.yank(true)
+
+	case key.Matches(msg, mainKeys.Snapshot):
+		path, err := m.saveSnapshot()
+		if err != nil {
+			m.err = fmt.Errorf("snapshot: %w", err)
+			return m, nil
+		}
+		m.notice = "snapshot saved to " + path
+		m.noticeLink = ""
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Watch):
+		return m, m.toggleWatchSelected()
+
+	case key.Matches(msg, mainKeys.WatchRef):
+		return m, m.openWatchInput()
+
+	case key.Matches(msg, mainKeys.LabelFilter):
+		return m, m.openLabelFilter()
+
+	case key.Matches(msg, mainKeys.RefreshPR):
+		return m, m.refreshSelectedPR()
+
+	case key.Matches(msg, mainKeys.Refresh):
+		return m, m.refreshNow()
+
+	case key.Matches(msg, mainKeys.SwitchAccount):
+		return m, m.switchAccount()
+
+	case key.Matches(msg, mainKeys.DebugLog):
+		if m.debug {
+			m.openLogView()
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Inject):
+		if !m.debug || m.poller == nil {
+			return m, nil
+		}
+		scenario := github.SyntheticScenarios[m.syntheticScenario%len(github.SyntheticScenarios)]
+		m.syntheticScenario++
+		m.notice = "injected synthetic events: " + scenario
+		m.noticeLink = ""
+		return m, injectSynthetic(m.ctx, m.poller, scenario)
+
+	case key.Matches(msg, mainKeys.Thread):
+		if m.focusedPane == LeftPane {
+			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok {
+				return m, m.openThread(selectedItem.notification)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.PRFiles):
+		if m.focusedPane == RightPane {
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				return m, m.openPRFiles(selectedItem.info)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Checks):
+		if m.focusedPane == RightPane {
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				return m, m.openChecks(selectedItem.info)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Timezones):
+		m.showTimezones = true
+		return m, nil
+
+	case key.Matches(msg, mainKeys.MainBoard):
+		m.showMainBoard = true
+		m.mainBoardIndex = 0
+		if m.mainBoardLoading() {
+			return m, bannerTick()
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Security):
+		if m.focusedPane == RightPane {
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				m.openSecurity(selectedItem.info)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Ticket):
+		if m.focusedPane == RightPane {
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				if err := openTickets(selectedItem.tickets); err != nil {
+					m.err = err
+				}
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.UpdateBranch):
+		if m.focusedPane == RightPane {
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				return m, m.startBranchUpdate(selectedItem.info)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Checkout):
+		return m, m.checkoutSelected()
+
+	case key.Matches(msg, mainKeys.Editor):
+		return m, m.openInEditor()
+
+	case key.Matches(msg, mainKeys.AutoMerge):
+		if m.focusedPane == RightPane {
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				return m, m.toggleAutoMerge(selectedItem.info)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.SyncFork):
+		if m.focusedPane == RightPane {
+			if selectedItem, ok := m.prList.SelectedItem().(PRItem); ok {
+				return m, m.startForkSync(selectedItem.info)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.MarkRead):
 		if m.focusedPane == LeftPane {
 			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok {
-				return m, markAsRead(m.ctx, m.githubClient, selectedItem.notification.ID)
+				return m, m.markThread(selectedItem, false)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.MarkDone):
+		if m.focusedPane == LeftPane {
+			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok {
+				return m, m.markThread(selectedItem, true)
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Sort):
+		m.cycleSort()
+		return m, nil
+
+	case key.Matches(msg, mainKeys.UnreadOnly):
+		m.toggleUnreadOnly()
+		return m, nil
+
+	case key.Matches(msg, mainKeys.GroupThreads):
+		if m.focusedPane == LeftPane {
+			m.groupThreads = !m.groupThreads
+			m.updateNotifications(nil)
+		}
+		return m, nil
+
+	case key.Matches(msg, mainKeys.ExpandThread):
+		if m.focusedPane == LeftPane {
+			if selectedItem, ok := m.list.SelectedItem().(NotificationItem); ok && len(selectedItem.grouped) > 0 {
+				m.openGroup(selectedItem)
 			}
 		}
 		return m, nil
 
-	case "f":
+	case key.Matches(msg, mainKeys.Archive):
+		m.showArchive = true
+		m.archiveIndex = 0
+		return m, nil
+
+	case key.Matches(msg, mainKeys.Filter):
 		if m.focusedPane == LeftPane {
-			m.filterMode = (m.filterMode + 1) % 2
+			m.filterMode = (m.filterMode + 1) % filterModeCount
 			m.updateNotifications(nil)
 		}
 		return m, nil
@@ -250,80 +914,157 @@ func (m *Model) handleKeyMsg(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 func (m *Model) handleOrgDashboardKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	// Text input mode for org name
 	if m.orgInputActive {
-		switch msg.String() {
-		case "esc":
-			m.orgInputActive = false
-			m.showOrgDashboard = false
-			return m, nil
-		case "enter":
-			val := m.orgInput.Value()
-			if val != "" {
-				m.orgName = val
-				m.orgInputActive = false
-				_ = config.SaveOrg(m.orgName)
-				return m, m.beginOrgLoad(true)
-			}
+		return m.handleOrgInputKey(msg)
+	}
+
+	// Text input mode for team slug
+	if m.teamInputActive {
+		switch {
+		case key.Matches(msg, inputKeys.Cancel):
+			m.teamInputActive = false
 			return m, nil
+		case key.Matches(msg, inputKeys.Confirm):
+			m.orgTeam = strings.TrimSpace(m.teamInput.Value())
+			m.teamInputActive = false
+			_ = config.SaveTeam(m.orgTeam)
+			m.rememberOrgScope()
+			m.loadCachedOrgData()
+			m.updateTimelineList()
+			return m, m.beginOrgLoad(true)
 		}
 		var cmd tea.Cmd
-		m.orgInput, cmd = m.orgInput.Update(msg)
+		m.teamInput, cmd = m.teamInput.Update(msg)
 		return m, cmd
 	}
 
-	switch msg.String() {
-	case "esc", "q":
+	switch {
+	case key.Matches(msg, orgKeys.Close):
 		m.showOrgDashboard = false
 		return m, nil
 
-	case "up", "k":
+	case key.Matches(msg, orgKeys.Up):
 		if m.orgSelectedIndex > 0 {
 			m.orgSelectedIndex--
 		}
 		return m, nil
 
-	case "down", "j":
-		if m.orgSelectedIndex < len(m.orgMembers)-1 {
+	case key.Matches(msg, orgKeys.Down):
+		if m.orgSelectedIndex < m.orgRowCount()-1 {
 			m.orgSelectedIndex++
 		}
 		return m, nil
 
-	case "s", "right", "l":
+	case key.Matches(msg, orgKeys.GroupByRepo):
+		m.orgGroupByRepo = !m.orgGroupByRepo
+		m.orgSelectedIndex = 0
+		return m, nil
+
+	case key.Matches(msg, orgKeys.NextSort):
+		if m.orgGroupByRepo {
+			return m, nil
+		}
 		m.orgSortColumn = (m.orgSortColumn + 1) % orgSortColumnCount
 		m.sortOrgMembers()
 		m.orgSelectedIndex = 0
 		return m, nil
 
-	case "left", "h":
+	case key.Matches(msg, orgKeys.PrevSort):
+		if m.orgGroupByRepo {
+			return m, nil
+		}
 		m.orgSortColumn = (m.orgSortColumn - 1 + orgSortColumnCount) % orgSortColumnCount
 		m.sortOrgMembers()
 		m.orgSelectedIndex = 0
 		return m, nil
 
-	case "enter":
-		if !m.orgLoading && m.orgSelectedIndex < len(m.orgMembers) {
+	case key.Matches(msg, orgKeys.Open):
+		if m.orgGroupByRepo {
+			repos := buildOrgRepoActivity(m.orgMembers)
+			if m.orgSelectedIndex < len(repos) {
+				if err := browser.Open("https://github.com/" + repos[m.orgSelectedIndex].FullName()); err != nil {
+					m.err = err
+				}
+			}
+			return m, nil
+		}
+		if m.orgSelectedIndex < len(m.orgMembers) {
 			member := m.orgMembers[m.orgSelectedIndex]
+			m.cancelEngineerDetail()
+			ctx, cancel := context.WithCancel(m.ctx)
+			m.engineerCancel = cancel
+			partialCh := make(chan *github.EngineerDetail, 64)
 			m.showEngineerDetail = true
 			m.engineerLoading = true
+			m.engineerLogin = member.Login
+			m.engineerDetail = nil
+			m.engineerPartialCh = partialCh
 			m.engineerSelectedPR = 0
 			m.engineerScroll = 0
-			return m, tea.Batch(bannerTick(), fetchEngineerDetail(m.ctx, m.githubClient, m.orgName, member.Login))
+			return m, tea.Batch(
+				bannerTick(),
+				waitForEngineerDetail(partialCh),
+				fetchEngineerDetail(ctx, m.githubClient, m.orgName, member.Login, partialCh),
+			)
 		}
 		return m, nil
 
-	case "r":
+	case key.Matches(msg, orgKeys.Refresh):
 		if !m.orgLoading {
 			return m, m.beginOrgLoad(true)
 		}
 		return m, nil
+
+	case key.Matches(msg, orgKeys.ReviewMatrix):
+		if len(m.orgMembers) > 0 {
+			m.showReviewMatrix = true
+			if m.reviewMatrix == nil && !m.reviewMatrixLoading {
+				return m, m.beginReviewMatrixLoad()
+			}
+		}
+		return m, nil
+
+	case key.Matches(msg, orgKeys.Heatmap):
+		if !m.orgGroupByRepo && m.orgSelectedIndex < len(m.orgMembers) {
+			m.openHeatmap(m.orgMembers[m.orgSelectedIndex].Login)
+		}
+		return m, nil
+
+	case key.Matches(msg, orgKeys.Team):
+		if !m.orgLoading {
+			m.teamInputActive = true
+			m.teamInput.SetValue(m.orgTeam)
+			m.teamInput.CursorEnd()
+			return m, m.teamInput.Focus()
+		}
+		return m, nil
+
+	case key.Matches(msg, orgKeys.SwitchOrg):
+		return m, m.openOrgInput()
+
+	case key.Matches(msg, orgKeys.Inactive):
+		m.toggleInactiveMembers()
+		return m, nil
+
+	case key.Matches(msg, orgKeys.Bots):
+		m.toggleBots()
+		return m, nil
+
+	case key.Matches(msg, orgKeys.Summary):
+		m.showOrgSummary = true
+		return m, nil
 	}
 
 	return m, nil
 }
 
 func (m *Model) beginOrgLoad(includeTick bool) tea.Cmd {
+	m.cancelOrgLoad()
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.orgCancel = cancel
 	progressCh := make(chan github.OrgLoadingProgress, 512)
 
 	m.orgLoading = true
+	m.orgStreaming = len(m.orgMembers) == 0
 	m.orgError = nil
 	m.orgProgressCh = progressCh
 	m.orgLoadStartedAt = time.Now()
@@ -331,7 +1072,7 @@ func (m *Model) beginOrgLoad(includeTick bool) tea.Cmd {
 
 	cmds := []tea.Cmd{
 		waitForOrgLoadingStep(progressCh),
-		fetchOrgData(m.ctx, m.githubClient, m.orgName, progressCh),
+		fetchOrgData(ctx, m.githubClient, m.poller, m.orgName, m.orgTeam, progressCh),
 	}
 	if includeTick {
 		cmds = append([]tea.Cmd{bannerTick()}, cmds...)
@@ -339,15 +1080,40 @@ func (m *Model) beginOrgLoad(includeTick bool) tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// cancelOrgLoad stops the org activity load in flight, if any, so a
+// switch to another dashboard doesn't wait on or show the old one.
+func (m *Model) cancelOrgLoad() {
+	if m.orgCancel != nil {
+		m.orgCancel()
+		m.orgCancel = nil
+	}
+	m.orgLoading = false
+	m.orgStreaming = false
+	m.orgProgressCh = nil
+}
+
+// cancelEngineerDetail stops the engineer drill-down fetch, if one is
+// running, so its workers stop once nobody reads their snapshots.
+func (m *Model) cancelEngineerDetail() {
+	if m.engineerCancel != nil {
+		m.engineerCancel()
+		m.engineerCancel = nil
+	}
+	m.engineerLoading = false
+	m.engineerPartialCh = nil
+}
+
 // handleEngineerDetailKey handles keyboard events in the engineer detail overlay.
 func (m *Model) handleEngineerDetailKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc", "q":
+	switch {
+	case key.Matches(msg, engineerKeys.Close):
 		m.showEngineerDetail = false
 		m.engineerDetail = nil
+		m.engineerLogin = ""
+		m.cancelEngineerDetail()
 		return m, nil
 
-	case "up", "k":
+	case key.Matches(msg, engineerKeys.Up):
 		if m.engineerSelectedPR > 0 {
 			m.engineerSelectedPR--
 		} else if m.engineerScroll > 0 {
@@ -355,7 +1121,7 @@ func (m *Model) handleEngineerDetailKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd
 		}
 		return m, nil
 
-	case "down", "j":
+	case key.Matches(msg, engineerKeys.Down):
 		if m.engineerDetail != nil && m.engineerSelectedPR < len(m.engineerDetail.MergedPRs)-1 {
 			m.engineerSelectedPR++
 		} else {
@@ -363,7 +1129,7 @@ func (m *Model) handleEngineerDetailKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd
 		}
 		return m, nil
 
-	case "enter":
+	case key.Matches(msg, engineerKeys.Open):
 		if m.engineerDetail != nil && len(m.engineerDetail.MergedPRs) > 0 && m.engineerSelectedPR < len(m.engineerDetail.MergedPRs) {
 			pr := m.engineerDetail.MergedPRs[m.engineerSelectedPR]
 			if err := browser.Open(pr.URL); err != nil {
@@ -371,27 +1137,41 @@ func (m *Model) handleEngineerDetailKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd
 			}
 		}
 		return m, nil
+
+	case key.Matches(msg, engineerKeys.Heatmap):
+		m.openHeatmap(m.engineerLogin)
+		return m, nil
 	}
 
 	return m, nil
 }
 
-// fetchOrgData creates a command that fetches org activity data.
-func fetchOrgData(ctx context.Context, client *github.Client, org string, progressCh chan<- github.OrgLoadingProgress) tea.Cmd {
+// fetchOrgData creates a command that fetches org activity data, optionally
+// scoped to a single team, and publishes it to the poller's subscribers.
+func fetchOrgData(ctx context.Context, client *github.Client, poller *github.Poller, org, team string, progressCh chan github.OrgLoadingProgress) tea.Cmd {
 	return func() tea.Msg {
 		defer close(progressCh)
-		members, summary, err := client.FetchOrgActivityWithProgress(ctx, org, progressCh)
+		members, summary, err := client.Orgs.FetchOrgActivityWithProgress(ctx, org, team, progressCh)
 		if err != nil {
-			return OrgErrorMsg{Err: err}
+			return OrgErrorMsg{Err: err, load: progressCh}
+		}
+		if poller != nil {
+			poller.PublishOrgUpdate(ctx, github.OrgUpdated{Org: org, Team: team, Members: members, Summary: summary})
 		}
-		return OrgDataMsg{Members: members, Summary: summary}
+		return OrgDataMsg{Members: members, Summary: summary, load: progressCh}
 	}
 }
 
-// fetchEngineerDetail creates a command that fetches detailed engineer data.
-func fetchEngineerDetail(ctx context.Context, client *github.Client, org, login string) tea.Cmd {
+// fetchEngineerDetail creates a command that fetches detailed engineer data,
+// streaming partial snapshots on partialCh while PRs are being enriched.
+func fetchEngineerDetail(ctx context.Context, client *github.Client, org, login string, partialCh chan<- *github.EngineerDetail) tea.Cmd {
 	return func() tea.Msg {
-		detail, err := client.FetchEngineerDetail(ctx, org, login)
+		defer close(partialCh)
+		detail, err := client.Orgs.FetchEngineerDetailWithProgress(ctx, org, login, partialCh)
+		if ctx.Err() != nil {
+			// The drill-down was closed or replaced
+			return nil
+		}
 		if err != nil {
 			return OrgErrorMsg{Err: err}
 		}
@@ -399,15 +1179,46 @@ func fetchEngineerDetail(ctx context.Context, client *github.Client, org, login
 	}
 }
 
+// notifyPollEvent raises a desktop notification for a CI change, a new
+// secret scanning alert or a newly red default branch. Alerts gated by the
+// on-call rotation are skipped while the user is off call.
+func (m *Model) notifyPollEvent(e github.Event) {
+	now := time.Now()
+	switch e := e.(type) {
+	case github.PRStatusChanged:
+		m.desktopNotify(
+			fmt.Sprintf("CI %s: %s/%s", e.NewStatus, e.Owner, e.Repo),
+			fmt.Sprintf("PR #%d: %s (%s → %s)", e.Number, e.Title, e.OldStatus, e.NewStatus),
+		)
+	case github.SecretAlertRaised:
+		if m.rotation.Notifies(config.AlertSecretScanning, m.username, now) {
+			m.desktopNotify(e.Title(), e.Summary())
+		}
+	case github.MainBranchBroken:
+		if m.rotation.Notifies(config.AlertFailingMain, m.username, now) {
+			m.desktopNotify(
+				fmt.Sprintf("%s is red: %s", e.Branch, e.FullName()),
+				fmt.Sprintf("%.7s by @%s: %s", e.BreakingSHA, e.BreakingAuthor, e.BreakingMessage),
+			)
+		}
+	}
+}
+
 // checkReadyToMerge announces PRs that are both approved and CI-passing.
 // On the first poll it seeds the set silently so existing ready PRs don't trigger.
 func (m *Model) checkReadyToMerge() {
 	for key, info := range m.prInfos {
+		if info.Watched {
+			// Merging a watched PR is its author's call
+			continue
+		}
 		status := m.prStatuses[key]
 		if status == github.PRStatusSuccess && info.ReviewState == github.PRReviewApproved {
 			if !m.announcedReadyPRs[key] {
 				m.announcedReadyPRs[key] = true
-				if !m.firstPoll {
+				if m.inMeeting {
+					m.meetingDigest.Add("Ready to merge", fmt.Sprintf("%s/%s#%d", info.Owner, info.Repo, info.Number))
+				} else if !m.firstPoll && !m.popup {
 					notify.Say(fmt.Sprintf("%s %s PR %d is ready to be merged in", info.Owner, info.Repo, info.Number))
 				}
 			}
@@ -419,13 +1230,33 @@ func (m *Model) checkReadyToMerge() {
 	m.firstPoll = false
 }
 
+// injectSynthetic creates a command that publishes a synthetic scenario's
+// events through the poller, so every subscriber sees them as a poll.
+func injectSynthetic(ctx context.Context, poller *github.Poller, scenario string) tea.Cmd {
+	return func() tea.Msg {
+		poller.Inject(ctx, github.SyntheticEvents(scenario, time.Now()))
+		return nil
+	}
+}
+
 // markAsRead creates a command to mark a notification as read
 func markAsRead(ctx context.Context, client *github.Client, threadID string) tea.Cmd {
 	return func() tea.Msg {
-		err := client.MarkAsRead(ctx, threadID)
+		err := client.Notifications.MarkAsRead(ctx, threadID)
 		if err != nil {
 			return MarkAsReadErrorMsg{Err: err}
 		}
 		return MarkAsReadSuccessMsg{ThreadID: threadID}
 	}
 }
+
+// markAsDone creates a command to mark a notification as done
+func markAsDone(ctx context.Context, client *github.Client, threadID string) tea.Cmd {
+	return func() tea.Msg {
+		err := client.Notifications.MarkAsDone(ctx, threadID)
+		if err != nil {
+			return MarkAsReadErrorMsg{Err: err}
+		}
+		return MarkAsDoneSuccessMsg{ThreadID: threadID}
+	}
+}
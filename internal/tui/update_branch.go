@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// branchUpdate tracks a request to bring a PR up to date with its base.
+type branchUpdate struct {
+	headSHA string // head when the update was requested
+	pending bool
+	result  string // shown once the request was accepted
+	err     error
+}
+
+// label returns the short status shown in the PR pane.
+func (u branchUpdate) label() string {
+	switch {
+	case u.pending:
+		return "⟳ updating branch"
+	case u.err != nil:
+		return "✗ update failed"
+	default:
+		return "↻ " + u.result
+	}
+}
+
+// startBranchUpdate updates a PR's branch using either the configured bot
+// command comment or GitHub's update-branch API.
+func (m *Model) startBranchUpdate(info github.PRInfo) tea.Cmd {
+	key := github.PRKey(info.Owner, info.Repo, info.Number)
+	if u, ok := m.branchUpdates[key]; ok && u.pending {
+		return nil
+	}
+	m.branchUpdates[key] = branchUpdate{headSHA: info.HeadSHA, pending: true}
+	m.updatePRList()
+	return requestBranchUpdate(m.ctx, m.githubClient, key, info, m.updateBranch.CommentFor(info.Owner, info.Repo))
+}
+
+// requestBranchUpdate creates a command that posts comment on the PR, or calls
+// the update-branch API when comment is empty.
+func requestBranchUpdate(ctx context.Context, client *github.Client, key string, info github.PRInfo, comment string) tea.Cmd {
+	return func() tea.Msg {
+		if comment != "" {
+			err := client.PullRequests.CreateIssueComment(ctx, info.Owner, info.Repo, info.Number, comment)
+			return BranchUpdateMsg{Key: key, Result: fmt.Sprintf("posted %q", comment), Err: err}
+		}
+		err := client.PullRequests.UpdatePullRequestBranch(ctx, info.Owner, info.Repo, info.Number, info.HeadSHA)
+		return BranchUpdateMsg{Key: key, Result: "update requested", Err: err}
+	}
+}
+
+// branchUpdateLabel returns the update-branch status for a PR, or "".
+func (m *Model) branchUpdateLabel(key string) string {
+	if u, ok := m.branchUpdates[key]; ok {
+		return u.label()
+	}
+	return ""
+}
+
+// pruneBranchUpdates forgets updates for PRs whose head moved (the update
+// landed) or that are no longer open.
+func (m *Model) pruneBranchUpdates() {
+	for key, u := range m.branchUpdates {
+		info, ok := m.prInfos[key]
+		if !ok || (!u.pending && info.HeadSHA != u.headSHA) {
+			delete(m.branchUpdates, key)
+		}
+	}
+}
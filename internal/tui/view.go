@@ -2,11 +2,15 @@ package tui
 
 import (
 	"fmt"
+	"image/color"
 	"math"
 	"strings"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/jpoz/hubell/internal/github"
 )
 
@@ -40,18 +44,102 @@ func (m *Model) View() tea.View {
 		return m.newView("Loading...")
 	}
 
+	if m.showReauth {
+		return m.newView(m.renderReauth())
+	}
+
+	if m.showHelp {
+		return m.newView(m.renderHelp())
+	}
+
+	if m.showHeatmap {
+		return m.newView(m.renderHeatmap())
+	}
+
 	if m.showEngineerDetail {
 		return m.newView(m.renderEngineerDetail())
 	}
 
+	if m.showReviewMatrix {
+		return m.newView(m.renderReviewMatrix())
+	}
+
+	if m.showOrgSummary {
+		return m.newView(m.renderOrgSummary())
+	}
+
 	if m.showOrgDashboard {
 		return m.newView(m.renderOrgDashboard())
 	}
 
+	if m.showPanels {
+		return m.newView(m.renderPanels())
+	}
+
+	if m.showSubscriptions {
+		return m.newView(m.renderSubscriptions())
+	}
+
+	if m.showThread {
+		return m.newView(m.renderThread())
+	}
+
+	if m.showPRFiles {
+		return m.newView(m.renderPRFiles())
+	}
+
+	if m.showChecks {
+		return m.newView(m.renderChecks())
+	}
+
+	if m.showGroup {
+		return m.newView(m.renderGroup())
+	}
+
+	if m.showArchive {
+		return m.newView(m.renderArchive())
+	}
+
+	if m.showMainBoard {
+		return m.newView(m.renderMainBoard())
+	}
+
+	if m.showSecurity {
+		return m.newView(m.renderSecurity())
+	}
+
+	if m.showPRTimeline {
+		return m.newView(m.renderPRTimeline())
+	}
+
+	if m.showCISettings {
+		return m.newView(m.renderCISettings())
+	}
+
 	if m.showThemeSelector {
 		return m.newView(m.renderThemeSelector())
 	}
 
+	if m.showWatchInput {
+		return m.newView(m.renderWatchInput())
+	}
+
+	if m.showAutoMerge {
+		return m.newView(m.renderAutoMerge())
+	}
+
+	if m.showLabelInput {
+		return m.newView(m.renderLabelInput())
+	}
+
+	if m.showLog {
+		return m.newView(m.renderLogView())
+	}
+
+	if m.showTimezones {
+		return m.newView(m.renderTimezones())
+	}
+
 	if m.showDashboard {
 		return m.newView(m.renderDashboard())
 	}
@@ -60,66 +148,58 @@ func (m *Model) View() tea.View {
 		return m.newView(m.renderBanner())
 	}
 
+	if m.popup {
+		return m.newView(m.renderPopup())
+	}
+
 	// Show error banner if present
 	errorBanner := ""
 	if m.err != nil {
 		errorBanner = m.errorStyle().Render(fmt.Sprintf("⚠ Error: %s", m.err)) + "\n"
+	} else if w := m.scopeWarning(); w != "" {
+		errorBanner = m.errorStyle().Render(w) + "\n"
+	}
+
+	if m.minimal() {
+		return m.newView(errorBanner + m.renderPanes(m.height-3) + "\n" + m.renderStatusBar())
 	}
 
-	// Calculate pane widths: 30% timeline / 35% notifications / 35% PRs
-	tlWidth := m.width * 30 / 100
-	notiWidth := m.width * 35 / 100
-	prWidth := m.width - tlWidth - notiWidth
-
-	// Height for list content (minus error banner, help, borders)
-	listHeight := m.height - 5
-
-	// Build timeline pane (left)
-	tlContentWidth := max(tlWidth-2, 0)
-	tlContentHeight := max(listHeight-2, 0)
-	m.timelineList.SetSize(tlContentWidth, tlContentHeight)
-	tlStyle := m.unfocusedPaneStyle()
-	if m.focusedPane == TimelinePane {
-		tlStyle = m.focusedPaneStyle()
-	}
-	timelinePane := tlStyle.
-		Width(tlContentWidth).
-		Height(tlContentHeight).
-		Render(m.timelineList.View())
-
-	// Build notifications pane (middle)
-	notiContentWidth := max(notiWidth-2, 0)
-	notiContentHeight := max(listHeight-2, 0)
-	m.list.SetSize(notiContentWidth, notiContentHeight)
-	notiStyle := m.unfocusedPaneStyle()
-	if m.focusedPane == LeftPane {
-		notiStyle = m.focusedPaneStyle()
-	}
-	notiPane := notiStyle.
-		Width(notiContentWidth).
-		Height(notiContentHeight).
-		Render(m.list.View())
-
-	// Build PRs pane (right)
-	prContentWidth := max(prWidth-2, 0)
-	prContentHeight := max(listHeight-2, 0)
-	m.prList.SetSize(prContentWidth, prContentHeight)
-	prStyle := m.unfocusedPaneStyle()
-	if m.focusedPane == RightPane {
-		prStyle = m.focusedPaneStyle()
-	}
-	prPane := prStyle.
-		Width(prContentWidth).
-		Height(prContentHeight).
-		Render(m.prList.View())
-
-	// Combine panes horizontally
-	panes := lipgloss.JoinHorizontal(lipgloss.Top, timelinePane, notiPane, prPane)
-
-	// Help text
-	help := m.helpStyle().Render(fmt.Sprintf("tab: switch pane | enter: open | r: mark read | f: filter [%s] | d: dashboard | o: org | t: theme | q: quit | /: search", m.filterMode))
-
-	return m.newView(errorBanner + panes + "\n" + help)
+	// Height for list content (minus error banner, status bar, borders)
+	panes := m.renderPanes(m.height - 5)
+
+	return m.newView(errorBanner + panes + "\n\n" + m.renderStatusBar())
+}
+
+// renderTimelineVelocity renders a one-line summary of today's timeline
+// activity, counted over the items currently visible after filtering.
+func (m *Model) renderTimelineVelocity(width int) string {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var merged, opened, approved int
+	for _, item := range m.timelineList.VisibleItems() {
+		evt, ok := item.(TimelineEvent)
+		if !ok || evt.Timestamp.Before(today) {
+			continue
+		}
+		switch evt.EventType {
+		case TimelineEventMerged:
+			merged++
+		case TimelineEventCreated:
+			opened++
+		case TimelineEventApproved:
+			approved++
+		}
+	}
+
+	labelStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	line := labelStyle.Render(" today: ") +
+		lipgloss.NewStyle().Foreground(m.theme.TimelineMerged).Render(fmt.Sprintf("%d merged", merged)) +
+		labelStyle.Render(" · ") +
+		lipgloss.NewStyle().Foreground(m.theme.TimelineCreated).Render(fmt.Sprintf("%d opened", opened)) +
+		labelStyle.Render(" · ") +
+		lipgloss.NewStyle().Foreground(m.theme.TimelineApproved).Render(fmt.Sprintf("%d approved", approved))
+	return ansi.Truncate(line, width, "…")
 }
 
 // newView wraps a string in a tea.View with AltScreen enabled.
@@ -142,9 +222,16 @@ func (m *Model) renderBanner() string {
 	g := dark[1] + int(frac*float64(bright[1]-dark[1]))
 	b := dark[2] + int(frac*float64(bright[2]-dark[2]))
 
-	color := lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", r, g, b))
+	var bannerColor color.Color = lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", r, g, b))
+	if m.colorProfile <= colorprofile.ANSI {
+		// 16 colors can't blend, so pulse between two instead
+		bannerColor = m.theme.Subtle
+		if frac > 0.5 {
+			bannerColor = m.theme.Accent
+		}
+	}
 
-	bannerStyle := lipgloss.NewStyle().Foreground(color)
+	bannerStyle := lipgloss.NewStyle().Foreground(bannerColor)
 	checklist := m.renderLoadingChecklist()
 
 	content := bannerStyle.Render(banner) + "\n\n" + checklist
@@ -257,11 +344,13 @@ func renderProgressBar(current, total, width int, fillStyle, emptyStyle lipgloss
 
 // renderThemeSelector draws the theme picker overlay centered on screen.
 func (m *Model) renderThemeSelector() string {
-	m.themeList.SetSize(30, len(themeOrder)*3+4)
+	// Custom themes can make the list taller than the screen; it pages
+	height := max(min(len(themeOrder)*3+4, m.height-6), 10)
+	m.themeList.SetSize(30, height)
 
 	box := m.focusedPaneStyle().
 		Width(32).
-		Height(len(themeOrder)*3 + 6).
+		Height(height + 2).
 		Render(m.themeList.View())
 
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
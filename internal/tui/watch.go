@@ -0,0 +1,176 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// newWatchInput creates the input for adding a PR to the watchlist by
+// reference.
+func newWatchInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "owner/repo#123 or PR URL"
+	ti.CharLimit = 200
+	ti.SetWidth(40)
+	return ti
+}
+
+// toggleWatchSelected adds the selected notification's or timeline event's
+// PR to the watchlist, or removes the selected watched PR.
+func (m *Model) toggleWatchSelected() tea.Cmd {
+	var owner, repo string
+	var number int
+	switch m.focusedPane {
+	case LeftPane:
+		item, ok := m.list.SelectedItem().(NotificationItem)
+		if !ok || item.notification.Subject.Type != "PullRequest" {
+			return nil
+		}
+		if owner, repo, number, ok = github.ParseSubjectURL(item.notification.Subject.URL); !ok {
+			return nil
+		}
+	case RightPane:
+		item, ok := m.prList.SelectedItem().(PRItem)
+		if !ok {
+			return nil
+		}
+		if !item.info.Watched {
+			m.notice = "your own PRs are always listed"
+			m.noticeLink = ""
+			return nil
+		}
+		owner, repo, number = item.info.Owner, item.info.Repo, item.info.Number
+	case TimelinePane:
+		item, ok := m.timelineList.SelectedItem().(TimelineEvent)
+		if !ok || item.Number == 0 {
+			return nil
+		}
+		owner, repo, number = item.Owner, item.Repo, item.Number
+	}
+	key := github.PRKey(owner, repo, number)
+	if slices.Contains(m.watchlist, key) {
+		return m.unwatch(key)
+	}
+	return m.watch(key)
+}
+
+// watch adds a PR to the watchlist and polls right away so it shows up in
+// the PR pane.
+func (m *Model) watch(key string) tea.Cmd {
+	if info, ok := m.prInfos[key]; ok && !info.Watched {
+		m.notice = "your own PRs are always listed"
+		m.noticeLink = ""
+		return nil
+	}
+	if slices.Contains(m.watchlist, key) {
+		return nil
+	}
+	m.watchlist = append(m.watchlist, key)
+	m.saveWatchlist()
+	m.notice = "watching " + key + ", loading…"
+	m.noticeLink = ""
+	if m.poller != nil {
+		m.poller.PollNow()
+	}
+	return nil
+}
+
+// unwatch removes a PR from the watchlist and the PR pane.
+func (m *Model) unwatch(key string) tea.Cmd {
+	m.watchlist = slices.DeleteFunc(m.watchlist, func(k string) bool { return k == key })
+	m.saveWatchlist()
+	if info, ok := m.prInfos[key]; ok && info.Watched {
+		delete(m.prInfos, key)
+		delete(m.prStatuses, key)
+		m.updatePRList()
+	}
+	m.notice = "stopped watching " + key
+	m.noticeLink = ""
+	return nil
+}
+
+// pruneWatchlist drops watched PRs the poll found closed or merged.
+func (m *Model) pruneWatchlist(closed []string) {
+	if len(closed) == 0 {
+		return
+	}
+	m.watchlist = slices.DeleteFunc(m.watchlist, func(k string) bool { return slices.Contains(closed, k) })
+	m.saveWatchlist()
+}
+
+// saveWatchlist persists the watchlist and hands it to the poller.
+func (m *Model) saveWatchlist() {
+	if err := config.SaveWatchlist(m.watchlist); err != nil {
+		m.err = fmt.Errorf("save watchlist: %w", err)
+	}
+	if m.poller != nil {
+		m.poller.SetWatchlist(m.watchlist)
+	}
+}
+
+// handleWatchInputKey handles key events in the watch-by-reference prompt.
+func (m *Model) handleWatchInputKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, inputKeys.Cancel):
+		m.showWatchInput = false
+		return m, nil
+	case key.Matches(msg, inputKeys.Confirm):
+		owner, repo, number, ok := github.ParsePRRef(strings.TrimSpace(m.watchInput.Value()))
+		if !ok {
+			m.watchInputErr = fmt.Errorf("expected owner/repo#123 or a PR URL")
+			return m, nil
+		}
+		m.showWatchInput = false
+		return m, m.watch(github.PRKey(owner, repo, number))
+	}
+	m.watchInputErr = nil
+	var cmd tea.Cmd
+	m.watchInput, cmd = m.watchInput.Update(msg)
+	return m, cmd
+}
+
+// openWatchInput shows the prompt for watching a PR by reference.
+func (m *Model) openWatchInput() tea.Cmd {
+	m.showWatchInput = true
+	m.watchInputErr = nil
+	m.watchInput.Reset()
+	return m.watchInput.Focus()
+}
+
+// renderWatchInput renders the watch-by-reference prompt.
+func (m *Model) renderWatchInput() string {
+	maxWidth := max(min(56, m.width-4), 30)
+
+	titleStyle := lipgloss.NewStyle().Foreground(m.theme.Title).Bold(true)
+	subtleStyle := lipgloss.NewStyle().Foreground(m.theme.Subtle)
+	errorStyle := lipgloss.NewStyle().Foreground(m.theme.Error)
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Watch a Pull Request"))
+	b.WriteString("\n\n")
+	b.WriteString(m.watchInput.View())
+	b.WriteString("\n")
+	if m.watchInputErr != nil {
+		b.WriteString(errorStyle.Render(m.watchInputErr.Error()))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("%d watched  enter: watch  esc: cancel", len(m.watchlist))))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FocusedBorder).
+		Padding(1, 2).
+		Width(maxWidth).
+		Render(b.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/jpoz/hubell/internal/clipboard"
+	"github.com/jpoz/hubell/internal/github"
+)
+
+// selectedLink returns the web URL and owner/repo#number reference of the
+// item selected in the focused pane.
+func (m *Model) selectedLink() (url, ref string, ok bool) {
+	switch m.focusedPane {
+	case LeftPane:
+		switch item := m.list.SelectedItem().(type) {
+		case NotificationItem:
+			subject := item.notification.Subject.URL
+			url = m.notificationURL(item.notification)
+			if owner, repo, number, found := github.ParseSubjectURL(subject); found {
+				ref = fmt.Sprintf("%s/%s#%d", owner, repo, number)
+			} else {
+				ref = item.notification.Repository.FullName
+			}
+			return url, ref, true
+		case AssignedIssueItem:
+			return item.issue.HTMLURL, fmt.Sprintf("%s#%d", item.issue.RepoFullName(), item.issue.Number), true
+		case SecretAlertItem:
+			return item.alert.HTMLURL, item.alert.Owner + "/" + item.alert.Repo, true
+		}
+	case RightPane:
+		if item, found := m.prList.SelectedItem().(PRItem); found {
+			return item.info.URL, github.PRKey(item.info.Owner, item.info.Repo, item.info.Number), true
+		}
+	case TimelinePane:
+		if item, found := m.timelineList.SelectedItem().(TimelineEvent); found {
+			return item.URL, github.PRKey(item.Owner, item.Repo, item.Number), true
+		}
+	}
+	return "", "", false
+}
+
+// yank copies the selected item's URL, or its reference when ref is set, to
+// the clipboard via OSC 52 and the platform clipboard tool.
+func (m *Model) yank(ref bool) tea.Cmd {
+	url, reference, ok := m.selectedLink()
+	text := url
+	if ref {
+		text = reference
+	}
+	if !ok || text == "" {
+		return nil
+	}
+	m.notice = "copied " + text
+	m.noticeLink = ""
+	return tea.Batch(tea.SetClipboard(text), copyToClipboard(text))
+}
+
+// copyToClipboard creates a command that copies text with the platform
+// clipboard tool. Missing tools are fine as long as OSC 52 works, so errors
+// are only reported when a tool was found and failed.
+func copyToClipboard(text string) tea.Cmd {
+	return func() tea.Msg {
+		if err := clipboard.Copy(text); err != nil && !errors.Is(err, clipboard.ErrUnavailable) {
+			return ClipboardErrorMsg{Err: err}
+		}
+		return nil
+	}
+}
@@ -6,12 +6,19 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/jpoz/hubell/internal/auth"
+	"github.com/jpoz/hubell/internal/browser"
 	"github.com/jpoz/hubell/internal/config"
+	"github.com/jpoz/hubell/internal/daemon"
+	"github.com/jpoz/hubell/internal/debuglog"
 	"github.com/jpoz/hubell/internal/github"
 	"github.com/jpoz/hubell/internal/notify"
 	"github.com/jpoz/hubell/internal/tui"
@@ -26,8 +33,69 @@ func main() {
 
 func run() error {
 	orgFlag := flag.String("org", "", "GitHub organization to monitor")
+	teamFlag := flag.String("team", "", "GitHub team slug to scope the org dashboard to")
+	popupFlag := flag.Bool("popup", false, "Compact single-pane inbox for tmux display-popup; exits after opening an item")
+	daemonFlag := flag.Bool("daemon", false, "Run headless, sending desktop notifications without the TUI")
+	trayFlag := flag.Bool("tray", false, "With --daemon, show a system tray icon with unread/failing counts")
+	debugFlag := flag.Bool("debug", false, "Log API requests, poll timings and rate limits to ~/.cache/hubell/debug.log (viewer: L), and enable ctrl+t to inject synthetic events")
+	intervalFlag := flag.Duration("interval", 0, "How often to poll GitHub (default from config, 30s)")
+	// A broken theme file is reported once the TUI starts
+	_ = tui.LoadCustomThemes()
+	themeFlag := flag.String("theme", "", "Theme to use for this run: "+strings.Join(tui.ThemeNames(), ", "))
+	filterFlag := flag.String("filter", "", "Notification filter to start with: "+strings.Join(config.Filters, ", "))
+	noNotifyFlag := flag.Bool("no-notify", false, "Don't send desktop notifications or spoken announcements")
+	configFlag := flag.String("config", "", "Config file to use instead of ~/.config/hubell/config.toml")
+	flag.Usage = usage
 	flag.Parse()
 
+	if *trayFlag && !*daemonFlag {
+		return fmt.Errorf("--tray requires --daemon")
+	}
+	var command string
+	if flag.NArg() > 0 {
+		command = flag.Arg(0)
+		// Only login takes arguments of its own
+		if !isCommand(command) || (flag.NArg() > 1 && command != "login") {
+			usage()
+			return fmt.Errorf("unknown command %q", strings.Join(flag.Args(), " "))
+		}
+	}
+
+	if *debugFlag {
+		logFile, err := debuglog.Enable()
+		if err != nil {
+			return fmt.Errorf("failed to open debug log: %w", err)
+		}
+		defer logFile.Close()
+	}
+
+	if *configFlag != "" {
+		config.SetPath(*configFlag)
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if *intervalFlag != 0 {
+		if *intervalFlag < 5*time.Second {
+			return fmt.Errorf("--interval must be at least 5s")
+		}
+		cfg.Interval = *intervalFlag
+	}
+	if *themeFlag != "" {
+		if !slices.Contains(tui.ThemeNames(), *themeFlag) {
+			return fmt.Errorf("unknown theme %q", *themeFlag)
+		}
+		cfg.Theme = *themeFlag
+	}
+	if *filterFlag != "" {
+		if !slices.Contains(config.Filters, *filterFlag) {
+			return fmt.Errorf("unknown filter %q", *filterFlag)
+		}
+		cfg.Filter = *filterFlag
+	}
+	notify.SetDesktopEnabled(!*noNotifyFlag)
+
 	// Create context with signal handling
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -43,6 +111,13 @@ func run() error {
 	// Initialize token store
 	tokenStore := auth.NewTokenStore()
 
+	switch command {
+	case "login":
+		return runLogin(ctx, tokenStore, flag.Args()[1:])
+	case "logout":
+		return runLogout(tokenStore)
+	}
+
 	// Load token from disk or environment variable
 	token := os.Getenv("GITHUB_TOKEN")
 	if token == "" {
@@ -51,17 +126,15 @@ func run() error {
 
 	// If no token found, prompt user
 	if token == "" {
-		var err error
 		token, err = auth.PromptForToken()
 		if err != nil {
 			return fmt.Errorf("failed to get token: %w", err)
 		}
 
-		// Save token for future use
-		if err := tokenStore.Save(token); err != nil {
-			return fmt.Errorf("failed to save token: %w", err)
+		// Check and save token for future use
+		if err := saveValidToken(ctx, tokenStore, token); err != nil {
+			return err
 		}
-		fmt.Println("✓ Token saved to ~/.config/hubell/token")
 		fmt.Println()
 	}
 
@@ -71,11 +144,33 @@ func run() error {
 		org = os.Getenv("HUBELL_ORG")
 	}
 	if org == "" {
-		org = config.LoadOrg()
+		org = cfg.Org
+	}
+
+	// Resolve team slug: flag > env > config
+	team := *teamFlag
+	if team == "" {
+		team = os.Getenv("HUBELL_TEAM")
+	}
+	if team == "" {
+		team = cfg.Team
 	}
 
+	// Resolve URL open command: env > config
+	browserSettings, err := config.LoadBrowserSettings()
+	if err != nil {
+		return fmt.Errorf("failed to load browser settings: %w", err)
+	}
+	browserCmd := os.Getenv("HUBELL_BROWSER")
+	if browserCmd == "" {
+		browserCmd = browserSettings.Command
+	}
+	browser.SetCommand(browserCmd)
+	browser.SetMode(browserSettings.Remote)
+
 	// Create GitHub client
-	client := github.NewClient(token)
+	botPatterns := github.WithBotPatterns(config.LoadBotSettings().Patterns)
+	client := github.NewClient(token, botPatterns)
 
 	// Get authenticated user for PR status polling
 	user, err := client.GetAuthenticatedUser(ctx)
@@ -83,23 +178,166 @@ func run() error {
 		return fmt.Errorf("failed to get authenticated user: %w", err)
 	}
 
+	switch command {
+	case "status", "prs", "notifications":
+		return runQuery(ctx, command, client, user.Login, cfg.Interval)
+	}
+
+	ciOptions := config.LoadCISettings().CIOptions()
+
+	mainBoard, err := config.LoadMainBoardSettings()
+	if err != nil {
+		return fmt.Errorf("failed to load failing-main board settings: %w", err)
+	}
+	mainBoardOptions := mainBoard.Options(org, ciOptions)
+	watchlist := config.LoadWatchlist()
+
+	if *daemonFlag {
+		// No loading checklist to feed without the TUI
+		email, err := config.LoadEmailSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load email settings: %w", err)
+		}
+		poller := github.NewPoller(client, cfg.Interval, user.Login, nil)
+		poller.SetCIOptions(ciOptions)
+		poller.SetMainBoard(mainBoardOptions)
+		poller.SetWatchlist(watchlist)
+		outbound, err := config.LoadOutboundSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load outbound settings: %w", err)
+		}
+		cal, err := config.LoadCalendarSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load calendar settings: %w", err)
+		}
+		rotation, err := config.LoadRotationSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load rotation settings: %w", err)
+		}
+		d := daemon.New(poller.Subscribe(), daemon.Options{
+			Email:    email,
+			Outbound: outbound,
+			Calendar: cal,
+			Rotation: rotation,
+			Login:    user.Login,
+		})
+		poller.Start(ctx)
+		if *trayFlag {
+			daemon.RunTray(ctx, cancel, d)
+			return nil
+		}
+		return d.Run(ctx)
+	}
+
 	// Create progress channel for loading checklist
 	progressCh := make(chan github.LoadingProgress, 8)
 
-	// Create poller with 30-second interval
-	poller := github.NewPoller(client, 30*time.Second, user.Login, progressCh)
-	pollCh := poller.Start(ctx)
+	// Additional accounts to switch between in the TUI; popup mode can't
+	// switch, so skip looking them up
+	accountsSettings, err := config.LoadAccountsSettings()
+	if err != nil {
+		return fmt.Errorf("failed to load accounts settings: %w", err)
+	}
+	if *popupFlag {
+		accountsSettings.Accounts = nil
+	}
+	accounts := []tui.Account{{
+		Name:          user.Login,
+		Client:        client,
+		Username:      user.Login,
+		MissingScopes: github.MissingScopes(user.Scopes, org != ""),
+	}}
+	// Look the extra accounts up concurrently; one that fails is left
+	// unavailable, and looked up again when switched to, rather than
+	// keeping hubell from starting
+	extra := make([]tui.Account, len(accountsSettings.Accounts))
+	var wg sync.WaitGroup
+	for i, a := range accountsSettings.Accounts {
+		opts := []github.Option{botPatterns}
+		if a.BaseURL != "" {
+			opts = append(opts, github.WithBaseURL(a.BaseURL))
+		}
+		extra[i] = tui.Account{Name: a.Name, Client: github.NewClient(a.ResolveToken(), opts...)}
+		wg.Add(1)
+		go func(acct *tui.Account) {
+			defer wg.Done()
+			u, err := acct.Client.GetAuthenticatedUser(ctx)
+			if err != nil {
+				acct.Err = err
+				return
+			}
+			acct.Username = u.Login
+			acct.MissingScopes = github.MissingScopes(u.Scopes, org != "")
+		}(&extra[i])
+	}
+	wg.Wait()
+	accounts = append(accounts, extra...)
+
+	// Create poller at the configured interval
+	poller := github.NewPoller(client, cfg.Interval, user.Login, progressCh)
+	poller.SetCIOptions(ciOptions)
+	poller.SetWatchlist(watchlist)
+	if !*popupFlag {
+		poller.SetMainBoard(mainBoardOptions)
+	}
+	events := poller.Subscribe()
+	pollCtx, stopPoller := context.WithCancel(ctx)
+	poller.Start(pollCtx)
+
+	// Accounts switched to later get a poller without a loading checklist;
+	// the failing-main board belongs to the first account's org
+	startPoller := func(ctx context.Context, a tui.Account) (*github.Poller, <-chan github.Event) {
+		p := github.NewPoller(a.Client, cfg.Interval, a.Username, nil)
+		if a.Client == client && !*popupFlag {
+			p.SetMainBoard(mainBoardOptions)
+		}
+		events := p.Subscribe()
+		p.Start(ctx)
+		return p, events
+	}
 
 	// Send test notification on startup
-	notify.SendDesktopNotification("hubell", "Application started successfully!")
+	if !*popupFlag {
+		notify.SendDesktopNotification("hubell", "Application started successfully!")
+	}
 
 	// Create and run TUI
-	model := tui.New(ctx, client, pollCh, progressCh, org)
+	model := tui.New(ctx, client, events, progressCh, tui.Options{
+		OrgName:   org,
+		OrgTeam:   team,
+		Orgs:      cfg.Orgs,
+		Popup:     *popupFlag,
+		Poller:    poller,
+		MainBoard: mainBoardOptions.Enabled(),
+		Username:  user.Login,
+		Debug:     *debugFlag,
+
+		Accounts:    accounts,
+		StartPoller: startPoller,
+		StopPoller:  stopPoller,
+		SaveToken:   tokenStore.Save,
+		Filter:      cfg.Filter,
+		Theme:       cfg.Theme,
+		Icons:       cfg.Icons,
+		Keys:        cfg.Keys,
+	})
 	p := tea.NewProgram(model)
 
+	// URLs that can't be opened here (e.g. over SSH) are shown in the TUI
+	// as links; popup mode quits right after opening, so print the last one.
+	// The fallback runs on the TUI's goroutine.
+	var lastURL atomic.Pointer[string]
+	browser.SetFallback(func(url string) {
+		lastURL.Store(&url)
+		go p.Send(tui.OpenURLMsg{URL: url})
+	})
+
 	if _, err := p.Run(); err != nil {
 		return fmt.Errorf("TUI error: %w", err)
 	}
+	if url := lastURL.Load(); *popupFlag && url != nil {
+		fmt.Println(*url)
+	}
 
 	return nil
 }